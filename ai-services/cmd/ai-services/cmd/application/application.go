@@ -21,14 +21,18 @@ func init() {
 	ApplicationCmd.AddCommand(templatesCmd)
 	ApplicationCmd.AddCommand(createCmd)
 	ApplicationCmd.AddCommand(psCmd)
+	ApplicationCmd.AddCommand(healthCmd)
 	ApplicationCmd.AddCommand(deleteCmd)
 	ApplicationCmd.AddCommand(image.ImageCmd)
 	ApplicationCmd.AddCommand(stopCmd)
 	ApplicationCmd.AddCommand(startCmd)
 	ApplicationCmd.AddCommand(infoCmd)
 	ApplicationCmd.AddCommand(logsCmd)
+	ApplicationCmd.AddCommand(execCmd)
+	ApplicationCmd.AddCommand(diffCmd)
+	ApplicationCmd.AddCommand(rollbackCmd)
 	ApplicationCmd.AddCommand(model.ModelCmd)
-	ApplicationCmd.PersistentFlags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool image to use for downloading the model(only for the development purpose)")
+	ApplicationCmd.PersistentFlags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool image to use for downloading the model(only for the development purpose), pinned by tag or by digest (registry/repo@sha256:...)")
 	ApplicationCmd.PersistentFlags().BoolVar(&hiddenTemplates, "hidden", false, "Show hidden templates")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("tool-image")
 	_ = ApplicationCmd.PersistentFlags().MarkHidden("hidden")
@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -10,13 +12,16 @@ import (
 	appBootstrap "github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
@@ -25,19 +30,36 @@ import (
 // Variables for flags placeholder.
 var (
 	// common flags.
-	templateName string
-	rawArgParams []string
-	argParams    map[string]string
+	templateName     string
+	templateVersion  string
+	templateURL      string
+	templateChecksum string
+	templateKeyFile  string
+	insecureURL      bool
+	rawArgParams     []string
+	rawArgEnvParams  []string
+	rawArgJSONParams []string
+	rawArgStrParams  []string
+	rawArgSetParams  []string
+	argParams        map[string]string
+	rawLabels        []string
+	rawAnnotations   []string
+	metadata         specs.Metadata
+	dumpManifestsDir string
 
 	// podman flags.
 	skipModelDownload     bool
+	waitForModel          time.Duration
 	skipImageDownload     bool
 	skipChecks            []string
 	valuesFiles           []string
 	rawArgImagePullPolicy string
+	manifestFile          string
 
 	// openshift flags.
-	timeout time.Duration
+	timeout      time.Duration
+	applyTimeout time.Duration
+	dryRun       bool
 )
 
 var createCmd = &cobra.Command{
@@ -46,9 +68,48 @@ var createCmd = &cobra.Command{
 	Long: `Deploys an application with the provided application name based on the template
 		Arguments
 		- [name]: Application name (Required)
+
+		The latest version of the template is deployed, unless an earlier one it still ships is pinned
+		with --template-version, Eg:- to roll back an upgrade. The deployed resources are labelled with it.
+
+		A template distributed out of band, as a zip bundle holding its directory, is fetched with --template-url
+		and deployed the same as a built-in one. Its sha256 checksum is verified, the one given with --checksum or else
+		the one of the .sha256 sidecar file served along with it, Eg:- rag.zip.sha256. Its cosign signature,
+		served as the .sig sidecar file, is verified too when the public key is given with --public-key.
+		The URL must be HTTPS unless --insecure is set, and the bundle is limited to 20 MiB.
+
+		On a terminal, the template is picked from the list of the available ones when neither --template
+		nor --from-manifest is given, and its required parameters are prompted for. Elsewhere, Eg:- in scripts,
+		one of the flags must be set.
+
+		On podman, a hand-crafted manifest can be deployed in place of a template with --from-manifest.
+		Its pods get the application labels and their spyre cards annotations are validated,
+		the same as for the pods rendered from a template.
+
+		The parameters are set with dotted keys, Eg:- ui.port, and --set indexes the lists the way helm does,
+		Eg:- --set vllm.args[0]=--max-model-len=8192 sets the first item of the list and the index right after the
+		last item appends one. New keys are only accepted in the maps the template leaves empty, Eg:- nodeSelector: {}.
+
+		Custom labels and annotations, Eg:- cost-center or team, are set on the deployed resources with
+		--label and --annotation, on top of the ai-services.io ones which they cannot override.
+
+		On openshift, --dry-run validates the resources against the cluster, Eg:- its admission webhooks,
+		without persisting them.
+
+		To debug a deployment which does not match its rendered template, --dump-manifests writes the
+		manifests to a directory right before they are applied, after the labels and annotations are set,
+		Eg:- one <pod>.yaml per pod on podman and a single <name>.yaml on openshift.
 	`,
 	Args: cobra.ExactArgs(1),
 	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := fetchTemplate(cmd); err != nil {
+			return err
+		}
+
+		if err := pickTemplate(cmd); err != nil {
+			return err
+		}
+
 		// Build and run flag validator
 		flagValidator := buildFlagValidator()
 		if err := flagValidator.Validate(cmd); err != nil {
@@ -61,12 +122,12 @@ var createCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		appName := args[0]
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		if err := doBootstrapValidate(); err != nil {
+		if err := doBootstrapValidate(ctx); err != nil {
 			return err
 		}
 
@@ -81,18 +142,24 @@ var createCmd = &cobra.Command{
 			Name:              appName,
 			TemplateName:      templateName,
 			SkipModelDownload: skipModelDownload,
+			WaitForModel:      waitForModel,
 			SkipImageDownload: skipImageDownload,
 			ArgParams:         argParams,
+			Metadata:          metadata,
+			DumpManifestsDir:  dumpManifestsDir,
 			ValuesFiles:       valuesFiles,
 			ImagePullPolicy:   image.ImagePullPolicy(rawArgImagePullPolicy),
+			ManifestFile:      manifestFile,
 			Timeout:           timeout,
+			ApplyTimeout:      applyTimeout,
+			DryRun:            dryRun,
 		}
 
 		return app.Create(ctx, opts)
 	},
 }
 
-func doBootstrapValidate() error {
+func doBootstrapValidate(ctx context.Context) error {
 	skip := helpers.ParseSkipChecks(skipChecks)
 	if len(skip) > 0 {
 		logger.Warningf("Skipping validation checks (skipped: %v)\n", skipChecks)
@@ -101,7 +168,7 @@ func doBootstrapValidate() error {
 	// Create bootstrap instance based on runtime
 	factory := bootstrap.NewBootstrapFactory(vars.RuntimeFactory.GetRuntimeType())
 
-	if err := factory.Validate(skip); err != nil {
+	if err := factory.Validate(ctx, skip); err != nil {
 		return fmt.Errorf("bootstrap validation failed: %w", err)
 	}
 
@@ -109,6 +176,7 @@ func doBootstrapValidate() error {
 }
 
 func init() {
+	audit.MarkMutating(createCmd)
 	initCreateCommonFlags()
 	initCreatePodmanFlags()
 	initCreateOpenShiftFlags()
@@ -118,8 +186,13 @@ func initCreateCommonFlags() {
 	skipCheckDesc := appBootstrap.BuildSkipFlagDescription()
 	createCmd.Flags().StringSliceVar(&skipChecks, appFlags.Create.SkipValidation, []string{}, skipCheckDesc)
 
-	createCmd.Flags().StringVarP(&templateName, appFlags.Create.Template, "t", "", "Application template to use (required)")
-	_ = createCmd.MarkFlagRequired(appFlags.Create.Template)
+	createCmd.Flags().StringVarP(&templateName, appFlags.Create.Template, "t", "", "Application template to use (required unless --from-manifest or --template-url is set)")
+	_ = createCmd.RegisterFlagCompletionFunc(appFlags.Create.Template, completion.TemplateNames)
+	createCmd.Flags().StringVar(&templateVersion, appFlags.Create.TemplateVersion, templates.LatestVersion,
+		"Version of the application template to deploy, one of the versions shown by \"ai-services application templates show\"")
+	_ = createCmd.RegisterFlagCompletionFunc(appFlags.Create.TemplateVersion, completion.TemplateVersions)
+	initTemplateURLFlags()
+	_ = createCmd.RegisterFlagCompletionFunc(appFlags.Create.SkipValidation, completion.ValidationChecks)
 
 	createCmd.Flags().StringSliceVar(
 		&rawArgParams,
@@ -134,6 +207,53 @@ func initCreateCommonFlags() {
 			"- When both --values and --params are provided, --params overrides --values\n",
 	)
 
+	createCmd.Flags().StringSliceVar(
+		&rawArgEnvParams,
+		appFlags.Create.ParamsEnv,
+		[]string{},
+		"Inline parameters whose values are read from environment variables, to keep secrets out of the command line.\n\n"+
+			"Format:\n"+
+			"- Comma-separated key=ENV_VAR pairs\n"+
+			"- Example: --params-env opensearch.password=OPENSEARCH_PASSWORD\n\n"+
+			"- A parameter cannot be set by both --params and --params-env\n",
+	)
+
+	createCmd.Flags().StringArrayVar(
+		&rawArgJSONParams,
+		appFlags.Create.SetJSON,
+		[]string{},
+		"Inline parameter whose value is parsed as JSON, for the structured parameters such as resource limits.\n\n"+
+			"Format:\n"+
+			"- A single key=<json> pair, can be provided multiple times\n"+
+			"- Example: --set-json 'key1={\"limits\":{\"cpu\":\"2\",\"memory\":\"4Gi\"}}'\n\n"+
+			"- Objects and arrays are only accepted by the parameters declared with the object and array types\n"+
+			"- A parameter cannot be set by both --set-json and --params or --params-env\n",
+	)
+
+	createCmd.Flags().StringArrayVar(
+		&rawArgSetParams,
+		appFlags.Create.Set,
+		[]string{},
+		"Inline parameter whose key may index the lists of the values, mirroring helm --set.\n\n"+
+			"Format:\n"+
+			"- A single key=value pair, can be provided multiple times\n"+
+			"- Example: --set vllm.args[0]=--enforce-eager --set nodeSelector.zone=a\n\n"+
+			"- The index right after the last item of a list appends one, setting a scalar as a list or a map fails\n"+
+			"- A parameter cannot be set by both --set and --params, --params-env or --set-json\n",
+	)
+
+	createCmd.Flags().StringArrayVar(
+		&rawArgStrParams,
+		appFlags.Create.SetString,
+		[]string{},
+		"Inline parameter whose value is kept as a string whatever the type of the parameter, mirroring helm --set-string.\n\n"+
+			"Format:\n"+
+			"- A single key=value pair, can be provided multiple times\n"+
+			"- Example: --set-string app.version=1.20\n\n"+
+			"- Use it for the values which look numeric but must stay strings, such as versions or zip codes\n"+
+			"- A parameter cannot be set by both --set-string and --params, --params-env, --set-json or --set\n",
+	)
+
 	createCmd.Flags().StringArrayVarP(
 		&valuesFiles,
 		appFlags.Create.Values,
@@ -143,6 +263,32 @@ func initCreateCommonFlags() {
 			"Usage:\n"+
 			"- Can be provided multiple times; files are applied in order and later files override earlier ones\n",
 	)
+
+	createCmd.Flags().StringArrayVar(
+		&rawLabels,
+		appFlags.Create.Label,
+		[]string{},
+		"Custom label to set on the deployed resources, as a key=value pair (e.g. cost-center=1234)\n\n"+
+			"- Can be provided multiple times\n"+
+			"- The keys of the reserved ai-services.io/ namespace are rejected, and the labels set by the template are kept\n",
+	)
+	createCmd.Flags().StringArrayVar(
+		&rawAnnotations,
+		appFlags.Create.Annotation,
+		[]string{},
+		"Custom annotation to set on the deployed resources, as a key=value pair (e.g. team=search)\n\n"+
+			"- Can be provided multiple times\n"+
+			"- The keys of the reserved ai-services.io/ namespace are rejected, and the annotations set by the template are kept\n",
+	)
+
+	createCmd.Flags().StringVar(
+		&dumpManifestsDir,
+		appFlags.Create.DumpManifests,
+		"",
+		"Directory the final manifests are written to right before they are applied, to debug a deployment (e.g. ./manifests)\n\n"+
+			"- The manifests are the ones sent to the runtime, with the custom labels and annotations set\n"+
+			"- The directory is created if needed, and the files may hold secrets so they are only readable by the user\n",
+	)
 }
 
 func initCreatePodmanFlags() {
@@ -171,7 +317,18 @@ func initCreatePodmanFlags() {
 			"Note: Supported for podman runtime only.\n",
 	)
 
+	createCmd.Flags().DurationVar(
+		&waitForModel,
+		appFlags.Create.WaitForModel,
+		0,
+		"Wait up to the given duration (e.g. 30m) for the models referenced by the template to be staged\n"+
+			"into "+vars.ModelDirectory+"/ before deploying the pods, rather than letting them crash-loop\n\n"+
+			"The models are downloaded first, unless --skip-model-download is set, e.g. when staged by another process\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
 	initializeImagePullPolicyFlag()
+	initializeFromManifestFlag()
 
 	// deprecated flags
 	deprecatedPodmanFlags()
@@ -185,6 +342,22 @@ func initCreateOpenShiftFlags() {
 		"Timeout for the operation (e.g. 10s, 2m, 1h).\n"+
 			"Note: Supported for openshift runtime only.\n",
 	)
+	createCmd.Flags().DurationVar(
+		&applyTimeout,
+		appFlags.Create.ApplyTimeout,
+		0, // default
+		"Timeout of each request applying the resources of the application (e.g. 30s), apart from the readiness wait bounded by --timeout.\n"+
+			"The apply failing on a timeout, e.g. under a heavy load of the admission webhooks, is retried as set by --retry-count.\n"+
+			"Note: Supported for openshift runtime only.\n",
+	)
+	createCmd.Flags().BoolVar(
+		&dryRun,
+		appFlags.Create.DryRun,
+		false,
+		"Validate the resources of the application against the cluster with a server side dry-run, without persisting them.\n"+
+			"The API server reports the schema and admission errors, e.g. the rejections of the admission webhooks.\n"+
+			"Note: Supported for openshift runtime only.\n",
+	)
 }
 
 func initializeImagePullPolicyFlag() {
@@ -203,6 +376,73 @@ func initializeImagePullPolicyFlag() {
 	)
 }
 
+func initializeFromManifestFlag() {
+	createCmd.Flags().StringVar(
+		&manifestFile,
+		appFlags.Create.FromManifest,
+		"",
+		"Path of a hand-crafted manifest of pods to deploy in place of a template.\n\n"+
+			"The template rendering is bypassed, while the pods still get the ai-services.io labels\n"+
+			"and their spyre cards annotations are validated and allocated\n\n"+
+			"Note: Supported for podman runtime only.\n",
+	)
+
+	// the parameters only apply to the templates, --template is set to the one fetched from --template-url
+	createCmd.MarkFlagsOneRequired(appFlags.Create.Template, appFlags.Create.FromManifest)
+	for _, flag := range []string{appFlags.Create.Template, appFlags.Create.TemplateURL, appFlags.Create.TemplateVersion, appFlags.Create.Params, appFlags.Create.ParamsEnv, appFlags.Create.SetJSON, appFlags.Create.Set, appFlags.Create.SetString, appFlags.Create.Values} {
+		createCmd.MarkFlagsMutuallyExclusive(flag, appFlags.Create.FromManifest)
+	}
+}
+
+func initTemplateURLFlags() {
+	createCmd.Flags().StringVar(&templateURL, appFlags.Create.TemplateURL, "",
+		"URL of an application template bundle to deploy in place of a built-in template (e.g. https://example.com/rag.zip)\n\n"+
+			"- The bundle is a zip archive holding a single directory named after the template, laid out as the built-in ones\n"+
+			"- It must be served over HTTPS unless --insecure is set, and is limited to 20 MiB\n")
+	createCmd.Flags().StringVar(&templateChecksum, appFlags.Create.Checksum, "",
+		"Expected checksum of the bundle fetched from --template-url (e.g. sha256:<hex digest>), the deployment fails on a mismatch\n\n"+
+			"- When not set, the checksum of the <url>.sha256 sidecar file is verified if the server provides one\n")
+	createCmd.Flags().StringVar(&templateKeyFile, appFlags.Create.PublicKey, "",
+		"Path of the cosign public key verifying the signature of the bundle fetched from --template-url\n\n"+
+			"- The signature, as written by \"cosign sign-blob\", is fetched from the <url>.sig sidecar file\n")
+	createCmd.Flags().BoolVar(&insecureURL, appFlags.Create.Insecure, false,
+		"Allow fetching the bundle of --template-url over plain HTTP (insecure)")
+}
+
+// fetchTemplate fetches the application template bundle given by --template-url and selects its template as --template,
+// so that it is validated and deployed the same as a built-in one.
+func fetchTemplate(cmd *cobra.Command) error {
+	if !cmd.Flags().Changed(appFlags.Create.TemplateURL) {
+		for _, flag := range []string{appFlags.Create.Checksum, appFlags.Create.PublicKey, appFlags.Create.Insecure} {
+			if cmd.Flags().Changed(flag) {
+				return fmt.Errorf("--%s only applies along with --%s", flag, appFlags.Create.TemplateURL)
+			}
+		}
+
+		return nil
+	}
+	if cmd.Flags().Changed(appFlags.Create.Template) {
+		return fmt.Errorf("--%s and --%s cannot be used together", appFlags.Create.Template, appFlags.Create.TemplateURL)
+	}
+
+	opts := templates.RemoteOptions{Checksum: templateChecksum, Insecure: insecureURL}
+	if templateKeyFile != "" {
+		key, err := os.ReadFile(templateKeyFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the public key: %w", err)
+		}
+		opts.PublicKey = key
+	}
+
+	name, err := templates.FetchRemoteApplication(cmd.Context(), templateURL, opts)
+	if err != nil {
+		return err
+	}
+	logger.Infof("Using application template '%s' fetched from %s\n", name, templateURL)
+
+	return cmd.Flags().Set(appFlags.Create.Template, name)
+}
+
 func deprecatedPodmanFlags() {
 	if err := createCmd.Flags().MarkDeprecated(appFlags.Create.SkipImageDownload, "use --image-pull-policy instead"); err != nil {
 		panic(fmt.Sprintf("Failed to mark '%s' flag deprecated. Err: %v", appFlags.Create.SkipImageDownload, err))
@@ -219,46 +459,111 @@ func buildFlagValidator() *flagvalidator.FlagValidator {
 	builder.
 		AddCommonFlag(appFlags.Create.SkipValidation, nil).
 		AddCommonFlag(appFlags.Create.Template, validateTemplateFlag).
+		AddCommonFlag(appFlags.Create.TemplateVersion, nil).
+		AddCommonFlag(appFlags.Create.TemplateURL, nil).
+		AddCommonFlag(appFlags.Create.Checksum, nil).
+		AddCommonFlag(appFlags.Create.PublicKey, nil).
+		AddCommonFlag(appFlags.Create.Insecure, nil).
 		AddCommonFlag(appFlags.Create.Params, validateParamsFlag).
-		AddCommonFlag(appFlags.Create.Values, validateValuesFlag)
+		AddCommonFlag(appFlags.Create.ParamsEnv, validateParamsEnvFlag).
+		AddCommonFlag(appFlags.Create.SetJSON, validateSetJSONFlag).
+		AddCommonFlag(appFlags.Create.Set, validateSetFlag).
+		AddCommonFlag(appFlags.Create.SetString, validateSetStringFlag).
+		AddCommonFlag(appFlags.Create.Values, validateValuesFlag).
+		AddCommonFlag(appFlags.Create.Label, validateLabelFlag).
+		AddCommonFlag(appFlags.Create.Annotation, validateAnnotationFlag).
+		AddCommonFlag(appFlags.Create.DumpManifests, nil)
 
 	// Register Podman-specific flags
 	builder.
 		AddPodmanFlag(appFlags.Create.SkipImageDownload, nil).
 		AddPodmanFlag(appFlags.Create.SkipModelDownload, nil).
-		AddPodmanFlag(appFlags.Create.ImagePullPolicy, validateImagePullPolicyFlag)
+		AddPodmanFlag(appFlags.Create.WaitForModel, validateWaitForModelFlag).
+		AddPodmanFlag(appFlags.Create.ImagePullPolicy, validateImagePullPolicyFlag).
+		AddPodmanFlag(appFlags.Create.FromManifest, validateFromManifestFlag)
 
 	// Register OpenShift-specific flags
 	builder.
-		AddOpenShiftFlag(appFlags.Create.Timeout, nil)
+		AddOpenShiftFlag(appFlags.Create.Timeout, nil).
+		AddOpenShiftFlag(appFlags.Create.ApplyTimeout, validateApplyTimeoutFlag).
+		AddOpenShiftFlag(appFlags.Create.DryRun, nil)
 
 	return builder.Build()
 }
 
 // validateTemplateFlag validates the template flag.
 func validateTemplateFlag(cmd *cobra.Command) error {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return err
+	}
+
+	// deprecated aliases keep working, but are replaced by the current template name for the rest of the flow
+	templateName, err = tp.ResolveApplication(templateName)
+	if err != nil {
+		return fmt.Errorf("failed to resolve the application template: %w", err)
+	}
+
 	if err := validators.ValidateAppTemplateExist(tp, templateName); err != nil {
 		return err
 	}
 
+	// the template name is replaced by the reference of the pinned version, if any
+	templateName, err = tp.ResolveVersion(templateName, templateVersion)
+	if err != nil {
+		return err
+	}
+
+	// hidden templates are left out of the listing, but can still be deployed when requested by exact name
+	appMetadata, err := tp.LoadMetadata(templateName, false)
+	if err != nil {
+		return fmt.Errorf("failed to read the app metadata: %w", err)
+	}
+	if appMetadata.Hidden {
+		logger.Warningf("Application template '%s' is marked hidden/experimental and may not be fully supported\n", templateName)
+	}
+	// enforced by the spyre check of the bootstrap validation run before the deployment
+	vars.MinSpyreCards = appMetadata.MinSpyreCards
+
 	return nil
 }
 
-// validateParamsFlag validates the params flag.
+// validateParamsFlag validates the params, params-env, set-json, set and set-string flags.
 func validateParamsFlag(cmd *cobra.Command) error {
-	if len(rawArgParams) == 0 {
+	if len(rawArgParams) == 0 && len(rawArgEnvParams) == 0 && len(rawArgJSONParams) == 0 && len(rawArgSetParams) == 0 &&
+		len(rawArgStrParams) == 0 {
 		return nil
 	}
 
 	var err error
-	argParams, err = utils.ParseKeyValues(rawArgParams)
+	argParams, err = parseArgParams(rawArgParams, rawArgEnvParams)
 	if err != nil {
-		return fmt.Errorf("invalid format: %w", err)
+		return err
+	}
+
+	jsonParams, err := utils.ParseJSONParams(rawArgJSONParams)
+	if err != nil {
+		return err
 	}
 
 	// Validate params against template values
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+	if err != nil {
+		return err
+	}
+
+	if err := mergeJSONParams(tp, argParams, jsonParams); err != nil {
+		return err
+	}
+
+	if err := mergeSetParams(argParams, rawArgSetParams); err != nil {
+		return err
+	}
+
+	if err := mergeStringParams(argParams, rawArgStrParams); err != nil {
+		return err
+	}
+
 	_, err = tp.LoadValues(templateName, valuesFiles, argParams)
 	if err != nil {
 		return fmt.Errorf("failed to load params: %w", err)
@@ -267,6 +572,162 @@ func validateParamsFlag(cmd *cobra.Command) error {
 	return nil
 }
 
+// validateParamsEnvFlag validates the params-env flag, unless already done along with the params flag.
+func validateParamsEnvFlag(cmd *cobra.Command) error {
+	if cmd.Flags().Changed(appFlags.Create.Params) {
+		return nil
+	}
+
+	return validateParamsFlag(cmd)
+}
+
+// validateSetJSONFlag validates the set-json flag, unless already done along with the params or params-env flags.
+func validateSetJSONFlag(cmd *cobra.Command) error {
+	if cmd.Flags().Changed(appFlags.Create.Params) || cmd.Flags().Changed(appFlags.Create.ParamsEnv) {
+		return nil
+	}
+
+	return validateParamsFlag(cmd)
+}
+
+// validateSetFlag validates the set flag, unless already done along with the params, params-env or set-json flags.
+func validateSetFlag(cmd *cobra.Command) error {
+	if cmd.Flags().Changed(appFlags.Create.Params) || cmd.Flags().Changed(appFlags.Create.ParamsEnv) ||
+		cmd.Flags().Changed(appFlags.Create.SetJSON) {
+		return nil
+	}
+
+	return validateParamsFlag(cmd)
+}
+
+// validateSetStringFlag validates the set-string flag, unless already done along with the params, params-env, set-json or set flags.
+func validateSetStringFlag(cmd *cobra.Command) error {
+	if cmd.Flags().Changed(appFlags.Create.Params) || cmd.Flags().Changed(appFlags.Create.ParamsEnv) ||
+		cmd.Flags().Changed(appFlags.Create.SetJSON) || cmd.Flags().Changed(appFlags.Create.Set) {
+		return nil
+	}
+
+	return validateParamsFlag(cmd)
+}
+
+// validateLabelFlag validates the custom labels.
+func validateLabelFlag(cmd *cobra.Command) error {
+	labels, err := specs.ParseLabels(rawLabels)
+	if err != nil {
+		return err
+	}
+	metadata.Labels = labels
+
+	return nil
+}
+
+// validateAnnotationFlag validates the custom annotations.
+func validateAnnotationFlag(cmd *cobra.Command) error {
+	annotations, err := specs.ParseAnnotations(rawAnnotations)
+	if err != nil {
+		return err
+	}
+	metadata.Annotations = annotations
+
+	return nil
+}
+
+// parseArgParams parses the key=value params along with the key=ENV_VAR ones, resolved from the environment.
+func parseArgParams(rawParams, rawEnvParams []string) (map[string]string, error) {
+	params, err := utils.ParseKeyValues(rawParams)
+	if err != nil {
+		return nil, fmt.Errorf("invalid format: %w", err)
+	}
+
+	envParams, err := utils.ParseEnvParams(rawEnvParams)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, value := range envParams {
+		if _, ok := params[key]; ok {
+			return nil, fmt.Errorf("parameter '%s' is set by both --params and --params-env", key)
+		}
+		params[key] = value
+	}
+
+	return params, nil
+}
+
+// mergeJSONParams adds the params decoded from JSON to the given params.
+// The objects and arrays are passed on as JSON, decoded by the template according to the declared parameter type.
+func mergeJSONParams(tp templates.Template, params map[string]string, jsonParams map[string]any) error {
+	if len(jsonParams) == 0 {
+		return nil
+	}
+
+	appMetadata, err := tp.LoadMetadata(templateName, false)
+	if err != nil {
+		return fmt.Errorf("failed to load metadata: %w", err)
+	}
+
+	for key, value := range jsonParams {
+		if _, ok := params[key]; ok {
+			return fmt.Errorf("parameter '%s' is set by both --%s and --params or --params-env", key, appFlags.Create.SetJSON)
+		}
+
+		switch v := value.(type) {
+		case string:
+			params[key] = v
+
+			continue
+		case map[string]any, []any:
+			if paramType := appMetadata.ParamTypes[key]; paramType != templates.ParamTypeObject && paramType != templates.ParamTypeArray {
+				return fmt.Errorf("parameter '%s' does not accept a JSON object or array, "+
+					"only the parameters declared with the object and array types do", key)
+			}
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to encode parameter '%s': %w", key, err)
+		}
+		params[key] = string(raw)
+	}
+
+	return nil
+}
+
+// mergeSetParams adds the key=value params given with --set, whose keys may index the lists of the values, to the given params.
+func mergeSetParams(params map[string]string, rawSetParams []string) error {
+	setParams, err := utils.ParseKeyValues(rawSetParams)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range setParams {
+		if _, ok := params[key]; ok {
+			return fmt.Errorf("parameter '%s' is set by both --%s and --params, --params-env or --%s", key, appFlags.Create.Set, appFlags.Create.SetJSON)
+		}
+		params[key] = value
+	}
+
+	return nil
+}
+
+// mergeStringParams adds the key=value params forced to a string, whatever the declared parameter type, to the given params.
+func mergeStringParams(params map[string]string, rawStrParams []string) error {
+	strParams, err := utils.ParseKeyValues(rawStrParams)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range strParams {
+		if _, ok := params[key]; ok {
+			return fmt.Errorf("parameter '%s' is set by both --%s and --params, --params-env, --%s or --%s", key, appFlags.Create.SetString,
+				appFlags.Create.SetJSON, appFlags.Create.Set)
+		}
+		params[templates.StringParam(key)] = value
+	}
+
+	return nil
+}
+
 // validateValuesFlag validates the values flag.
 func validateValuesFlag(cmd *cobra.Command) error {
 	for _, vf := range valuesFiles {
@@ -278,6 +739,33 @@ func validateValuesFlag(cmd *cobra.Command) error {
 	return nil
 }
 
+// validateFromManifestFlag validates the from-manifest flag.
+func validateFromManifestFlag(cmd *cobra.Command) error {
+	if !utils.FileExists(manifestFile) {
+		return fmt.Errorf("file '%s' does not exist", manifestFile)
+	}
+
+	return nil
+}
+
+// validateWaitForModelFlag validates the wait-for-model flag.
+func validateWaitForModelFlag(cmd *cobra.Command) error {
+	if waitForModel < 0 {
+		return fmt.Errorf("invalid --%s %s, must not be negative", appFlags.Create.WaitForModel, waitForModel)
+	}
+
+	return nil
+}
+
+// validateApplyTimeoutFlag validates the apply-timeout flag.
+func validateApplyTimeoutFlag(cmd *cobra.Command) error {
+	if applyTimeout < 0 {
+		return fmt.Errorf("invalid --%s %s, must not be negative", appFlags.Create.ApplyTimeout, applyTimeout)
+	}
+
+	return nil
+}
+
 // validateImagePullPolicyFlag validates the image-pull-policy flag.
 func validateImagePullPolicyFlag(cmd *cobra.Command) error {
 	if ok := image.ImagePullPolicy(rawArgImagePullPolicy).Valid(); !ok {
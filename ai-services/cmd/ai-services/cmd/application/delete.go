@@ -8,6 +8,8 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -15,8 +17,9 @@ import (
 )
 
 var (
-	skipCleanup   bool
-	deleteTimeout time.Duration
+	skipCleanup          bool
+	deleteTimeout        time.Duration
+	deleteIgnoreNotFound bool
 )
 
 var deleteCmd = &cobra.Command{
@@ -24,9 +27,13 @@ var deleteCmd = &cobra.Command{
 	Short: "Delete an application",
 	Long: `Deletes an application and all associated resources.
 
+Every resource is attempted even when the deletion of another one fails, the command then reports
+the resources which were deleted and the ones which failed along with why, and exits with a non-zero code.
+
 Arguments
   [name]: Application name (required)`,
-	Args: cobra.ExactArgs(1),
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		// Build and run flag validator
 		flagValidator := buildDeleteFlagValidator()
@@ -54,10 +61,11 @@ Arguments
 		}
 
 		opts := appTypes.DeleteOptions{
-			Name:        applicationName,
-			AutoYes:     autoYes,
-			SkipCleanup: skipCleanup,
-			Timeout:     deleteTimeout,
+			Name:           applicationName,
+			AutoYes:        autoYes,
+			SkipCleanup:    skipCleanup,
+			IgnoreNotFound: deleteIgnoreNotFound,
+			Timeout:        deleteTimeout,
 		}
 
 		return app.Delete(cmd.Context(), opts)
@@ -66,6 +74,7 @@ Arguments
 }
 
 func init() {
+	audit.MarkMutating(deleteCmd)
 	initDeleteCommonFlags()
 	initDeleteOpenShiftFlags()
 }
@@ -73,6 +82,8 @@ func init() {
 func initDeleteCommonFlags() {
 	deleteCmd.Flags().BoolVar(&skipCleanup, appFlags.Delete.SkipCleanup, false, "Skip deleting application data (default=false)")
 	deleteCmd.Flags().BoolVarP(&autoYes, appFlags.Delete.AutoYes, "y", false, "Automatically accept all confirmation prompts (default=false)")
+	deleteCmd.Flags().BoolVar(&deleteIgnoreNotFound, appFlags.Delete.IgnoreNotFound, false,
+		"Treat the application or its resources already gone as deleted, instead of failing (default=false)")
 }
 
 func initDeleteOpenShiftFlags() {
@@ -94,7 +105,8 @@ func buildDeleteFlagValidator() *flagvalidator.FlagValidator {
 	// Register common flags
 	builder.
 		AddCommonFlag(appFlags.Delete.SkipCleanup, nil).
-		AddCommonFlag(appFlags.Delete.AutoYes, nil)
+		AddCommonFlag(appFlags.Delete.AutoYes, nil).
+		AddCommonFlag(appFlags.Delete.IgnoreNotFound, nil)
 
 	// Register OpenShift-specific flags
 	builder.
@@ -0,0 +1,125 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	diffTemplateName string
+	diffRawParams    []string
+	diffRawEnvParams []string
+	diffValuesFiles  []string
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff [name]",
+	Short: "Shows what re-deploying an application would change",
+	Long: `Renders the application template with the given parameters and compares it with the deployed resources
+		Arguments
+		- [name]: Application name (Required)
+	`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		for _, vf := range diffValuesFiles {
+			if !utils.FileExists(vf) {
+				return fmt.Errorf("file '%s' does not exist", vf)
+			}
+		}
+
+		return utils.VerifyAppName(args[0])
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		appName := args[0]
+
+		params, err := parseArgParams(diffRawParams, diffRawEnvParams)
+		if err != nil {
+			return err
+		}
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		templateName := diffTemplateName
+		if templateName != "" {
+			tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: rt})
+			if err != nil {
+				return fmt.Errorf("failed to load application templates: %w", err)
+			}
+			if templateName, err = tp.ResolveApplication(templateName); err != nil {
+				return fmt.Errorf("failed to resolve the application template: %w", err)
+			}
+		}
+
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(appName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		changes, err := app.Diff(cmd.Context(), appTypes.DiffOptions{
+			Name:         appName,
+			TemplateName: templateName,
+			ArgParams:    params,
+			ValuesFiles:  diffValuesFiles,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to compare application '%s': %w", appName, err)
+		}
+
+		printDiff(appName, changes)
+
+		return nil
+	},
+}
+
+func init() {
+	diffCmd.Flags().StringVarP(&diffTemplateName, appFlags.Diff.Template, "t", "",
+		"Application template to compare with (defaults to the template the application was deployed with)")
+	_ = diffCmd.RegisterFlagCompletionFunc(appFlags.Diff.Template, completion.TemplateNames)
+	diffCmd.Flags().StringSliceVar(&diffRawParams, appFlags.Diff.Params, []string{},
+		"Inline parameters to render the application with, as comma-separated key=value pairs (same as for create)")
+	diffCmd.Flags().StringSliceVar(&diffRawEnvParams, appFlags.Diff.ParamsEnv, []string{},
+		"Inline parameters whose values are read from environment variables, as comma-separated key=ENV_VAR pairs (same as for create)")
+	diffCmd.Flags().StringArrayVarP(&diffValuesFiles, appFlags.Diff.Values, "f", []string{},
+		"Values files to render the application with (same as for create)")
+}
+
+var diffSymbols = map[diff.ChangeType]string{
+	diff.Added:   "+",
+	diff.Removed: "-",
+	diff.Changed: "~",
+}
+
+func printDiff(appName string, changes []diff.Change) {
+	if len(changes) == 0 {
+		logger.Resultf("No changes: application '%s' matches its template\n", appName)
+
+		return
+	}
+
+	counts := map[diff.ChangeType]int{}
+	for _, c := range changes {
+		counts[c.Type]++
+		logger.Resultf("%s %s/%s (%s)\n", diffSymbols[c.Type], c.Kind, c.Name, c.Type)
+		for _, field := range c.Fields {
+			logger.Resultln("    " + field)
+		}
+	}
+
+	logger.Resultf("\n%d added, %d removed, %d changed\n", counts[diff.Added], counts[diff.Removed], counts[diff.Changed])
+}
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
+	"github.com/project-ai-services/ai-services/internal/pkg/lock"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	execPodName       string
+	execContainerName string
+)
+
+var execCmd = &cobra.Command{
+	Use:   "exec [name] -- [command...]",
+	Short: "Runs a command inside an application container",
+	Long: `Runs a command inside a container of the application, for debugging it
+A terminal is allocated when run from an interactive terminal
+		Arguments
+		- [name]: Application name (Required)
+		- [command...]: Command to run, after -- (Required)
+	`,
+	Example: `  # open a shell in the vllm container of the application
+  ai-services application exec rag --container vllm -- /bin/bash
+
+  # run a command in the only container of the application
+  ai-services application exec rag -- ls /models`,
+	Args:              cobra.MinimumNArgs(2),
+	ValidArgsFunction: completion.ApplicationNames,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		flagValidator := buildExecFlagValidator()
+		if err := flagValidator.Validate(cmd); err != nil {
+			return err
+		}
+
+		// the flags of the command are only left to it after --, so only the application name is expected before
+		switch dash := cmd.ArgsLenAtDash(); {
+		case dash == 0:
+			return errors.New("application name must be given before --")
+		case dash > 1:
+			return errors.New("only the application name is expected before --")
+		}
+
+		return utils.VerifyAppName(args[0])
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory
+		factory := application.NewFactory(rt)
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		opts := appTypes.ExecOptions{
+			Name:          applicationName,
+			PodName:       execPodName,
+			ContainerName: execContainerName,
+			Command:       args[1:],
+			Stdin:         os.Stdin,
+			Stdout:        os.Stdout,
+			Stderr:        os.Stderr,
+			TTY:           term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd())),
+		}
+
+		return app.Exec(opts)
+	},
+}
+
+func init() {
+	// the command run inside the container can change it, so it is audited as well
+	audit.MarkMutating(execCmd)
+	// an interactive session would hold off every other operation for as long as it is open
+	lock.Exempt(execCmd)
+	execCmd.Flags().StringVarP(&execContainerName, appFlags.Exec.Container, "c", "",
+		"Container to run the command in, by its name in the template (optional for an application with a single container)")
+	execCmd.Flags().StringVar(&execPodName, appFlags.Exec.Pod, "",
+		"Pod of the container, when several pods of the application run a container of the same name (optional)")
+}
+
+// buildExecFlagValidator creates and configures the flag validator for the exec command.
+func buildExecFlagValidator() *flagvalidator.FlagValidator {
+	runtimeType := vars.RuntimeFactory.GetRuntimeType()
+
+	builder := flagvalidator.NewFlagValidatorBuilder(runtimeType)
+
+	// Register common flags
+	builder.
+		AddCommonFlag(appFlags.Exec.Pod, nil).
+		AddCommonFlag(appFlags.Exec.Container, nil)
+
+	return builder.Build()
+}
@@ -0,0 +1,329 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	// defaultHealthInterval is the interval between the redraws of --watch.
+	defaultHealthInterval = 5 * time.Second
+
+	// clearScreen moves the cursor home and clears the terminal, to redraw the status in place.
+	clearScreen = "\033[H\033[2J"
+)
+
+var (
+	healthOutput    string
+	healthWatch     bool
+	healthInterval  time.Duration
+	healthWaitReady bool
+)
+
+var healthCmd = &cobra.Command{
+	Use:     "health [name]",
+	Aliases: []string{"status"},
+	Short:   "Reports the health of all the deployed applications",
+	Long: `Reports the rolled-up health of every application deployed from a template, or only of the given one:
+  - Ready: all the pods of the application are ready
+  - Degraded: only some of the pods are ready, or the spyre cards used by the application are not bound to vfio-pci
+  - Failed: none of the pods are ready, e.g. a stopped application
+On podman, the spyre cards reserved by each application are listed as well, those cards are not
+allocated to another application until it is deleted
+Exits with a non-zero code when any application is failed
+
+With --watch, the health is redrawn every --interval until the applications are Ready or the command is
+interrupted, as a compact table in place on a terminal. With --wait-ready, it waits for the applications
+to be Ready, and exits with a non-zero code when they are not once interrupted or the --global-timeout is exceeded`,
+	Example: `  # report the health of all the applications
+  ai-services application health
+
+  # report it as JSON, e.g. for a monitoring script
+  ai-services application health --output json
+
+  # watch an application come up after a deployment
+  ai-services application status rag --watch
+
+  # wait up to 20 minutes for an application to be ready in a script
+  ai-services application status rag --watch --wait-ready --global-timeout 20m`,
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		flagValidator := buildHealthFlagValidator()
+		if err := flagValidator.Validate(cmd); err != nil {
+			return err
+		}
+		if len(args) == 1 {
+			return utils.VerifyAppName(args[0])
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		name := ""
+		if len(args) == 1 {
+			name = args[0]
+		}
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+
+		// Create application instance using factory, on openshift an empty namespace lists the pods of all the namespaces
+		factory := application.NewFactory(rt)
+		app, err := factory.Create("")
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		if healthWatch || healthWaitReady {
+			return watchHealth(cmd.Context(), app, name)
+		}
+
+		healths, err := fetchHealths(app, name)
+		if err != nil {
+			return err
+		}
+		if name != "" && len(healths) == 0 {
+			return fmt.Errorf("application %s not found", name)
+		}
+
+		if err := renderHealths(healths, false); err != nil {
+			return err
+		}
+
+		return failedApplications(healths)
+	},
+}
+
+func init() {
+	healthCmd.Flags().StringVarP(&healthOutput, appFlags.Health.Output, "o", "", "Output format (json or yaml)")
+	healthCmd.Flags().BoolVar(&healthWatch, appFlags.Health.Watch, false,
+		"Redraw the health every --interval until the applications are Ready or the command is interrupted")
+	healthCmd.Flags().DurationVar(&healthInterval, appFlags.Health.Interval, defaultHealthInterval,
+		"Interval between the health checks of --watch and --wait-ready (e.g. 10s)")
+	healthCmd.Flags().BoolVar(&healthWaitReady, appFlags.Health.WaitReady, false,
+		"Wait for the applications to be Ready, exiting with a non-zero code when they are not once interrupted or timed out")
+}
+
+// buildHealthFlagValidator creates and configures the flag validator for the health command.
+func buildHealthFlagValidator() *flagvalidator.FlagValidator {
+	runtimeType := vars.RuntimeFactory.GetRuntimeType()
+
+	builder := flagvalidator.NewFlagValidatorBuilder(runtimeType)
+
+	// Register common flags
+	builder.
+		AddCommonFlag(appFlags.Health.Output, func(cmd *cobra.Command) error {
+			format, err := output.Parse(healthOutput)
+			if err == nil && format.Structured() && healthWatch {
+				return fmt.Errorf("--%s cannot be combined with --%s, use --%s to print the final health only",
+					appFlags.Health.Output, appFlags.Health.Watch, appFlags.Health.WaitReady)
+			}
+
+			return err
+		}).
+		AddCommonFlag(appFlags.Health.Watch, nil).
+		AddCommonFlag(appFlags.Health.Interval, func(cmd *cobra.Command) error {
+			if healthInterval <= 0 {
+				return fmt.Errorf("invalid --%s %s, must be positive", appFlags.Health.Interval, healthInterval)
+			}
+
+			return nil
+		}).
+		AddCommonFlag(appFlags.Health.WaitReady, nil)
+
+	return builder.Build()
+}
+
+// fetchHealths returns the health of the applications, only the one of the named application when name is set.
+func fetchHealths(app application.Application, name string) ([]appTypes.ApplicationHealth, error) {
+	healths, err := app.Health()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the health of the applications: %w", err)
+	}
+	if name == "" {
+		return healths, nil
+	}
+
+	return slices.DeleteFunc(healths, func(h appTypes.ApplicationHealth) bool { return h.Name != name }), nil
+}
+
+// renderHealths prints the health in the format given by --output, as a compact table when compact is set.
+func renderHealths(healths []appTypes.ApplicationHealth, compact bool) error {
+	if format, _ := output.Parse(healthOutput); format.Structured() {
+		return output.Print(healths, format)
+	}
+	if compact {
+		printCompactHealths(healths)
+	} else {
+		printHealths(healths)
+	}
+
+	return nil
+}
+
+// watchHealth polls the health every --interval until the applications are Ready or ctx is done,
+// redrawing it for --watch. The outcome is the one of the last poll, the readiness being required by --wait-ready.
+func watchHealth(ctx context.Context, app application.Application, name string) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tty := logger.IsTerminal()
+	ticker := time.NewTicker(healthInterval)
+	defer ticker.Stop()
+
+	var healths []appTypes.ApplicationHealth
+	for {
+		var err error
+		healths, err = fetchHealths(app, name)
+		if err != nil {
+			return err
+		}
+		ready := allReady(healths)
+
+		if healthWatch {
+			header := fmt.Sprintf("%s, every %s until Ready, Ctrl-C to stop", time.Now().Format(time.TimeOnly), healthInterval)
+			if tty {
+				header = clearScreen + header
+			}
+			logger.Resultln(header)
+			if err := renderHealths(healths, tty); err != nil {
+				return err
+			}
+		}
+		if ready {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Infoln("Stopped watching the health")
+
+			return watchOutcome(healths, name)
+		case <-ticker.C:
+		}
+	}
+
+	if !healthWatch {
+		if err := renderHealths(healths, false); err != nil {
+			return err
+		}
+	}
+
+	return watchOutcome(healths, name)
+}
+
+// watchOutcome returns the error of the last health polled: the applications not Ready with --wait-ready,
+// the failed applications otherwise as reported without watching.
+func watchOutcome(healths []appTypes.ApplicationHealth, name string) error {
+	if !healthWaitReady {
+		return failedApplications(healths)
+	}
+	if name != "" && len(healths) == 0 {
+		return fmt.Errorf("application %s not found", name)
+	}
+
+	var notReady []string
+	for _, health := range healths {
+		if health.State != appTypes.HealthReady {
+			notReady = append(notReady, fmt.Sprintf("%s (%s)", health.Name, health.State))
+		}
+	}
+	if len(notReady) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("application(s) not ready: %s", strings.Join(notReady, ", "))
+}
+
+// allReady tells whether there are applications and all of them are Ready.
+func allReady(healths []appTypes.ApplicationHealth) bool {
+	if len(healths) == 0 {
+		return false
+	}
+	for _, health := range healths {
+		if health.State != appTypes.HealthReady {
+			return false
+		}
+	}
+
+	return true
+}
+
+// printCompactHealths prints the state of the applications only, fitting a terminal redrawn by --watch.
+func printCompactHealths(healths []appTypes.ApplicationHealth) {
+	if len(healths) == 0 {
+		logger.Resultln("No applications found.")
+
+		return
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("APPLICATION NAME", "STATE", "READY")
+	for _, health := range healths {
+		printer.AppendRow(health.Name, string(health.State), strconv.Itoa(health.ReadyReplicas)+"/"+strconv.Itoa(health.Replicas))
+	}
+}
+
+func printHealths(healths []appTypes.ApplicationHealth) {
+	if len(healths) == 0 {
+		logger.Infoln("No applications found.")
+
+		return
+	}
+
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("APPLICATION NAME", "TEMPLATE", "STATE", "READY", "SPYRE CARDS", "ISSUES")
+	for _, health := range healths {
+		issues := "none"
+		if len(health.Issues) > 0 {
+			issues = strings.Join(health.Issues, "; ")
+		}
+		cards := "none"
+		if len(health.SpyreCards) > 0 {
+			cards = strings.Join(health.SpyreCards, ", ")
+		}
+		printer.AppendRow(health.Name, health.Template, string(health.State),
+			strconv.Itoa(health.ReadyReplicas)+"/"+strconv.Itoa(health.Replicas), cards, issues)
+	}
+}
+
+// failedApplications returns an error listing the failed applications, nil for none.
+func failedApplications(healths []appTypes.ApplicationHealth) error {
+	var failed []string
+	for _, health := range healths {
+		if health.State == appTypes.HealthFailed {
+			failed = append(failed, health.Name)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("application(s) failed: %s", strings.Join(failed, ", "))
+}
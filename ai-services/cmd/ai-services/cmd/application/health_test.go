@@ -0,0 +1,99 @@
+package application
+
+import (
+	"testing"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+func newHealths(states ...appTypes.HealthState) []appTypes.ApplicationHealth {
+	names := []string{"rag", "chat", "summarize"}
+	healths := make([]appTypes.ApplicationHealth, 0, len(states))
+	for i, state := range states {
+		healths = append(healths, appTypes.ApplicationHealth{Name: names[i], State: state})
+	}
+
+	return healths
+}
+
+func TestAllReady(t *testing.T) {
+	tests := []struct {
+		name    string
+		healths []appTypes.ApplicationHealth
+		want    bool
+	}{
+		{name: "no application", want: false},
+		{name: "all ready", healths: newHealths(appTypes.HealthReady, appTypes.HealthReady), want: true},
+		{name: "one degraded", healths: newHealths(appTypes.HealthReady, appTypes.HealthDegraded), want: false},
+		{name: "one failed", healths: newHealths(appTypes.HealthFailed, appTypes.HealthReady), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allReady(tt.healths); got != tt.want {
+				t.Errorf("allReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWatchOutcome(t *testing.T) {
+	tests := []struct {
+		name      string
+		waitReady bool
+		healths   []appTypes.ApplicationHealth
+		app       string
+		wantErr   string
+	}{
+		{
+			name:    "degraded without --wait-ready",
+			healths: newHealths(appTypes.HealthReady, appTypes.HealthDegraded),
+		},
+		{
+			name:    "failed without --wait-ready",
+			healths: newHealths(appTypes.HealthFailed, appTypes.HealthReady, appTypes.HealthFailed),
+			wantErr: "application(s) failed: rag, summarize",
+		},
+		{
+			name:      "ready with --wait-ready",
+			waitReady: true,
+			healths:   newHealths(appTypes.HealthReady, appTypes.HealthReady),
+		},
+		{
+			name:      "not ready with --wait-ready",
+			waitReady: true,
+			healths:   newHealths(appTypes.HealthReady, appTypes.HealthDegraded, appTypes.HealthFailed),
+			wantErr:   "application(s) not ready: chat (Degraded), summarize (Failed)",
+		},
+		{
+			name:      "named application not found with --wait-ready",
+			waitReady: true,
+			app:       "rag",
+			wantErr:   "application rag not found",
+		},
+		{
+			name: "named application not found without --wait-ready",
+			app:  "rag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orig := healthWaitReady
+			t.Cleanup(func() { healthWaitReady = orig })
+			healthWaitReady = tt.waitReady
+
+			err := watchOutcome(tt.healths, tt.app)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("watchOutcome() unexpected error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("watchOutcome() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
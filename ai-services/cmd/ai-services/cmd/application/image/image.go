@@ -2,6 +2,9 @@ package image
 
 import (
 	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 )
 
 var templateName string
@@ -19,6 +22,8 @@ var ImageCmd = &cobra.Command{
 func init() {
 	ImageCmd.AddCommand(listCmd)
 	ImageCmd.AddCommand(pullCmd)
+	audit.MarkMutating(pullCmd)
 	ImageCmd.PersistentFlags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
 	_ = ImageCmd.MarkPersistentFlagRequired("template")
+	_ = ImageCmd.RegisterFlagCompletionFunc("template", completion.TemplateNames)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
@@ -17,7 +18,8 @@ var infoCmd = &cobra.Command{
 		Arguments
 		- [name]: Application name (Required)
 	`,
-	Args: cobra.ExactArgs(1),
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// fetch application name
 		applicationName := args[0]
@@ -2,9 +2,11 @@ package application
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
@@ -14,6 +16,9 @@ import (
 var (
 	podName           string
 	containerNameOrID string
+	// logsSinceValue is the value of --since, parsed into logsSince.
+	logsSinceValue string
+	logsSince      time.Duration
 )
 
 var logsCmd = &cobra.Command{
@@ -21,7 +26,16 @@ var logsCmd = &cobra.Command{
 	Long: `Displays logs from an application pod
 Arguments
 [name]: Application name (required)`,
-	Args: cobra.ExactArgs(1),
+	Example: `  # follow the logs of a pod of the application
+  ai-services application logs <name> --pod <pod>
+
+  # follow the logs of a container, starting from the lines of the last 10 minutes
+  ai-services application logs <name> --pod <pod> --container <container> --since 10m
+
+  # follow the logs of a pod, starting from the lines written since the given time
+  ai-services application logs <name> --pod <pod> --since 2026-01-02T15:04:05Z`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		// Build and run flag validator
 		flagValidator := buildLogsFlagValidator()
@@ -33,6 +47,12 @@ Arguments
 			return fmt.Errorf("pod name must be specified using --pod flag")
 		}
 
+		since, err := parseSince(logsSinceValue, time.Now())
+		if err != nil {
+			return err
+		}
+		logsSince = since
+
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
@@ -54,6 +74,7 @@ Arguments
 		opts := appTypes.LogsOptions{
 			PodName:           podName,
 			ContainerNameOrID: containerNameOrID,
+			Since:             logsSince,
 		}
 
 		return app.Logs(opts)
@@ -67,9 +88,39 @@ func init() {
 func initLogsCommonFlags() {
 	logsCmd.Flags().StringVar(&podName, appFlags.Logs.Pod, "", "Pod name to show logs from (required)")
 	logsCmd.Flags().StringVar(&containerNameOrID, appFlags.Logs.Container, "", "Container logs to show logs from (Optional)")
+	logsCmd.Flags().StringVar(&logsSinceValue, appFlags.Logs.Since, "",
+		"Only show the logs newer than the duration (e.g. 30s, 10m, 1h) or than the RFC3339 timestamp (e.g. 2026-01-02T15:04:05Z) "+
+			"before following them, all the logs when not set")
 	_ = logsCmd.MarkFlagRequired(appFlags.Logs.Pod)
 }
 
+// parseSince parses the value of --since, a duration or an RFC3339 timestamp, into the duration the logs are limited to
+// as of now. An empty value does not limit the logs.
+func parseSince(value string, now time.Time) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	if since, err := time.ParseDuration(value); err == nil {
+		if since < 0 {
+			return 0, fmt.Errorf("--%s must not be negative, got: %s", appFlags.Logs.Since, value)
+		}
+
+		return since, nil
+	}
+
+	ts, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s '%s', expected a duration (e.g. 10m) or an RFC3339 timestamp (e.g. 2026-01-02T15:04:05Z)",
+			appFlags.Logs.Since, value)
+	}
+	if ts.After(now) {
+		return 0, fmt.Errorf("--%s must not be in the future, got: %s", appFlags.Logs.Since, value)
+	}
+
+	return now.Sub(ts), nil
+}
+
 // buildLogsFlagValidator creates and configures the flag validator for the logs command.
 func buildLogsFlagValidator() *flagvalidator.FlagValidator {
 	runtimeType := vars.RuntimeFactory.GetRuntimeType()
@@ -79,7 +130,8 @@ func buildLogsFlagValidator() *flagvalidator.FlagValidator {
 	// Register common flags
 	builder.
 		AddCommonFlag(appFlags.Logs.Pod, nil).
-		AddCommonFlag(appFlags.Logs.Container, nil)
+		AddCommonFlag(appFlags.Logs.Container, nil).
+		AddCommonFlag(appFlags.Logs.Since, nil)
 
 	return builder.Build()
 }
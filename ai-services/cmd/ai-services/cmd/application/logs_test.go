@@ -0,0 +1,61 @@
+package application
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Duration
+		wantErr string
+	}{
+		{name: "not set"},
+		{name: "seconds", value: "30s", want: 30 * time.Second},
+		{name: "compound duration", value: "1h30m", want: 90 * time.Minute},
+		{name: "zero duration", value: "0s"},
+		{name: "UTC timestamp", value: "2026-01-02T14:04:05Z", want: time.Hour},
+		{name: "timestamp with an offset", value: "2026-01-02T16:54:05+02:00", want: 10 * time.Minute},
+		{name: "timestamp with fractional seconds", value: "2026-01-02T15:04:04.5Z", want: 500 * time.Millisecond},
+		{name: "negative duration", value: "-5m", wantErr: "--since must not be negative, got: -5m"},
+		{name: "future timestamp", value: "2026-01-02T15:05:00Z", wantErr: "--since must not be in the future, got: 2026-01-02T15:05:00Z"},
+		{
+			name:    "number without unit",
+			value:   "10",
+			wantErr: "invalid --since '10', expected a duration (e.g. 10m) or an RFC3339 timestamp (e.g. 2026-01-02T15:04:05Z)",
+		},
+		{
+			name:    "date without time",
+			value:   "2026-01-02",
+			wantErr: "invalid --since '2026-01-02', expected a duration (e.g. 10m) or an RFC3339 timestamp (e.g. 2026-01-02T15:04:05Z)",
+		},
+		{
+			name:    "timestamp without zone",
+			value:   "2026-01-02T14:04:05",
+			wantErr: "invalid --since '2026-01-02T14:04:05', expected a duration (e.g. 10m) or an RFC3339 timestamp (e.g. 2026-01-02T15:04:05Z)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSince(tt.value, now)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("parseSince() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSince() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("parseSince() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
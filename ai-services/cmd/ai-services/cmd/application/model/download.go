@@ -2,7 +2,12 @@ package model
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/checksum"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -13,8 +18,36 @@ import (
 var downloadCmd = &cobra.Command{
 	Use:   "download",
 	Short: "Download models for a given application template",
-	Long:  ``,
-	Args:  cobra.MaximumNArgs(0),
+	Long: `Downloads the models referenced by the application template into the models directory.
+
+		The model files shipped along with a .sha256 sidecar file, Eg:- by a mirror, are verified
+		once downloaded, and the download fails when one of them does not match its checksum.
+
+		The whole model directory is verified against the checksum given by --checksum, computed from
+		within the directory of a trusted copy of the model with:
+		  find . -type f ! -name '*.sha256' ! -path '*/.*' | cut -c3- | LC_ALL=C sort | xargs sha256sum | sha256sum
+	`,
+	Example: `  # Download the models of the RAG template
+  ai-services application model download --template rag
+
+  # Download the model of a single model template, verifying it against its checksum
+  ai-services application model download --template <template> --checksum sha256:<hex>
+
+  # Verify each model of the template against its checksum
+  ai-services application model download --template rag --checksum <model>=sha256:<hex> --checksum <model>=sha256:<hex>`,
+	Args: cobra.MaximumNArgs(0),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if vars.ModelDownloadConcurrency < 1 {
+			return fmt.Errorf("--concurrency must be at least 1, got %d", vars.ModelDownloadConcurrency)
+		}
+		for _, value := range checksums {
+			if _, err := checksum.Parse(value[strings.Index(value, "=")+1:]); err != nil {
+				return fmt.Errorf("invalid --checksum: %w", err)
+			}
+		}
+
+		return nil
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
@@ -25,11 +58,42 @@ var downloadCmd = &cobra.Command{
 }
 
 func init() {
+	audit.MarkMutating(downloadCmd)
 	downloadCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name(Required)")
+	_ = downloadCmd.RegisterFlagCompletionFunc("template", completion.TemplateNames)
 	_ = downloadCmd.MarkFlagRequired("template")
-	downloadCmd.Flags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool container image used for downloading the model (for development purposes only)")
+	downloadCmd.Flags().StringVar(&vars.ToolImage, "tool-image", vars.ToolImage, "Tool container image used for downloading the model (for development purposes only), pinned by tag or by digest (registry/repo@sha256:...)")
 	_ = downloadCmd.Flags().MarkHidden("tool-image")
 	downloadCmd.Flags().StringVar(&vars.ModelDirectory, "dir", vars.ModelDirectory, "Directory to download the model files")
+	downloadCmd.Flags().IntVar(&vars.ModelDownloadConcurrency, "concurrency", vars.ModelDownloadConcurrency, "Maximum number of parallel download streams shared by all the models")
+	downloadCmd.Flags().StringArrayVar(&checksums, "checksum", nil,
+		"Checksum of the downloaded model directory as sha256:<hex>, failing the download on a mismatch. "+
+			"Given as <model>=sha256:<hex> per model when the template references several models")
+}
+
+// checksums are the values of the --checksum flag, either sha256:<hex> or <model>=sha256:<hex>.
+var checksums []string
+
+// modelChecksums maps the models to their checksum given by --checksum. A bare checksum applies to the single
+// model of the template.
+func modelChecksums(values, models []string) (map[string]string, error) {
+	byModel := map[string]string{}
+	for _, value := range values {
+		model, sum, found := strings.Cut(value, "=")
+		if !found {
+			if len(models) != 1 {
+				return nil, fmt.Errorf("--checksum %s applies to a single model, the template references %d models, "+
+					"use --checksum <model>=sha256:<hex>", value, len(models))
+			}
+			model, sum = models[0], value
+		}
+		if !slices.Contains(models, model) {
+			return nil, fmt.Errorf("--checksum given for model %s, which is not referenced by the template", model)
+		}
+		byModel[model] = sum
+	}
+
+	return byModel, nil
 }
 
 func download(cmd *cobra.Command) error {
@@ -44,12 +108,13 @@ func download(cmd *cobra.Command) error {
 	if err != nil {
 		return err
 	}
-	logger.Infoln("Downloaded Models in application template" + templateName + ":")
-	for _, model := range models {
-		err := helpers.DownloadModel(model, vars.ModelDirectory)
-		if err != nil {
-			return fmt.Errorf("failed to download model: %w", err)
-		}
+	sums, err := modelChecksums(checksums, models)
+	if err != nil {
+		return err
+	}
+	logger.Infoln("Downloading models in application template " + templateName + ":")
+	if err := helpers.DownloadModels(models, vars.ModelDirectory, vars.ModelDownloadConcurrency, sums); err != nil {
+		return fmt.Errorf("failed to download model: %w", err)
 	}
 
 	return nil
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestModelChecksums(t *testing.T) {
+	const sum = "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	tests := []struct {
+		name    string
+		values  []string
+		models  []string
+		want    map[string]string
+		wantErr string
+	}{
+		{name: "none", models: []string{"ibm-granite/granite-3.3-8b-instruct"}, want: map[string]string{}},
+		{
+			name:   "single model",
+			values: []string{sum},
+			models: []string{"ibm-granite/granite-3.3-8b-instruct"},
+			want:   map[string]string{"ibm-granite/granite-3.3-8b-instruct": sum},
+		},
+		{
+			name:   "per model",
+			values: []string{"ibm-granite/granite-embedding-278m-multilingual=" + sum},
+			models: []string{"ibm-granite/granite-3.3-8b-instruct", "ibm-granite/granite-embedding-278m-multilingual"},
+			want:   map[string]string{"ibm-granite/granite-embedding-278m-multilingual": sum},
+		},
+		{
+			name:    "bare checksum with several models",
+			values:  []string{sum},
+			models:  []string{"ibm-granite/granite-3.3-8b-instruct", "ibm-granite/granite-embedding-278m-multilingual"},
+			wantErr: "--checksum " + sum + " applies to a single model, the template references 2 models, use --checksum <model>=sha256:<hex>",
+		},
+		{
+			name:    "unknown model",
+			values:  []string{"other/model=" + sum},
+			models:  []string{"ibm-granite/granite-3.3-8b-instruct"},
+			wantErr: "--checksum given for model other/model, which is not referenced by the template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := modelChecksums(tt.values, tt.models)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("modelChecksums() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil || !maps.Equal(got, tt.want) {
+				t.Errorf("modelChecksums() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package model
 import (
 	"fmt"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
@@ -28,6 +29,7 @@ var listCmd = &cobra.Command{
 func init() {
 	listCmd.Flags().StringVarP(&templateName, "template", "t", "", "Application template name (Required)")
 	_ = listCmd.MarkFlagRequired("template")
+	_ = listCmd.RegisterFlagCompletionFunc("template", completion.TemplateNames)
 }
 
 func list(cmd *cobra.Command) error {
@@ -27,7 +27,11 @@ func init() {
 }
 
 func models(template string) ([]string, error) {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the application templates, err: %w", err)
+	}
+
 	apps, err := tp.ListApplications(hiddenTemplates)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list the applications, err: %w", err)
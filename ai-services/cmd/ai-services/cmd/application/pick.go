@@ -0,0 +1,171 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/term"
+
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	// isInteractive reports whether both the input and the output of the command are a terminal.
+	isInteractive = func() bool {
+		return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+	}
+	// runForm runs the prompts of the picker, Eg:- answered from a reader rather than a terminal in the tests.
+	runForm = func(form *huh.Form) error {
+		return form.Run()
+	}
+	// newTemplateProvider returns the provider of the application templates to pick from, Eg:- test ones in the tests.
+	newTemplateProvider = func() (templates.Template, error) {
+		return templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+	}
+)
+
+// pickTemplate lets the user select the application template to deploy when neither --template nor --from-manifest
+// is given on a terminal, then prompts for its required parameters. Elsewhere the flags stay required.
+func pickTemplate(cmd *cobra.Command) error {
+	if cmd.Flags().Changed(appFlags.Create.Template) || cmd.Flags().Changed(appFlags.Create.FromManifest) || !isInteractive() {
+		return nil
+	}
+
+	tp, err := newTemplateProvider()
+	if err != nil {
+		return err
+	}
+
+	apps, err := tp.ListApplications(false)
+	if err != nil {
+		return fmt.Errorf("failed to list templates: %w", err)
+	}
+	if len(apps) == 0 {
+		return errors.New("no application template is available")
+	}
+
+	options := make([]huh.Option[string], 0, len(apps))
+	for _, app := range apps {
+		appMetadata, err := tp.LoadMetadata(app, false)
+		if err != nil {
+			return fmt.Errorf("failed to load metadata of application template '%s': %w", app, err)
+		}
+		options = append(options, huh.NewOption(fmt.Sprintf("%s - %s", app, appMetadata.Description), app))
+	}
+
+	var choice string
+	selection := huh.NewSelect[string]().
+		Title("Select the application template to deploy").
+		Options(options...).
+		Value(&choice)
+	if err := runForm(huh.NewForm(huh.NewGroup(selection))); err != nil {
+		return fmt.Errorf("failed to run template selection: %w", err)
+	}
+	logger.Infof("Selected application template '%s'\n", choice)
+
+	if err := cmd.Flags().Set(appFlags.Create.Template, choice); err != nil {
+		return err
+	}
+
+	return promptRequiredParams(cmd, tp, choice)
+}
+
+// promptRequiredParams prompts for the required parameters of the application template not already set on the command line,
+// prefilled with their current value. The answers which change it are added to --params.
+func promptRequiredParams(cmd *cobra.Command, tp templates.Template, app string) error {
+	required, err := helpers.RequiredParameters(tp, app)
+	if err != nil {
+		return err
+	}
+
+	values, err := tp.LoadValues(app, valuesFiles, nil)
+	if err != nil {
+		return fmt.Errorf("failed to load application template values: %w", err)
+	}
+
+	given := givenParams()
+	for _, param := range required {
+		if given[param.Name] {
+			continue
+		}
+
+		current := ""
+		if value, ok := utils.GetNestedValue(values, param.Name); ok && value != nil {
+			current, err = formatParam(value)
+			if err != nil {
+				return fmt.Errorf("failed to encode parameter '%s': %w", param.Name, err)
+			}
+		}
+
+		answer := current
+		input := huh.NewInput().
+			Title(fmt.Sprintf("%s (%s)", param.Name, param.Type)).
+			Description(param.Description).
+			Value(&answer)
+		if err := runForm(huh.NewForm(huh.NewGroup(input))); err != nil {
+			return fmt.Errorf("failed to run parameter prompt: %w", err)
+		}
+
+		if answer == current {
+			continue
+		}
+		if err := appendParam(cmd, param.Name+"="+answer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// givenParams returns the keys of the parameters set by the params flags.
+func givenParams() map[string]bool {
+	given := map[string]bool{}
+	for _, raw := range [][]string{rawArgParams, rawArgEnvParams, rawArgJSONParams, rawArgSetParams, rawArgStrParams} {
+		for _, pair := range raw {
+			key, _, _ := strings.Cut(pair, "=")
+			given[key] = true
+		}
+	}
+
+	return given
+}
+
+// formatParam renders the value of a parameter the way it is given on the command line, Eg:- the objects as JSON.
+func formatParam(value any) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// appendParam adds the key=value pair to --params as is, a comma in the value not splitting it.
+func appendParam(cmd *cobra.Command, pair string) error {
+	flag := cmd.Flags().Lookup(appFlags.Create.Params)
+	params, ok := flag.Value.(pflag.SliceValue)
+	if !ok {
+		return fmt.Errorf("unexpected type of --%s", appFlags.Create.Params)
+	}
+	if err := params.Append(pair); err != nil {
+		return err
+	}
+	flag.Changed = true
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package application
+
+import (
+	"embed"
+	"io"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/charmbracelet/huh"
+	"github.com/spf13/cobra"
+
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+//go:embed testdata
+var testFS embed.FS
+
+// newPickCmd returns a command with the flags of the picker, as registered by application create.
+func newPickCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "create"}
+	cmd.Flags().String(appFlags.Create.Template, "", "")
+	cmd.Flags().String(appFlags.Create.FromManifest, "", "")
+	cmd.Flags().StringSlice(appFlags.Create.Params, nil, "")
+
+	return cmd
+}
+
+// answerPrompts runs the prompts of the picker in accessible mode, answering them with the given lines,
+// an empty line keeping the prefilled value, and returns the number of prompts run.
+func answerPrompts(t *testing.T, interactive bool, answers ...string) *int {
+	t.Helper()
+
+	// a single byte is read at a time, so that each prompt only consumes its own line
+	in := iotest.OneByteReader(strings.NewReader(strings.Join(answers, "\n") + "\n"))
+	forms := 0
+
+	origInteractive, origRunForm, origProvider, origFactory := isInteractive, runForm, newTemplateProvider, vars.RuntimeFactory
+	t.Cleanup(func() {
+		isInteractive, runForm, newTemplateProvider, vars.RuntimeFactory = origInteractive, origRunForm, origProvider, origFactory
+		rawArgParams = nil
+	})
+
+	isInteractive = func() bool { return interactive }
+	runForm = func(form *huh.Form) error {
+		forms++
+
+		return form.WithAccessible(true).WithInput(in).WithOutput(io.Discard).Run()
+	}
+	newTemplateProvider = newTestTemplateProvider
+	vars.RuntimeFactory = runtime.NewRuntimeFactory(types.RuntimeTypePodman)
+
+	return &forms
+}
+
+// newTestTemplateProvider serves the application templates of testdata, the secured one having required parameters.
+func newTestTemplateProvider() (templates.Template, error) {
+	return templates.NewEmbedTemplateProvider(templates.EmbedOptions{FS: &testFS, Root: "testdata/applications", Runtime: types.RuntimeTypePodman})
+}
+
+// templateChoice returns the number of the option selecting the given application template.
+func templateChoice(t *testing.T, app string) string {
+	t.Helper()
+
+	tp, err := newTestTemplateProvider()
+	if err != nil {
+		t.Fatalf("NewEmbedTemplateProvider() error = %v", err)
+	}
+	apps, err := tp.ListApplications(false)
+	if err != nil {
+		t.Fatalf("ListApplications() error = %v", err)
+	}
+	idx := slices.Index(apps, app)
+	if idx < 0 {
+		t.Fatalf("application template %s not found in %v", app, apps)
+	}
+
+	return strconv.Itoa(idx + 1)
+}
+
+func TestPickTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		interactive bool
+		flags       map[string]string
+		given       []string
+		answers     []string
+		wantForms   int
+		wantParams  []string
+	}{
+		{
+			name:        "template and required parameters prompted",
+			interactive: true,
+			answers:     []string{"secured", "S3cret@pass", ""},
+			wantForms:   3,
+			wantParams:  []string{"opensearch.password=S3cret@pass"},
+		},
+		{
+			name:        "prefilled values kept",
+			interactive: true,
+			answers:     []string{"secured", "", ""},
+			wantForms:   3,
+			wantParams:  []string{},
+		},
+		{
+			name:        "given parameters not prompted",
+			interactive: true,
+			given:       []string{"opensearch.password=S3cret@pass"},
+			answers:     []string{"secured", "operator"},
+			wantForms:   2,
+			wantParams:  []string{"opensearch.username=operator"},
+		},
+		{
+			name:        "template given",
+			interactive: true,
+			flags:       map[string]string{appFlags.Create.Template: "secured"},
+			wantParams:  []string{},
+		},
+		{
+			name:        "manifest given",
+			interactive: true,
+			flags:       map[string]string{appFlags.Create.FromManifest: "rag.yaml"},
+			wantParams:  []string{},
+		},
+		{
+			name:       "not a terminal",
+			wantParams: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answers := slices.Clone(tt.answers)
+			if len(answers) > 0 {
+				answers[0] = templateChoice(t, answers[0])
+			}
+			forms := answerPrompts(t, tt.interactive, answers...)
+			rawArgParams = tt.given
+
+			cmd := newPickCmd()
+			for name, value := range tt.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := pickTemplate(cmd); err != nil {
+				t.Fatalf("pickTemplate() error = %v", err)
+			}
+			if *forms != tt.wantForms {
+				t.Errorf("pickTemplate() ran %d prompts, want %d", *forms, tt.wantForms)
+			}
+			if tt.wantForms > 0 {
+				if got, _ := cmd.Flags().GetString(appFlags.Create.Template); got != "secured" {
+					t.Errorf("--%s = %q, want the selected template secured", appFlags.Create.Template, got)
+				}
+			}
+			params, _ := cmd.Flags().GetStringSlice(appFlags.Create.Params)
+			if !reflect.DeepEqual(params, tt.wantParams) {
+				t.Errorf("--%s = %q, want %q", appFlags.Create.Params, params, tt.wantParams)
+			}
+		})
+	}
+}
@@ -6,16 +6,17 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
-var output string
+var psOutput string
 
 func isOutputWide() bool {
-	return strings.ToLower(output) == "wide"
+	return strings.ToLower(psOutput) == "wide"
 }
 
 var psCmd = &cobra.Command{
@@ -26,7 +27,8 @@ Lists information about a specific application if the name is provided
 Arguments
   [name]: Application name (optional)
 `,
-	Args: cobra.MaximumNArgs(1),
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		// Build and run flag validator
 		flagValidator := buildPsFlagValidator()
@@ -71,7 +73,7 @@ func init() {
 
 func initPsCommonFlags() {
 	psCmd.Flags().StringVarP(
-		&output,
+		&psOutput,
 		appFlags.Ps.Output,
 		"o",
 		"",
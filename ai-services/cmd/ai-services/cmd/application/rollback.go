@@ -0,0 +1,90 @@
+package application
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/flagvalidator"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	rollbackAutoYes bool
+	rollbackTimeout time.Duration
+)
+
+var rollbackCmd = &cobra.Command{
+	Use:   "rollback [name]",
+	Short: "Roll back an application to its previous version",
+	Long: `Redeploys the latest version of an application successfully deployed before the current one, along with
+the parameters it was deployed with. Use it to undo a bad upgrade made with 'application create --template-version'.
+The failed deployments, and the re-deployments of the current version, are skipped.
+
+The rollback is refused when no earlier version of the application is recorded.
+
+Note: Supported for openshift runtime only, where the versions are recorded in the release history of the application.
+
+Arguments
+  [name]: Application name (required)`,
+	Example: `  # Undo the last upgrade of the application
+  ai-services application rollback my-app --runtime openshift`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := buildRollbackFlagValidator().Validate(cmd); err != nil {
+			return err
+		}
+
+		return utils.VerifyAppName(args[0])
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		applicationName := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		factory := application.NewFactory(vars.RuntimeFactory.GetRuntimeType())
+		app, err := factory.Create(applicationName)
+		if err != nil {
+			return fmt.Errorf("failed to create application instance: %w", err)
+		}
+
+		return app.Rollback(cmd.Context(), appTypes.RollbackOptions{
+			Name:    applicationName,
+			AutoYes: rollbackAutoYes,
+			Timeout: rollbackTimeout,
+		})
+	},
+}
+
+func init() {
+	audit.MarkMutating(rollbackCmd)
+
+	rollbackCmd.Flags().BoolVarP(&rollbackAutoYes, appFlags.Rollback.AutoYes, "y", false, "Automatically accept all confirmation prompts (default=false)")
+	rollbackCmd.Flags().DurationVar(
+		&rollbackTimeout,
+		appFlags.Rollback.Timeout,
+		0, // default
+		"Timeout for the operation (e.g. 10s, 2m, 1h).\n"+
+			"Note: Supported for openshift runtime only.\n",
+	)
+}
+
+// buildRollbackFlagValidator creates and configures the flag validator for the rollback command.
+func buildRollbackFlagValidator() *flagvalidator.FlagValidator {
+	builder := flagvalidator.NewFlagValidatorBuilder(vars.RuntimeFactory.GetRuntimeType())
+
+	builder.
+		AddCommonFlag(appFlags.Rollback.AutoYes, nil).
+		AddOpenShiftFlag(appFlags.Rollback.Timeout, nil)
+
+	return builder.Build()
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
@@ -27,7 +29,8 @@ Note: Logs are streamed only when a single pod is specified, and only after the
 
 Note: Supported for podman runtime only.
 `,
-	Args: cobra.ExactArgs(1),
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.ApplicationNames,
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		var err error
 		startPodNames, err = cmd.Flags().GetStringSlice("pod")
@@ -65,6 +68,7 @@ Note: Supported for podman runtime only.
 }
 
 func init() {
+	audit.MarkMutating(startCmd)
 	//nolint:godox
 	// TODO: revisit --pod flag to consider openshift as well
 	startCmd.Flags().StringSlice("pod", []string{}, "Specific pod name(s) to start (optional)\nCan be specified multiple times: --pod pod1 --pod pod2\nOr comma-separated: --pod pod1,pod2")
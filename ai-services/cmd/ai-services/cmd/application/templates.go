@@ -6,66 +6,164 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+// uncategorized is the group of the application templates without a category, listed last.
+const uncategorized = "uncategorized"
+
+var (
+	templatesCategory string
+	templatesOutput   string
+)
+
+// templateSummary is a single application template of the listing.
+type templateSummary struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Category    string `json:"category,omitempty"`
+	// MinSpyreCards is the minimum number of spyre cards required by the template, none when zero.
+	MinSpyreCards int               `json:"minSpyreCards,omitempty"`
+	Parameters    map[string]string `json:"parameters"`
+}
+
 var templatesCmd = &cobra.Command{
 	Use:   "templates",
 	Short: "Lists the offered application templates and their supported parameters",
-	Long:  `Retrieves information about the offered application templates and their supported parameters`,
+	Long: `Retrieves information about the offered application templates and their supported parameters.
+The templates are grouped by their category, which can be filtered with --category`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		_, err := output.Parse(templatesOutput)
+
+		return err
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// Once precheck passes, silence usage for any *later* internal errors.
 		cmd.SilenceUsage = true
 
-		tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+		tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+		if err != nil {
+			return fmt.Errorf("failed to load application templates: %w", err)
+		}
 
 		appTemplateNames, err := tp.ListApplications(hiddenTemplates)
 		if err != nil {
 			return fmt.Errorf("failed to list application templates: %w", err)
 		}
 
-		if len(appTemplateNames) == 0 {
-			logger.Infoln("No application templates found.")
+		// sort appTemplateNames alphabetically
+		sort.Strings(appTemplateNames)
 
-			return nil
+		summaries := summarizeTemplates(tp, appTemplateNames, templatesCategory)
+
+		if format, _ := output.Parse(templatesOutput); format.Structured() {
+			return output.Print(summaries, format)
 		}
 
-		// sort appTemplateNames alphabetically
-		sort.Strings(appTemplateNames)
+		if len(summaries) == 0 {
+			logger.Infoln("No application templates found.")
 
-		logger.Infoln("Available application templates:")
-		for _, name := range appTemplateNames {
-			appTemplatesParametersWithDescription, err := tp.ListApplicationTemplateValues(name)
-			if err != nil {
-				logger.Errorf("failed to list application template values: %v", err)
-
-				continue
-			}
-
-			logger.Infof("- %s\n", name)
-			metadata, err := tp.LoadMetadata(name, false)
-			if err != nil {
-				logger.Errorf("failed to load application metadata: %v", err)
-
-				continue
-			}
-			if metadata.Description != "" {
-				logger.Infof("  Description: %s", metadata.Description)
-			}
-
-			logger.Infoln("\n  Supported Parameters:")
-			if len(appTemplatesParametersWithDescription) == 0 {
-				logger.Infoln("\t" + "NONE")
-			}
-
-			for k, v := range appTemplatesParametersWithDescription {
-				logger.Infoln("\t" + k + ":  " + v)
-			}
-			cmd.Println()
+			return nil
 		}
 
+		printTemplateSummaries(cmd, summaries)
+
 		return nil
 	},
 }
+
+func init() {
+	templatesCmd.Flags().StringVar(&templatesCategory, appFlags.Templates.Category, "", "List only the application templates of the given category")
+	_ = templatesCmd.RegisterFlagCompletionFunc(appFlags.Templates.Category, completion.TemplateCategories)
+	templatesCmd.Flags().StringVarP(&templatesOutput, appFlags.Templates.Output, "o", "", "Output format (json or yaml)")
+}
+
+// summarizeTemplates gathers the summary of the given application templates, of the given category if any.
+func summarizeTemplates(tp templates.Template, names []string, category string) []templateSummary {
+	summaries := []templateSummary{}
+	for _, name := range names {
+		metadata, err := tp.LoadMetadata(name, false)
+		if err != nil {
+			logger.Errorf("failed to load application metadata: %v", err)
+
+			continue
+		}
+		if category != "" && metadata.Category != category {
+			continue
+		}
+
+		params, err := tp.ListApplicationTemplateValues(name)
+		if err != nil {
+			logger.Errorf("failed to list application template values: %v", err)
+
+			continue
+		}
+
+		summaries = append(summaries, templateSummary{
+			Name:          name,
+			Description:   metadata.Description,
+			Category:      metadata.Category,
+			MinSpyreCards: metadata.MinSpyreCards,
+			Parameters:    params,
+		})
+	}
+
+	return summaries
+}
+
+// printTemplateSummaries prints the application templates grouped by category, the uncategorized ones last.
+// The listing stays flat while none of the templates has a category.
+func printTemplateSummaries(cmd *cobra.Command, summaries []templateSummary) {
+	groups := map[string][]templateSummary{}
+	var categories []string
+	for _, s := range summaries {
+		category := s.Category
+		if category == "" {
+			category = uncategorized
+		}
+		if _, ok := groups[category]; !ok && category != uncategorized {
+			categories = append(categories, category)
+		}
+		groups[category] = append(groups[category], s)
+	}
+	sort.Strings(categories)
+	if _, ok := groups[uncategorized]; ok {
+		categories = append(categories, uncategorized)
+	}
+
+	logger.Infoln("Available application templates:")
+	grouped := len(categories) > 1 || categories[0] != uncategorized
+	for _, category := range categories {
+		if grouped {
+			logger.Infof("\n[%s]\n", category)
+		}
+		for _, s := range groups[category] {
+			printTemplateSummary(cmd, s)
+		}
+	}
+}
+
+func printTemplateSummary(cmd *cobra.Command, s templateSummary) {
+	logger.Infof("- %s\n", s.Name)
+	if s.Description != "" {
+		logger.Infof("  Description: %s", s.Description)
+	}
+	if s.MinSpyreCards > 0 {
+		logger.Infof("\n  Minimum Spyre Cards: %d", s.MinSpyreCards)
+	}
+
+	logger.Infoln("\n  Supported Parameters:")
+	if len(s.Parameters) == 0 {
+		logger.Infoln("\t" + "NONE")
+	}
+
+	for k, v := range s.Parameters {
+		logger.Infoln("\t" + k + ":  " + v)
+	}
+	cmd.Println()
+}
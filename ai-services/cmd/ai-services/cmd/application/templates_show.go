@@ -0,0 +1,150 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var templateShowOutput string
+
+var templatesShowCmd = &cobra.Command{
+	Use:     "show [name]",
+	Aliases: []string{"describe"},
+	Short:   "Shows the full details of an application template",
+	Long: `Displays the metadata, supported parameters, container images and spyre card requirements
+of a single application template
+		Arguments
+		- [name]: Application template name (Required)
+	`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.TemplateNameArg,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		_, err := output.Parse(templateShowOutput)
+
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		rt := vars.RuntimeFactory.GetRuntimeType()
+		tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: rt})
+		if err != nil {
+			return fmt.Errorf("failed to load application templates: %w", err)
+		}
+
+		name, err = tp.ResolveApplication(name)
+		if err != nil {
+			return fmt.Errorf("failed to resolve the application template: %w", err)
+		}
+
+		if err := validators.ValidateAppTemplateExist(tp, name); err != nil {
+			return err
+		}
+
+		details, err := helpers.DescribeTemplate(tp, rt, name)
+		if err != nil {
+			return fmt.Errorf("failed to describe application template: %w", err)
+		}
+
+		if format, _ := output.Parse(templateShowOutput); format.Structured() {
+			return output.Print(details, format)
+		}
+
+		printTemplateDetails(details)
+
+		return nil
+	},
+}
+
+func init() {
+	templatesShowCmd.Flags().StringVarP(
+		&templateShowOutput,
+		appFlags.TemplatesShow.Output,
+		"o",
+		"",
+		"Output format (json or yaml)",
+	)
+	templatesCmd.AddCommand(templatesShowCmd)
+}
+
+func printTemplateDetails(details *helpers.TemplateDetails) {
+	logger.Resultf("Name:        %s\n", details.Name)
+	logger.Resultf("Runtime:     %s\n", details.Runtime)
+	if details.Version != "" {
+		logger.Resultf("Version:     %s\n", details.Version)
+	}
+	if len(details.Versions) > 1 {
+		logger.Resultf("Versions:    %s\n", strings.Join(details.Versions, ", "))
+	}
+	if details.Description != "" {
+		logger.Resultf("Description: %s\n", details.Description)
+	}
+	if details.Category != "" {
+		logger.Resultf("Category:    %s\n", details.Category)
+	}
+	if details.Hidden {
+		logger.Resultln("Hidden:      true")
+	}
+	if details.SMTLevel != nil {
+		logger.Resultf("SMT Level:   %d\n", *details.SMTLevel)
+	}
+
+	logger.Resultln("\nParameters:")
+	if len(details.Parameters) == 0 {
+		logger.Resultln("\tNONE")
+	}
+	for _, p := range details.Parameters {
+		required := ""
+		if p.Required {
+			required = " (required)"
+		}
+		logger.Resultf("\t%s%s\n", p.Name, required)
+		if p.Description != "" {
+			logger.Resultf("\t  Description: %s\n", p.Description)
+		}
+		logger.Resultf("\t  Type:        %s\n", p.Type)
+		logger.Resultf("\t  Default:     %v\n", formatDefault(p.Default))
+	}
+
+	logger.Resultln("\nImages:")
+	if len(details.Images) == 0 {
+		logger.Resultln("\tNONE")
+	}
+	for _, img := range details.Images {
+		logger.Resultln("\t" + img)
+	}
+
+	logger.Resultf("\nSpyre Cards: %d\n", details.SpyreCards.Total)
+	if details.SpyreCards.Minimum > 0 {
+		logger.Resultf("Minimum Spyre Cards: %d\n", details.SpyreCards.Minimum)
+	}
+	containers := utils.ExtractMapKeys(details.SpyreCards.Containers)
+	sort.Strings(containers)
+	for _, container := range containers {
+		logger.Resultf("\t%s: %d\n", container, details.SpyreCards.Containers[container])
+	}
+}
+
+func formatDefault(v any) any {
+	if v == nil || v == "" {
+		return "<none>"
+	}
+
+	return v
+}
@@ -0,0 +1,147 @@
+package application
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	appFlags "github.com/project-ai-services/ai-services/internal/pkg/cli/constants/application"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var (
+	verifyImagesRegistryPrefix string
+	verifyImagesOutput         string
+)
+
+var templatesVerifyImagesCmd = &cobra.Command{
+	Use:   "verify-images [name]",
+	Short: "Verifies that the images of an application template are present in the registry mirror",
+	Long: `Checks that the manifest of every container image referenced by the application template
+is served by the registry the image gets pulled from, without pulling the image.
+This tells ahead of a disconnected deploy whether the mirror holds all the images.
+
+The images are looked up at their mirrors configured in registries.conf, or with --registry-prefix,
+at the registry prefix replacing their registry, e.g. icr.io/ai-services/vllm:0.1 is looked up
+as mirror.local:5000/ai-services/vllm:0.1 with --registry-prefix mirror.local:5000.
+		Arguments
+		- [name]: Application template name (Required)
+	`,
+	Example: `  # verify the mirrors configured in registries.conf hold the images of the rag template
+  ai-services application templates verify-images rag
+
+  # verify a mirror registry holds them
+  ai-services application templates verify-images rag --registry-prefix mirror.local:5000`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: completion.TemplateNameArg,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if verifyImagesRegistryPrefix != "" {
+			// the prefix must give valid references once it replaces the registry of the images
+			if _, err := image.RewriteRegistry(vars.ToolImage, verifyImagesRegistryPrefix); err != nil {
+				return exitcode.MarkUsage(fmt.Errorf("invalid --%s '%s': %w", appFlags.TemplatesVerifyImages.RegistryPrefix, verifyImagesRegistryPrefix, err))
+			}
+		}
+		_, err := output.Parse(verifyImagesOutput)
+
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Once precheck passes, silence usage for any *later* internal errors.
+		cmd.SilenceUsage = true
+
+		if vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypeOpenShift {
+			// Since we do not have tmpl files in OpenShift marking it as unsupported for now
+			logger.Warningln("Not supported for openshift runtime")
+
+			return nil
+		}
+
+		name, err := resolveTemplateName(args[0])
+		if err != nil {
+			return err
+		}
+
+		images, err := image.ListImages(name, "")
+		if err != nil {
+			return fmt.Errorf("error listing images: %w", err)
+		}
+
+		checks := image.CheckMirrors(cmd.Context(), nil, images, verifyImagesRegistryPrefix)
+
+		if format, _ := output.Parse(verifyImagesOutput); format.Structured() {
+			if err := output.Print(checks, format); err != nil {
+				return err
+			}
+		} else {
+			printMirrorChecks(checks)
+		}
+
+		return missingImages(checks)
+	},
+}
+
+func init() {
+	templatesVerifyImagesCmd.Flags().StringVar(&verifyImagesRegistryPrefix, appFlags.TemplatesVerifyImages.RegistryPrefix, "",
+		"Registry, optionally followed by a path, replacing the registry of the images, e.g. mirror.local:5000/ibm")
+	templatesVerifyImagesCmd.Flags().StringVarP(&verifyImagesOutput, appFlags.TemplatesVerifyImages.Output, "o", "", "Output format (json or yaml)")
+	templatesCmd.AddCommand(templatesVerifyImagesCmd)
+}
+
+func resolveTemplateName(name string) (string, error) {
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return "", fmt.Errorf("failed to load application templates: %w", err)
+	}
+
+	name, err = tp.ResolveApplication(name)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the application template: %w", err)
+	}
+
+	if err := validators.ValidateAppTemplateExist(tp, name); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+func printMirrorChecks(checks []image.MirrorCheck) {
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("IMAGE", "STATUS", "LOCATION")
+	for _, check := range checks {
+		if check.Missing() {
+			printer.AppendRow(check.Image, "missing", strings.Join(check.Locations, ", "))
+
+			continue
+		}
+		printer.AppendRow(check.Image, "present", check.Found)
+	}
+}
+
+// missingImages returns an error listing the images missing from the registry along with the reason, nil for none.
+func missingImages(checks []image.MirrorCheck) error {
+	var missing []string
+	for _, check := range checks {
+		if check.Missing() {
+			missing = append(missing, fmt.Sprintf("%s (%s)", check.Image, check.Error))
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	return exitcode.MarkValidationFailed(fmt.Errorf("%d of %d image(s) are missing from the registry:\n  - %s",
+		len(missing), len(checks), strings.Join(missing, "\n  - ")))
+}
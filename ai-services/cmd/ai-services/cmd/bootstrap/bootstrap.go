@@ -1,54 +1,98 @@
 package bootstrap
 
 import (
+	"context"
 	"fmt"
 
-	"github.com/charmbracelet/lipgloss"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
+// reloginNotice asks podman users to login again, so that the vfio group membership assigned while configuring applies.
+const reloginNotice = "Re-login to the shell to reflect necessary permissions assigned to vfio cards"
+
+// bootstrapResult is the combined outcome of the bootstrap flow, emitted with --output json or yaml.
+type bootstrapResult struct {
+	Configure *bootstrap.ConfigureReport  `json:"configure"`
+	Validate  *bootstrap.ValidationReport `json:"validate,omitempty"`
+	Warnings  []string                    `json:"warnings,omitempty"`
+}
+
 // BootstrapCmd represents the bootstrap command.
 func BootstrapCmd() *cobra.Command {
+	var (
+		profile      profileFlags
+		outputFormat string
+		force        bool
+	)
+
 	bootstrapCmd := &cobra.Command{
 		Use:     "bootstrap",
 		Short:   "Initializes AI Services infrastructure",
 		Long:    bootstrapDescription(),
 		Example: bootstrapExample(),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := output.Parse(outputFormat); err != nil {
+				return err
+			}
+			if err := validateOperatorsNamespaceMap(cmd); err != nil {
+				return err
+			}
+
+			return profile.validate()
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmd.SilenceUsage = true
 
-			rt := vars.RuntimeFactory.GetRuntimeType()
-			// Create bootstrap instance based on runtime
-			factory := bootstrap.NewBootstrapFactory(rt)
-			bootstrapInstance, err := factory.Create()
-			if err != nil {
-				return fmt.Errorf("failed to create bootstrap instance: %w", err)
+			if profile.list {
+				printProfiles()
+
+				return nil
 			}
 
-			if configureErr := bootstrapInstance.Configure(); configureErr != nil {
-				return fmt.Errorf("failed to bootstrap the LPAR: %w", configureErr)
+			format, _ := output.Parse(outputFormat)
+			if format.Structured() {
+				// keep the progress messages out of the structured document
+				logger.SetQuiet(true)
 			}
 
-			if err := factory.Validate(nil); err != nil {
+			result, err := runBootstrap(cmd.Context(), profile.apply(nil), force)
+			if format.Structured() {
+				err = printBootstrapResult(result, err, format)
+			}
+			if err != nil {
 				return fmt.Errorf("failed to bootstrap the LPAR: %w", err)
 			}
 
-			if rt == types.RuntimeTypePodman {
-				logger.Infoln("LPAR bootstrapped successfully")
+			if !format.Structured() && vars.RuntimeFactory.GetRuntimeType() == types.RuntimeTypePodman {
+				logger.Resultln("LPAR bootstrapped successfully")
 				logger.Infoln("----------------------------------------------------------------------------")
-				style := lipgloss.NewStyle().Foreground(lipgloss.Color("#32BD27"))
-				message := style.Render("Re-login to the shell to reflect necessary permissions assigned to vfio cards")
-				logger.Infoln(message)
+				for _, warning := range result.Warnings {
+					logger.Infoln(style.Success().Render(warning))
+				}
 			}
 
 			return nil
 		},
 	}
 
+	addOperatorTimeoutFlag(bootstrapCmd)
+	addMinOpenShiftVersionFlag(bootstrapCmd)
+	addOperatorsNamespaceMapFlag(bootstrapCmd)
+	addMinServiceReportVersionFlag(bootstrapCmd)
+	addWarningsAsErrorsFlag(bootstrapCmd)
+	addContinueOnFailureFlag(bootstrapCmd, &vars.ContinueOnFailure, vars.ContinueOnFailure)
+	addForceFlag(bootstrapCmd, &force)
+	profile.register(bootstrapCmd)
+	bootstrapCmd.Flags().StringVarP(&outputFormat, "output", "o", "", "Output format (json or yaml)")
+	audit.MarkMutating(bootstrapCmd)
+
 	// subcommands
 	bootstrapCmd.AddCommand(validateCmd())
 	bootstrapCmd.AddCommand(configureCmd())
@@ -56,6 +100,96 @@ func BootstrapCmd() *cobra.Command {
 	return bootstrapCmd
 }
 
+// runBootstrap configures and then validates the environment, skipping the given checks.
+// The configuration is refused while applications are running, unless forced.
+func runBootstrap(ctx context.Context, skip map[string]bool, force bool) (*bootstrapResult, error) {
+	rt := vars.RuntimeFactory.GetRuntimeType()
+	result := &bootstrapResult{}
+
+	// Create bootstrap instance based on runtime
+	factory := bootstrap.NewBootstrapFactory(rt)
+	bootstrapInstance, err := factory.Create()
+	if err != nil {
+		return result, fmt.Errorf("failed to create bootstrap instance: %w", err)
+	}
+
+	if err := bootstrap.GuardRunningApplications(bootstrapInstance, nil, force); err != nil {
+		return result, err
+	}
+
+	result.Configure, err = bootstrap.ConfigureWithReport(bootstrapInstance, rt)
+	if err != nil {
+		return result, err
+	}
+
+	result.Validate, err = factory.ValidateWithReport(ctx, skip)
+	if err != nil {
+		return result, err
+	}
+
+	if rt == types.RuntimeTypePodman {
+		result.Warnings = append(result.Warnings, reloginNotice)
+	}
+
+	return result, nil
+}
+
+// printBootstrapResult emits the result in the structured format, the partial one as well when the run failed.
+// The error of the run takes precedence over the one printing the result.
+func printBootstrapResult(result *bootstrapResult, runErr error, format output.Format) error {
+	if err := output.Print(result, format); err != nil && runErr == nil {
+		return err
+	}
+
+	return runErr
+}
+
+// addOperatorTimeoutFlag registers the flag controlling how long to wait for each operator on OpenShift.
+func addOperatorTimeoutFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&vars.OperatorTimeout, "operator-timeout", vars.OperatorTimeout,
+		"Maximum time to wait for each operator to become ready (only applicable for OpenShift runtime)")
+}
+
+// addForceFlag registers the flag allowing the disruptive configuration steps to run while applications are running.
+func addForceFlag(cmd *cobra.Command, force *bool) {
+	cmd.Flags().BoolVar(force, "force", false,
+		"Run the configuration steps which may disrupt the running applications (e.g. rebinding the spyre cards), even when applications are running")
+}
+
+// addMinOpenShiftVersionFlag registers the flag overriding the minimum OpenShift version required by the clusterversion check.
+func addMinOpenShiftVersionFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&vars.MinOpenShiftVersion, "min-openshift-version", vars.MinOpenShiftVersion,
+		"Minimum OpenShift version in the major.minor format required by the clusterversion check (only applicable for OpenShift runtime)")
+}
+
+// addMinServiceReportVersionFlag registers the flag overriding the minimum version of the servicereport tool.
+func addMinServiceReportVersionFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&vars.MinServiceReportVersion, "min-servicereport-version", vars.MinServiceReportVersion,
+		"Minimum version of the servicereport tool run by the configuration and the servicereport check (only applicable for podman runtime)")
+}
+
+// addWarningsAsErrorsFlag registers the flag failing the validation on the checks reporting a warning.
+func addWarningsAsErrorsFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&vars.WarningsAsErrors, "warnings-as-errors", vars.WarningsAsErrors,
+		"Fail the validation when a check reports a warning, e.g. a LPAR affinity below the threshold")
+}
+
+const waitReadyFlag = "wait-ready"
+
+// addWaitReadyFlag registers the flag waiting for the operators being installed, rather than failing on them.
+func addWaitReadyFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&vars.WaitReady, waitReadyFlag, vars.WaitReady,
+		"Wait up to the given duration (e.g. 15m) for the operators being installed to reach the Succeeded phase, polling them with backoff, "+
+			"rather than failing on them. The operators which are not installed still fail right away (only applicable for OpenShift runtime)")
+}
+
+// addContinueOnFailureFlag registers the flag verifying all the checks past the failure of a critical one, into p.
+func addContinueOnFailureFlag(cmd *cobra.Command, p *bool, value bool) {
+	cmd.Flags().BoolVar(p, "continue-on-failure", value,
+		"Keep verifying the remaining checks when a critical check fails, e.g. the root check, to report all the failures in one run. "+
+			"The checks requiring a failed check are still skipped")
+}
+
 func bootstrapExample() string {
 	return `  # Validate the environment
   ai-services bootstrap validate
@@ -63,6 +197,9 @@ func bootstrapExample() string {
   # Configure the infrastructure
   ai-services bootstrap configure
 
+  # Configure and validate, emitting the combined result as JSON
+  ai-services bootstrap --output json
+
   # Get help on a specific subcommand
   ai-services bootstrap validate --help`
 }
@@ -0,0 +1,116 @@
+package bootstrap
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestPrintBootstrapResult(t *testing.T) {
+	startedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	configured := &bootstrap.ConfigureReport{
+		Runtime: "podman", Passed: true, StartedAt: startedAt, DurationMs: 1200, Steps: []string{"podman", "servicereport"},
+	}
+
+	tests := []struct {
+		name    string
+		result  *bootstrapResult
+		runErr  error
+		want    string
+		wantErr string
+	}{
+		{
+			name: "bootstrapped",
+			result: &bootstrapResult{
+				Configure: configured,
+				Validate: &bootstrap.ValidationReport{
+					Runtime: "podman", Host: "lpar1", Passed: true, StartedAt: startedAt, DurationMs: 300,
+					Checks: []bootstrap.CheckResult{{Name: "root", Description: "Root privileges", Level: "error", Status: bootstrap.CheckStatusPassed, DurationMs: 1}},
+				},
+				Warnings: []string{reloginNotice},
+			},
+			want: `{"configure":{"runtime":"podman","passed":true,"startedAt":"2026-01-02T03:04:05Z","durationMs":1200,"steps":["podman","servicereport"]},` +
+				`"validate":{"runtime":"podman","host":"lpar1","passed":true,"startedAt":"2026-01-02T03:04:05Z","durationMs":300,` +
+				`"checks":[{"name":"root","description":"Root privileges","level":"error","status":"passed","durationMs":1}]},` +
+				`"warnings":["` + reloginNotice + `"]}`,
+		},
+		{
+			name: "skipped check",
+			result: &bootstrapResult{
+				Configure: configured,
+				Validate: &bootstrap.ValidationReport{
+					Runtime: "podman", Host: "lpar1", Passed: true, StartedAt: startedAt, DurationMs: 300,
+					Checks: []bootstrap.CheckResult{{Name: "numa", Description: "NUMA node alignment", Level: "warning", Status: bootstrap.CheckStatusSkipped}},
+				},
+			},
+			want: `{"configure":{"runtime":"podman","passed":true,"startedAt":"2026-01-02T03:04:05Z","durationMs":1200,"steps":["podman","servicereport"]},` +
+				`"validate":{"runtime":"podman","host":"lpar1","passed":true,"startedAt":"2026-01-02T03:04:05Z","durationMs":300,` +
+				`"checks":[{"name":"numa","description":"NUMA node alignment","level":"warning","status":"skipped","durationMs":0}]}}`,
+		},
+		{
+			name: "failed configuration",
+			result: &bootstrapResult{Configure: &bootstrap.ConfigureReport{
+				Runtime: "podman", Error: "failed to run servicereport", StartedAt: startedAt, DurationMs: 900, Steps: []string{"podman", "servicereport"},
+			}},
+			runErr: errors.New("failed to run servicereport"),
+			want: `{"configure":{"runtime":"podman","passed":false,"error":"failed to run servicereport","startedAt":"2026-01-02T03:04:05Z",` +
+				`"durationMs":900,"steps":["podman","servicereport"]}}`,
+			wantErr: "failed to run servicereport",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			logger.SetOutput(&out)
+			t.Cleanup(func() { logger.SetOutput(os.Stdout) })
+
+			err := printBootstrapResult(tt.result, tt.runErr, output.FormatJSON)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("printBootstrapResult() unexpected error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("printBootstrapResult() error = %v, want %q", err, tt.wantErr)
+			}
+
+			// compare the documents rather than their indentation
+			var got, want any
+			if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+				t.Fatalf("printBootstrapResult() emitted invalid JSON %q: %v", out.String(), err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("printBootstrapResult() emitted %s, want %s", out.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestContinueOnFailureDefault(t *testing.T) {
+	bootstrapCmd := BootstrapCmd()
+	validate, _, err := bootstrapCmd.Find([]string{"validate"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := bootstrapCmd.Flags().Lookup("continue-on-failure").DefValue; got != "false" {
+		t.Errorf("bootstrap --continue-on-failure default = %s, want false", got)
+	}
+	if got := validate.Flags().Lookup("continue-on-failure").DefValue; got != "true" {
+		t.Errorf("bootstrap validate --continue-on-failure default = %s, want true to report all the failures", got)
+	}
+	if vars.ContinueOnFailure {
+		t.Errorf("vars.ContinueOnFailure = true after registering the flags, want the validate default kept to its own run")
+	}
+}
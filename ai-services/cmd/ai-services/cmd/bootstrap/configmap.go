@@ -0,0 +1,88 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+)
+
+const fromConfigMapFlag = "from-configmap"
+
+// configMapKeys are the flags of the validation which the ConfigMap sets, by their name as the key of its data.
+var configMapKeys = []string{
+	"skip-validation",
+	"profile",
+	"min-openshift-version",
+	"warnings-as-errors",
+	operatorsNamespaceMapFlag,
+}
+
+// addFromConfigMapFlag registers the flag loading the validation configuration from a ConfigMap of the cluster.
+func addFromConfigMapFlag(cmd *cobra.Command, ref *string) {
+	cmd.Flags().StringVar(ref, fromConfigMapFlag, "",
+		fmt.Sprintf("Load the validation configuration from the ConfigMap <namespace>/<name> of the cluster, e.g. a ConfigMap managed by GitOps. "+
+			"Its data keys set the flags of the same name: %s. The flags given on the command line take precedence, "+
+			"and the defaults apply when the ConfigMap does not exist (only applicable for OpenShift runtime)", strings.Join(configMapKeys, ", ")))
+}
+
+// applyConfigMap sets the flags of the validation not given on the command line from the data of the referenced ConfigMap.
+func applyConfigMap(cmd *cobra.Command, ref string) error {
+	namespace, name, ok := strings.Cut(ref, "/")
+	if !ok || namespace == "" || name == "" || strings.Contains(name, "/") {
+		return fmt.Errorf("invalid --%s '%s', must be <namespace>/<name>", fromConfigMapFlag, ref)
+	}
+
+	c, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	return setFlagsFromConfigMap(c.Ctx, c.Client, cmd, ref, k8sClient.ObjectKey{Namespace: namespace, Name: name})
+}
+
+// setFlagsFromConfigMap sets the flags not given on the command line from the data of the ConfigMap read with c.
+func setFlagsFromConfigMap(ctx context.Context, c k8sClient.Reader, cmd *cobra.Command, ref string, key k8sClient.ObjectKey) error {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, key, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Warningf("ConfigMap '%s' not found, validating with the default configuration\n", ref)
+
+			return nil
+		}
+
+		return fmt.Errorf("failed to get ConfigMap '%s': %w", ref, err)
+	}
+
+	keys := make([]string, 0, len(cm.Data))
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !slices.Contains(configMapKeys, key) {
+			return fmt.Errorf("invalid ConfigMap '%s': unknown key '%s', must be one of: %s", ref, key, strings.Join(configMapKeys, ", "))
+		}
+		if cmd.Flags().Changed(key) {
+			logger.Infof("--%s is given on the command line, ignoring the key of ConfigMap '%s'\n", key, ref, logger.VerbosityLevelDebug)
+
+			continue
+		}
+		if err := cmd.Flags().Set(key, strings.TrimSpace(cm.Data[key])); err != nil {
+			return fmt.Errorf("invalid ConfigMap '%s': key '%s': %w", ref, key, err)
+		}
+	}
+	logger.Infof("Loaded the validation configuration from ConfigMap '%s'\n", ref)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// newConfigMapCmd returns a command with the flags the ConfigMap sets, as registered by bootstrap validate.
+func newConfigMapCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "validate"}
+	cmd.Flags().StringSlice("skip-validation", nil, "")
+	cmd.Flags().String("profile", "prod", "")
+	cmd.Flags().String("min-openshift-version", "4.18", "")
+	cmd.Flags().Bool("warnings-as-errors", false, "")
+	cmd.Flags().StringToString(operatorsNamespaceMapFlag, nil, "")
+
+	return cmd
+}
+
+func newValidationConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "gitops", Name: "validation"}, Data: data}
+}
+
+func TestSetFlagsFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		configMap *corev1.ConfigMap
+		args      []string
+		want      map[string]string
+		wantErr   string
+	}{
+		{
+			name: "flags set from the data",
+			configMap: newValidationConfigMap(map[string]string{
+				"profile":                 "dev",
+				"skip-validation":         "numa,power",
+				"warnings-as-errors":      " true\n",
+				operatorsNamespaceMapFlag: "nfd=custom-nfd",
+			}),
+			want: map[string]string{
+				"profile":                 "dev",
+				"skip-validation":         "[numa,power]",
+				"warnings-as-errors":      "true",
+				"min-openshift-version":   "4.18",
+				operatorsNamespaceMapFlag: "[nfd=custom-nfd]",
+			},
+		},
+		{
+			name:      "flag given on the command line takes precedence",
+			configMap: newValidationConfigMap(map[string]string{"profile": "dev", "min-openshift-version": "4.19"}),
+			args:      []string{"--profile", "ci"},
+			want:      map[string]string{"profile": "ci", "min-openshift-version": "4.19"},
+		},
+		{
+			name: "missing ConfigMap keeps the defaults",
+			want: map[string]string{"profile": "prod", "min-openshift-version": "4.18"},
+		},
+		{
+			name:      "unknown key",
+			configMap: newValidationConfigMap(map[string]string{"profile": "dev", "timeout": "5m"}),
+			wantErr:   "invalid ConfigMap 'gitops/validation': unknown key 'timeout', must be one of: ",
+		},
+		{
+			name:      "invalid value",
+			configMap: newValidationConfigMap(map[string]string{"warnings-as-errors": "maybe"}),
+			wantErr:   "invalid ConfigMap 'gitops/validation': key 'warnings-as-errors': ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme)
+			if tt.configMap != nil {
+				builder = builder.WithObjects(tt.configMap)
+			}
+
+			cmd := newConfigMapCmd()
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			err := setFlagsFromConfigMap(context.Background(), builder.Build(), cmd, "gitops/validation",
+				k8sClient.ObjectKey{Namespace: "gitops", Name: "validation"})
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("setFlagsFromConfigMap() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("setFlagsFromConfigMap() unexpected error = %v", err)
+			}
+
+			got := map[string]string{}
+			for name := range tt.want {
+				got[name] = cmd.Flags().Lookup(name).Value.String()
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("flags = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetFlagsFromConfigMapGetError(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c k8sClient.WithWatch, key k8sClient.ObjectKey, obj k8sClient.Object, opts ...k8sClient.GetOption) error {
+			return apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, key.Name, errors.New("no access"))
+		},
+	}).Build()
+
+	err := setFlagsFromConfigMap(context.Background(), c, newConfigMapCmd(), "gitops/validation",
+		k8sClient.ObjectKey{Namespace: "gitops", Name: "validation"})
+	if err == nil || !strings.HasPrefix(err.Error(), "failed to get ConfigMap 'gitops/validation'") {
+		t.Errorf("setFlagsFromConfigMap() error = %v, want the failure to get the ConfigMap", err)
+	}
+}
+
+func TestApplyConfigMapInvalidRef(t *testing.T) {
+	for _, ref := range []string{"validation", "/validation", "gitops/", "gitops/validation/extra"} {
+		if err := applyConfigMap(newConfigMapCmd(), ref); err == nil || !strings.HasPrefix(err.Error(), "invalid --from-configmap") {
+			t.Errorf("applyConfigMap(%q) error = %v, want the reference rejected", ref, err)
+		}
+	}
+}
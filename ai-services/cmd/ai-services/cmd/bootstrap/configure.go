@@ -3,25 +3,45 @@ package bootstrap
 import (
 	"fmt"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
 )
 
 // configureCmd represents the validate subcommand of bootstrap.
 func configureCmd() *cobra.Command {
+	var (
+		only      []string
+		listSteps bool
+		force     bool
+	)
+
 	cmd := &cobra.Command{
-		Use:    "configure",
-		Short:  "Configures the LPAR environment",
-		Long:   `Configure and initialize the LPAR.`,
-		Hidden: true,
+		Use:     "configure",
+		Short:   "Configures the LPAR environment",
+		Long:    `Configure and initialize the LPAR.`,
+		Example: configureExample(),
+		Hidden:  true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if vars.ApplyRecommendations && vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+				return fmt.Errorf("--apply-recommendations is only supported for the %s runtime", types.RuntimeTypePodman)
+			}
+			if vars.AutoYes && !vars.ApplyRecommendations {
+				return fmt.Errorf("--yes can only be used along with --apply-recommendations")
+			}
+
+			return validateOperatorsNamespaceMap(cmd)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Once precheck passes, silence usage for any *later* internal errors.
 			cmd.SilenceUsage = true
 
-			logger.Infoln("Running bootstrap configuration...")
-
 			// Create bootstrap instance based on runtime
 			factory := bootstrap.NewBootstrapFactory(vars.RuntimeFactory.GetRuntimeType())
 			bootstrapInstance, err := factory.Create()
@@ -29,15 +49,70 @@ func configureCmd() *cobra.Command {
 				return fmt.Errorf("failed to create bootstrap instance: %w", err)
 			}
 
-			if err := bootstrapInstance.Configure(); err != nil {
+			if listSteps {
+				printSteps(bootstrapInstance.Steps())
+
+				return nil
+			}
+
+			if err := bootstrap.GuardRunningApplications(bootstrapInstance, utils.UniqueSlice(only), force); err != nil {
+				return err
+			}
+
+			logger.Infoln("Running bootstrap configuration...")
+
+			if err := bootstrapInstance.ConfigureSteps(utils.UniqueSlice(only)); err != nil {
 				return fmt.Errorf("bootstrap configuration failed: %w", err)
 			}
 
-			logger.Infof("Bootstrap configuration completed successfully.")
+			logger.Resultln("Bootstrap configuration completed successfully.")
 
 			return nil
 		},
 	}
 
+	cmd.Flags().StringSliceVar(&only, "only", []string{}, "Comma-separated configuration steps to run, instead of all of them (see --list-steps)")
+	_ = cmd.RegisterFlagCompletionFunc("only", completion.ConfigureSteps)
+	cmd.Flags().BoolVar(&listSteps, "list-steps", false, "List the configuration steps of the selected runtime")
+	cmd.MarkFlagsMutuallyExclusive("only", "list-steps")
+
+	addOperatorTimeoutFlag(cmd)
+	addOperatorsNamespaceMapFlag(cmd)
+	addMinServiceReportVersionFlag(cmd)
+	cmd.Flags().BoolVar(&vars.ApplyRecommendations, "apply-recommendations", vars.ApplyRecommendations,
+		"Apply the known fixes recommended by the servicereport tool, printing each of them and asking for a confirmation, "+
+			"rather than only reporting them (only applicable for podman runtime)")
+	cmd.Flags().BoolVarP(&vars.AutoYes, "yes", "y", false,
+		"Apply the actions recommended by the servicereport tool without asking for a confirmation (default=false)")
+	addForceFlag(cmd, &force)
+	audit.MarkMutating(cmd)
+
 	return cmd
 }
+
+func printSteps(all []steps.Step) {
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("STEP", "DESCRIPTION")
+	for _, step := range all {
+		printer.AppendRow(step.Name, step.Description)
+	}
+}
+
+func configureExample() string {
+	return `  # Run all the configuration steps
+  ai-services bootstrap configure
+
+  # List the configuration steps
+  ai-services bootstrap configure --list-steps
+
+  # Re-run only the servicereport step
+  ai-services bootstrap configure --only servicereport
+
+  # Apply the actions recommended by servicereport along with its repairs
+  ai-services bootstrap configure --only servicereport --apply-recommendations
+
+  # Apply them without asking for a confirmation of each of them
+  ai-services bootstrap configure --only servicereport --apply-recommendations --yes`
+}
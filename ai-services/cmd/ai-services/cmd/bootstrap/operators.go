@@ -0,0 +1,68 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	operatorsNamespaceMapFlag = "operators-namespace-map"
+	// operatorsNamespaceMapEnv sets the namespace overrides, unless overridden by the flag.
+	operatorsNamespaceMapEnv = "AI_SERVICES_OPERATORS_NAMESPACE_MAP"
+)
+
+// addOperatorsNamespaceMapFlag registers the flag overriding the namespaces the required operators are installed in.
+func addOperatorsNamespaceMapFlag(cmd *cobra.Command) {
+	defaults := make([]string, 0, len(constants.RequiredOperators))
+	for _, op := range constants.RequiredOperators {
+		defaults = append(defaults, op.Name+"="+op.Namespace)
+	}
+
+	cmd.Flags().StringToStringVar(&vars.OperatorNamespaces, operatorsNamespaceMapFlag, nil,
+		fmt.Sprintf("Comma-separated operator=namespace pairs overriding the namespace an operator is checked and installed in, "+
+			"e.g. nfd=custom-nfd for an operator installed in a custom namespace. Defaults to %s. Also set by %s (only applicable for OpenShift runtime)",
+			strings.Join(defaults, ","), operatorsNamespaceMapEnv))
+}
+
+// validateOperatorsNamespaceMap applies the namespace overrides of the environment unless set by the flag,
+// and checks that they are valid namespaces of known operators.
+func validateOperatorsNamespaceMap(cmd *cobra.Command) error {
+	if env := os.Getenv(operatorsNamespaceMapEnv); env != "" && !cmd.Flags().Changed(operatorsNamespaceMapFlag) {
+		if err := cmd.Flags().Set(operatorsNamespaceMapFlag, env); err != nil {
+			return fmt.Errorf("invalid %s '%s': %w", operatorsNamespaceMapEnv, env, err)
+		}
+	}
+
+	names := make([]string, 0, len(constants.RequiredOperators))
+	for _, op := range constants.RequiredOperators {
+		names = append(names, op.Name)
+	}
+
+	for name, ns := range vars.OperatorNamespaces {
+		if !isRequiredOperator(name) {
+			return fmt.Errorf("invalid --%s: unknown operator '%s', must be one of: %s", operatorsNamespaceMapFlag, name, strings.Join(names, ", "))
+		}
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return fmt.Errorf("invalid --%s: namespace '%s' of operator '%s' is invalid: %s", operatorsNamespaceMapFlag, ns, name, strings.Join(errs, "; "))
+		}
+	}
+
+	return nil
+}
+
+func isRequiredOperator(name string) bool {
+	for _, op := range constants.RequiredOperators {
+		if op.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
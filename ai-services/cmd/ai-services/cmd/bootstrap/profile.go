@@ -0,0 +1,85 @@
+package bootstrap
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// profileFlags holds the flags selecting the validation profile.
+type profileFlags struct {
+	name string
+	list bool
+}
+
+func (f *profileFlags) register(cmd *cobra.Command) {
+	names := make([]string, 0, len(validators.Profiles))
+	for _, p := range validators.Profiles {
+		names = append(names, p.Name)
+	}
+
+	cmd.Flags().StringVar(&f.name, "profile", validators.ProfileProd,
+		"Validation profile selecting the checks to run and their thresholds: "+strings.Join(names, "|"))
+	_ = cmd.RegisterFlagCompletionFunc("profile", completion.ValidationProfiles)
+	cmd.Flags().BoolVar(&f.list, "list-profiles", false, "List the validation profiles and exit")
+}
+
+func (f *profileFlags) validate() error {
+	_, err := validators.LookupProfile(f.name)
+
+	return err
+}
+
+// apply configures the selected profile, returning the checks to skip.
+func (f *profileFlags) apply(skip map[string]bool) map[string]bool {
+	// already validated in PreRunE
+	p, _ := validators.LookupProfile(f.name)
+	rt := vars.RuntimeFactory.GetRuntimeType()
+
+	if skipped := p.Skip[rt]; len(skipped) > 0 {
+		logger.Warningf("Validation profile '%s' skips the checks: %s\n", p.Name, strings.Join(skipped, ", "))
+	}
+	logger.Infof("Using validation profile '%s'\n", p.Name, logger.VerbosityLevelDebug)
+
+	return p.Apply(rt, skip)
+}
+
+func printProfiles() {
+	p := utils.NewTableWriter()
+	defer p.CloseTableWriter()
+
+	p.SetHeaders("PROFILE", "DESCRIPTION", "SKIPPED CHECKS", "LPAR AFFINITY THRESHOLD")
+	for _, profile := range validators.Profiles {
+		p.AppendRow(profile.Name, profile.Description, skippedChecks(profile), strconv.Itoa(profile.LparAffinityThreshold)+"%")
+	}
+}
+
+// skippedChecks renders the checks skipped by the profile, Eg:- "podman: rhn".
+func skippedChecks(profile validators.Profile) string {
+	runtimes := make([]string, 0, len(profile.Skip))
+	for rt := range profile.Skip {
+		runtimes = append(runtimes, string(rt))
+	}
+	sort.Strings(runtimes)
+
+	parts := []string{}
+	for _, rt := range runtimes {
+		if checks := profile.Skip[types.RuntimeType(rt)]; len(checks) > 0 {
+			parts = append(parts, rt+": "+strings.Join(checks, ","))
+		}
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,62 @@
+package bootstrap
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const targetFlag = "target"
+
+// addTargetFlag registers the flag validating a remote LPAR over ssh, Eg:- from a management node.
+func addTargetFlag(cmd *cobra.Command, ref *string) {
+	cmd.Flags().StringVar(ref, targetFlag, "",
+		"Validate the remote LPAR [user@]host over ssh in place of the local host, e.g. when running from a management node. "+
+			"Only the checks reading the host, such as power, rhel, numa, spyre and vfio, are run, the others are skipped. "+
+			"The ssh configuration and keys of the user are used, no password is prompted for (only applicable for Podman runtime)")
+}
+
+// validateTargetFlag checks that the remote host can be validated along with the other flags.
+func validateTargetFlag(target string) error {
+	if target == "" {
+		return nil
+	}
+	if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypePodman {
+		return fmt.Errorf("--%s is only supported for the %s runtime", targetFlag, types.RuntimeTypePodman)
+	}
+	if vars.FixChecks {
+		return fmt.Errorf("--fix cannot be used along with --%s, the checks are only remediated on the local host", targetFlag)
+	}
+
+	return nil
+}
+
+// useTarget substitutes the filesystem of the remote host, read over ssh, for the local one so that the host checks
+// verify it, and returns the function switching back to the local host.
+func useTarget(target string) (restore func(), err error) {
+	remote, err := hostfs.DialSSH(target)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreFS := hostfs.Substitute(remote)
+	// the platform info and the facts are cached, they are detected again from the remote host
+	platform.Reset()
+	facts.Reset()
+
+	return func() {
+		restoreFS()
+		platform.Reset()
+		facts.Reset()
+		if err := remote.Close(); err != nil {
+			logger.Warningf("failed to close the ssh connection to %s: %v\n", target, err)
+		}
+	}, nil
+}
@@ -5,8 +5,13 @@ import (
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/completion"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	"github.com/spf13/cobra"
@@ -26,7 +31,21 @@ const troubleshootingGuide = "https://www.ibm.com/docs/aiservices?topic=services
 
 // validateCmd represents the validate subcommand of bootstrap.
 func validateCmd() *cobra.Command {
-	var skipChecks []string
+	var (
+		skipChecks  []string
+		contexts    []string
+		allContexts bool
+		reportFile  string
+		metricsFile string
+		configMap   string
+		explainName string
+		compareFile string
+		target      string
+		baseline    *bootstrap.ValidationReport
+		profile     profileFlags
+		// all the failures are collected by default, as reporting them is the purpose of validate
+		continueOnFailure = true
+	)
 
 	cmd := &cobra.Command{
 		Use:     "validate",
@@ -34,34 +53,233 @@ func validateCmd() *cobra.Command {
 		Long:    validateDescription(),
 		Example: validateExample(),
 		Hidden:  true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			if (len(contexts) > 0 || allContexts) && vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypeOpenShift {
+				return fmt.Errorf("--contexts and --all-contexts are only supported for the %s runtime", types.RuntimeTypeOpenShift)
+			}
+			if configMap != "" {
+				if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypeOpenShift {
+					return fmt.Errorf("--%s is only supported for the %s runtime", fromConfigMapFlag, types.RuntimeTypeOpenShift)
+				}
+				if err := applyConfigMap(cmd, configMap); err != nil {
+					return err
+				}
+			}
+			if cmd.Flags().Changed(waitReadyFlag) {
+				if vars.RuntimeFactory.GetRuntimeType() != types.RuntimeTypeOpenShift {
+					return fmt.Errorf("--%s is only supported for the %s runtime", waitReadyFlag, types.RuntimeTypeOpenShift)
+				}
+				if vars.WaitReady <= 0 {
+					return fmt.Errorf("invalid --%s %s, must be positive", waitReadyFlag, vars.WaitReady)
+				}
+			}
+			if err := validateOperatorsNamespaceMap(cmd); err != nil {
+				return err
+			}
+			if err := validateTargetFlag(target); err != nil {
+				return err
+			}
+			if compareFile != "" {
+				var err error
+				if baseline, err = bootstrap.ReadReportFile(compareFile); err != nil {
+					return err
+				}
+			}
+
+			return profile.validate()
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Once precheck passes, silence usage for any *later* internal errors.
 			cmd.SilenceUsage = true
+			vars.ContinueOnFailure = continueOnFailure
 
-			logger.Infoln("Running bootstrap validation...")
+			if profile.list {
+				printProfiles()
+
+				return nil
+			}
+			if explainName != "" {
+				return explainCheck(explainName)
+			}
+
+			if target != "" {
+				restore, err := useTarget(target)
+				if err != nil {
+					return err
+				}
+				defer restore()
+				logger.Infof("Running bootstrap validation of %s over ssh...\n", target)
+			} else {
+				logger.Infoln("Running bootstrap validation...")
+			}
 
 			skip := helpers.ParseSkipChecks(skipChecks)
 			if len(skip) > 0 {
 				logger.Warningln("Skipping validation checks: " + strings.Join(skipChecks, ", "))
 			}
+			skip = profile.apply(skip)
 
 			factory := bootstrap.NewBootstrapFactory(vars.RuntimeFactory.GetRuntimeType())
-			if err := factory.Validate(skip); err != nil {
+
+			targetContexts, err := resolveContexts(contexts, allContexts)
+			if err != nil {
+				return err
+			}
+
+			var report *bootstrap.ValidationReport
+			if len(targetContexts) > 0 {
+				report, err = factory.ValidateContexts(cmd.Context(), targetContexts, skip)
+			} else {
+				report, err = factory.ValidateWithReport(cmd.Context(), skip)
+			}
+
+			if reportErr := writeReport(reportFile, report); reportErr != nil {
+				if err == nil {
+					return reportErr
+				}
+				logger.Warningf("%v\n", reportErr)
+			}
+			if metricsErr := writeMetrics(metricsFile, report); metricsErr != nil {
+				if err == nil {
+					return metricsErr
+				}
+				logger.Warningf("%v\n", metricsErr)
+			}
+
+			driftErr := compareReport(compareFile, baseline, report)
+
+			if err != nil {
 				logger.Infof("Please refer to troubleshooting guide for more information: %s", troubleshootingGuide)
+				if target != "" {
+					return fmt.Errorf("bootstrap validation of %s failed: %w", target, err)
+				}
 
 				return fmt.Errorf("bootstrap validation failed: %w", err)
 			}
 
-			return nil
+			return driftErr
 		},
 	}
 
 	skipCheckDesc := BuildSkipFlagDescription()
 	cmd.Flags().StringSliceVar(&skipChecks, "skip-validation", []string{}, skipCheckDesc)
+	_ = cmd.RegisterFlagCompletionFunc("skip-validation", completion.ValidationChecks)
+
+	cmd.Flags().StringSliceVar(&contexts, "contexts", []string{}, "Comma-separated kubeconfig contexts to validate, each one is validated independently (only applicable for OpenShift runtime)")
+	cmd.Flags().BoolVar(&allContexts, "all-contexts", false, "Validate all the contexts defined in the kubeconfig (only applicable for OpenShift runtime)")
+	cmd.MarkFlagsMutuallyExclusive("contexts", "all-contexts")
+
+	profile.register(cmd)
+	addMinOpenShiftVersionFlag(cmd)
+	addOperatorsNamespaceMapFlag(cmd)
+	addFromConfigMapFlag(cmd, &configMap)
+	addMinServiceReportVersionFlag(cmd)
+	addWarningsAsErrorsFlag(cmd)
+	addContinueOnFailureFlag(cmd, &continueOnFailure, continueOnFailure)
+	addWaitReadyFlag(cmd)
+	addTargetFlag(cmd, &target)
+	cmd.Flags().BoolVar(&vars.FixChecks, "fix", vars.FixChecks,
+		"Remediate the failed checks which support it (e.g. load the missing vfio kernel modules and persist them across reboot), then verify them again")
+
+	cmd.Flags().StringVar(&reportFile, "report-file", "", "Write the full validation report, including timings and hints, to the given path. Written as YAML for a .yaml or .yml path, as JSON otherwise")
+
+	cmd.Flags().StringVar(&metricsFile, "metrics-file", "",
+		"Write the outcome of the checks, the LPAR affinity and the number of Spyre cards as Prometheus gauges to the given path, "+
+			"e.g. for the textfile collector of node_exporter")
+
+	cmd.Flags().StringVar(&compareFile, "compare", "",
+		"Compare the results with a previously saved report of --report-file (e.g. a known-good one), print the checks which "+
+			"changed state, the measurements which dropped and the versions which went back (e.g. RHEL or OpenShift), and fail when any drifted")
+
+	cmd.Flags().StringVar(&explainName, "explain", "",
+		"Print what the given check verifies, why it matters and how to remediate its failure step by step, then exit without validating")
+	_ = cmd.RegisterFlagCompletionFunc("explain", completion.ValidationChecks)
 
 	return cmd
 }
 
+// explainCheck prints the in-depth explanation of the named check, of either runtime.
+func explainCheck(name string) error {
+	rule, ok := validators.PodmanRegistry.Rule(name)
+	if !ok {
+		rule, ok = validators.OpenshiftRegistry.Rule(name)
+	}
+	if !ok {
+		return fmt.Errorf("unknown check '%s' for --explain\n%s", name, BuildSkipFlagDescription())
+	}
+
+	logger.Resultf("%s: %s\nLevel: %s\n", rule.Name(), rule.Description(), rule.Level())
+	if deps := validators.DependenciesOf(rule); len(deps) > 0 {
+		logger.Resultf("Requires: %s\n", strings.Join(deps, ", "))
+	}
+	logger.Resultf("\n%s", validators.ExplanationOf(rule).Render())
+
+	return nil
+}
+
+// compareReport prints the drift of the validation report from the baseline report read from path, if one was requested,
+// and returns a validation failure when any check drifted.
+func compareReport(path string, baseline, report *bootstrap.ValidationReport) error {
+	if baseline == nil || report == nil {
+		return nil
+	}
+
+	drifts := bootstrap.CompareReports(baseline, report)
+	if len(drifts) == 0 {
+		logger.Infof("No drift from the baseline report %s\n", path)
+
+		return nil
+	}
+	logger.Resultf("Drift from the baseline report %s:\n%s", path, bootstrap.RenderDrift(drifts))
+
+	return exitcode.MarkValidationFailed(fmt.Errorf("drift detected from the baseline report %s: %d changes", path, len(drifts)))
+}
+
+// writeMetrics writes the validation report to path in the Prometheus textfile format, if one was requested.
+func writeMetrics(path string, report *bootstrap.ValidationReport) error {
+	if path == "" || report == nil {
+		return nil
+	}
+
+	if err := bootstrap.WriteMetricsFile(path, report); err != nil {
+		return err
+	}
+	logger.Infof("Validation metrics written to %s\n", path, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// writeReport writes the validation report to path, if one was requested.
+func writeReport(path string, report *bootstrap.ValidationReport) error {
+	if path == "" || report == nil {
+		return nil
+	}
+
+	if err := bootstrap.WriteReportFile(path, report); err != nil {
+		return err
+	}
+	logger.Infof("Validation report written to %s\n", path, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// resolveContexts returns the kubeconfig contexts to validate, none means only the current context.
+func resolveContexts(contexts []string, allContexts bool) ([]string, error) {
+	if !allContexts {
+		return utils.UniqueSlice(contexts), nil
+	}
+
+	all, err := openshift.ListContexts()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list kubeconfig contexts: %w", err)
+	}
+	if len(all) == 0 {
+		return nil, fmt.Errorf("no contexts found in the kubeconfig")
+	}
+
+	return all, nil
+}
+
 func validateDescription() string {
 	podmanList, openshiftList := generateValidationList()
 
@@ -85,6 +303,45 @@ func validateExample() string {
   # Skip multiple checks
   ai-services bootstrap validate --skip-validation rhn,power
   
+  # Relax the checks for a development sandbox
+  ai-services bootstrap validate --profile dev
+
+  # List the validation profiles
+  ai-services bootstrap validate --list-profiles
+
+  # Validate multiple OpenShift clusters
+  ai-services bootstrap validate --runtime openshift --contexts ctx1,ctx2
+
+  # Validate a remote LPAR over ssh from a management node
+  ai-services bootstrap validate --target root@lpar1.example.com
+
+  # Load the missing vfio kernel modules, and persist them across reboot
+  ai-services bootstrap validate --fix
+
+  # Validate with the configuration declared in a ConfigMap of the cluster
+  ai-services bootstrap validate --runtime openshift --from-configmap ai-services/validation-config
+
+  # Stop at the first critical failure, such as not running as root, rather than reporting all the failed checks
+  ai-services bootstrap validate --continue-on-failure=false
+
+  # Wait for the operators being installed, as a gate of the cluster bring-up
+  ai-services bootstrap validate --runtime openshift --wait-ready 15m
+
+  # Fail on the checks reporting a warning
+  ai-services bootstrap validate --warnings-as-errors
+
+  # Write the validation report for CI to a file
+  ai-services bootstrap validate --report-file validation-report.json
+
+  # Detect the drift from a known-good validation report
+  ai-services bootstrap validate --compare known-good-report.json
+
+  # Expose the validation to the textfile collector of node_exporter
+  ai-services bootstrap validate --metrics-file /var/lib/node_exporter/textfile_collector/ai_services.prom
+
+  # Explain the NUMA alignment check and how to fix it
+  ai-services bootstrap validate --explain numa
+
   # Run with verbose output
   ai-services bootstrap validate --verbose`
 }
@@ -111,6 +368,9 @@ func createRuleList(rules []validators.Rule) string {
 		description := rule.Description()
 		padding := strings.Repeat(" ", maxLen-len(ruleName))
 		fmt.Fprintf(&b, " - %s:%s %s", rule.Name(), padding, description)
+		if deps := validators.DependenciesOf(rule); len(deps) > 0 {
+			fmt.Fprintf(&b, " (requires: %s)", strings.Join(deps, ", "))
+		}
 
 		if i < len(rules)-1 {
 			b.WriteString("\n")
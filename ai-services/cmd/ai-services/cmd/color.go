@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
+)
+
+const (
+	noColorFlag    = "no-color"
+	forceColorFlag = "force-color"
+	themeFlag      = "theme"
+
+	// themeEnv sets the color theme, unless overridden by the flag.
+	themeEnv = "AI_SERVICES_THEME"
+)
+
+var (
+	// Global color flags.
+	noColor    bool
+	forceColor bool
+	theme      string
+)
+
+func initColorFlags() {
+	RootCmd.PersistentFlags().BoolVar(&noColor, noColorFlag, false, "Disable colored output (also honors NO_COLOR).")
+	RootCmd.PersistentFlags().BoolVar(&forceColor, forceColorFlag, false, "Force colored output even when stdout is not a terminal (also honors CLICOLOR_FORCE).")
+	RootCmd.PersistentFlags().StringVar(&theme, themeFlag, "",
+		fmt.Sprintf("Color theme of the output (options: %s, %s, %s). Also set by %s.", style.ThemeDefault, style.ThemeHighContrast, style.ThemeMonochrome, themeEnv))
+}
+
+// configureColorOutput sets the lipgloss color profile based on the color flags, and the theme of the styled output.
+// Explicit flags take precedence over NO_COLOR and CLICOLOR_FORCE environment variables,
+// which are otherwise honored by lipgloss itself.
+func configureColorOutput() error {
+	if noColor && forceColor {
+		return fmt.Errorf("--%s and --%s are mutually exclusive, set only one of them", noColorFlag, forceColorFlag)
+	}
+	if err := configureTheme(); err != nil {
+		return err
+	}
+
+	switch {
+	case noColor:
+		lipgloss.SetColorProfile(termenv.Ascii)
+	case forceColor || isColorForcedByEnv():
+		// ignore the TTY check, so that the profile is still detected from TERM and COLORTERM
+		profile := termenv.NewOutput(os.Stdout, termenv.WithUnsafe()).ColorProfile()
+		if profile == termenv.Ascii {
+			profile = termenv.ANSI
+		}
+		lipgloss.SetColorProfile(profile)
+	}
+
+	return nil
+}
+
+func isColorForcedByEnv() bool {
+	forced := os.Getenv("CLICOLOR_FORCE")
+
+	return forced != "" && forced != "0" && os.Getenv("NO_COLOR") == ""
+}
+
+// configureTheme selects the theme set by the flag or else the environment.
+func configureTheme() error {
+	name := theme
+	if name == "" {
+		name = os.Getenv(themeEnv)
+	}
+
+	selected, err := style.Parse(name)
+	if err != nil {
+		return fmt.Errorf("invalid --%s or %s: %w", themeFlag, themeEnv, err)
+	}
+	style.SetTheme(selected)
+
+	return nil
+}
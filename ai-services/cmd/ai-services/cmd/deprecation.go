@@ -0,0 +1,25 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/deprecation"
+)
+
+// recordDeprecatedFlags records the deprecated flags set on the command line, for the structured outputs.
+// Their warning is already logged by cobra while parsing them.
+func recordDeprecatedFlags(cmd *cobra.Command) {
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if f.Deprecated == "" {
+			return
+		}
+		deprecation.Record(deprecation.Warning{
+			Kind:    deprecation.KindFlag,
+			Name:    "--" + f.Name,
+			Message: fmt.Sprintf("Flag --%s has been deprecated, %s", f.Name, f.Deprecated),
+		})
+	})
+}
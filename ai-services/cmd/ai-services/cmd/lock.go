@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/lock"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const forceUnlockFlag = "force-unlock"
+
+var (
+	// Global flag taking over the lock of an operation which hung.
+	forceUnlock bool
+
+	// runLock is the lock held by the mutating command for the run, if any.
+	runLock *lock.Lock
+)
+
+func initLockFlags() {
+	RootCmd.PersistentFlags().BoolVar(&forceUnlock, forceUnlockFlag, false,
+		"Take over the lock held by another ai-services operation, e.g. one which hung or was killed. "+
+			"Use it only once that operation no longer runs.")
+}
+
+// acquireLock takes the lock of the host for the mutating commands, so that two operations,
+// Eg:- a bootstrap configure and an application create, do not change the host concurrently.
+// The read-only commands run alongside them.
+func acquireLock(cmd *cobra.Command) error {
+	if !audit.IsMutating(cmd) || lock.IsExempt(cmd) {
+		return nil
+	}
+
+	path := lock.DefaultPath()
+	if forceUnlock {
+		logger.Warningf("Forcing the unlock of %s, make sure no other ai-services operation is running\n", path)
+		if err := lock.ForceUnlock(path); err != nil {
+			return err
+		}
+	}
+
+	l, err := lock.Acquire(path, strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "))
+	if err != nil {
+		return err
+	}
+	runLock = l
+	logger.Infof("Acquired the lock %s\n", path, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// releaseLock releases the lock taken for the run, the kernel releases it anyway once the process exits.
+func releaseLock() {
+	if err := runLock.Release(); err != nil {
+		logger.Warningf("failed to release the lock: %v\n", err)
+	}
+	runLock = nil
+}
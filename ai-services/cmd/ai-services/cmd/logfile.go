@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	logFileFlag       = "log-file"
+	logMaxSizeFlag    = "log-max-size"
+	logMaxBackupsFlag = "log-max-backups"
+
+	defaultLogMaxSizeMB  = 10
+	defaultLogMaxBackups = 2
+
+	bytesPerMB = 1 << 20
+)
+
+var (
+	// Global log file flags, the path is kept in vars.LogFile.
+	logMaxSizeMB  int
+	logMaxBackups int
+
+	// logFile is the log file opened for the run, if any.
+	logFile *logger.RotatingFile
+
+	// restoreOutputs restores the outputs teed to the log file, if any.
+	restoreOutputs func()
+)
+
+func initLogFileFlags() {
+	RootCmd.PersistentFlags().StringVar(&vars.LogFile, logFileFlag, "",
+		"Path of a log file the output is also appended to, across the runs.")
+	RootCmd.PersistentFlags().IntVar(&logMaxSizeMB, logMaxSizeFlag, defaultLogMaxSizeMB,
+		"Size in MB of the log file at which it gets rotated, 0 disables the rotation.")
+	RootCmd.PersistentFlags().IntVar(&logMaxBackups, logMaxBackupsFlag, defaultLogMaxBackups,
+		"Number of rotated log files kept next to the log file.")
+}
+
+// configureLogFile tees the logger output and the errors printed by cobra to the log file given by --log-file,
+// rotated past --log-max-size. The current outputs are kept, Eg:- the buffers capturing them in the tests.
+func configureLogFile(cmd *cobra.Command) error {
+	if vars.LogFile == "" {
+		return nil
+	}
+	if logMaxSizeMB < 0 {
+		return fmt.Errorf("invalid --%s %d, must not be negative", logMaxSizeFlag, logMaxSizeMB)
+	}
+	if logMaxBackups < 0 {
+		return fmt.Errorf("invalid --%s %d, must not be negative", logMaxBackupsFlag, logMaxBackups)
+	}
+
+	f, err := logger.OpenRotatingFile(vars.LogFile, int64(logMaxSizeMB)*bytesPerMB, logMaxBackups)
+	if err != nil {
+		return err
+	}
+	logFile = f
+
+	stdout, stderr, cmdErr := logger.Output(), logger.ErrorOutput(), cmd.Root().ErrOrStderr()
+	logger.SetOutput(io.MultiWriter(stdout, f))
+	logger.SetErrorOutput(io.MultiWriter(stderr, f))
+	cmd.Root().SetErr(io.MultiWriter(cmdErr, f))
+	restoreOutputs = func() {
+		logger.SetOutput(stdout)
+		logger.SetErrorOutput(stderr)
+		cmd.Root().SetErr(cmdErr)
+	}
+
+	return nil
+}
+
+// closeLogFile restores the logger output and closes the log file, if any.
+func closeLogFile() {
+	if logFile == nil {
+		return
+	}
+
+	restoreOutputs()
+	restoreOutputs = nil
+	_ = logFile.Close()
+	logFile = nil
+}
@@ -0,0 +1,100 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	retryCountFlag    = "retry-count"
+	retryIntervalFlag = "retry-interval"
+
+	// retryCountEnv and retryIntervalEnv set the retries, unless overridden by the flags.
+	retryCountEnv    = "AI_SERVICES_RETRY_COUNT"
+	retryIntervalEnv = "AI_SERVICES_RETRY_INTERVAL"
+
+	maxRetryCount    = 20
+	maxRetryInterval = 10 * time.Minute
+)
+
+// defaultReadiness is the policy of the readiness checks, whose fields are overridden by the retries set for the run.
+var defaultReadiness = retry.Readiness
+
+func initRetryFlags() {
+	RootCmd.PersistentFlags().IntVar(&vars.RetryCount, retryCountFlag, vars.RetryCount,
+		fmt.Sprintf("Number of retries of the failed operations, e.g. the image pulls (between 0 and %d). "+
+			"The readiness checks make %d retries unless it is set. Also set by %s.", maxRetryCount, defaultReadiness.Attempts, retryCountEnv))
+	RootCmd.PersistentFlags().DurationVar(&vars.RetryInterval, retryIntervalFlag, vars.RetryInterval,
+		fmt.Sprintf("Interval between two retries of a failed operation (up to %s). "+
+			"The readiness checks retry every %s unless it is set. Also set by %s.", maxRetryInterval, defaultReadiness.Interval, retryIntervalEnv))
+}
+
+// configureRetries applies the retries set by the flags or else the environment, to all the retried operations.
+func configureRetries(cmd *cobra.Command) error {
+	if err := retryCountFromEnv(cmd); err != nil {
+		return err
+	}
+	if err := retryIntervalFromEnv(cmd); err != nil {
+		return err
+	}
+
+	if vars.RetryCount < 0 || vars.RetryCount > maxRetryCount {
+		return fmt.Errorf("invalid retry count %d (--%s or %s), must be between 0 and %d", vars.RetryCount, retryCountFlag, retryCountEnv, maxRetryCount)
+	}
+	if vars.RetryInterval < 0 || vars.RetryInterval > maxRetryInterval {
+		return fmt.Errorf("invalid retry interval %s (--%s or %s), must be between 0s and %s", vars.RetryInterval, retryIntervalFlag, retryIntervalEnv, maxRetryInterval)
+	}
+
+	// the readiness checks keep their own policy, but for the retries tuned for this run
+	readiness := defaultReadiness
+	if cmd.Flags().Changed(retryCountFlag) || envSet(retryCountEnv) {
+		readiness.Attempts = vars.RetryCount
+	}
+	if cmd.Flags().Changed(retryIntervalFlag) || envSet(retryIntervalEnv) {
+		readiness.Interval = vars.RetryInterval
+	}
+	retry.Readiness = readiness
+
+	return nil
+}
+
+func retryCountFromEnv(cmd *cobra.Command) error {
+	v, ok := os.LookupEnv(retryCountEnv)
+	if !ok || v == "" || cmd.Flags().Changed(retryCountFlag) {
+		return nil
+	}
+
+	count, err := strconv.Atoi(v)
+	if err != nil {
+		return fmt.Errorf("invalid %s '%s': %w", retryCountEnv, v, err)
+	}
+	vars.RetryCount = count
+
+	return nil
+}
+
+func retryIntervalFromEnv(cmd *cobra.Command) error {
+	v, ok := os.LookupEnv(retryIntervalEnv)
+	if !ok || v == "" || cmd.Flags().Changed(retryIntervalFlag) {
+		return nil
+	}
+
+	interval, err := time.ParseDuration(v)
+	if err != nil {
+		return fmt.Errorf("invalid %s '%s': %w", retryIntervalEnv, v, err)
+	}
+	vars.RetryInterval = interval
+
+	return nil
+}
+
+func envSet(env string) bool {
+	return os.Getenv(env) != ""
+}
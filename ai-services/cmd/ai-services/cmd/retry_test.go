@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// newRetryCmd returns a command with the retry flags, as registered on the root command, restoring the retries
+// once the test is done.
+func newRetryCmd(t *testing.T) *cobra.Command {
+	t.Helper()
+
+	count, interval, readiness := vars.RetryCount, vars.RetryInterval, retry.Readiness
+	t.Cleanup(func() {
+		vars.RetryCount, vars.RetryInterval, retry.Readiness = count, interval, readiness
+	})
+
+	cmd := &cobra.Command{Use: "ai-services"}
+	cmd.Flags().IntVar(&vars.RetryCount, retryCountFlag, vars.RetryCount, "")
+	cmd.Flags().DurationVar(&vars.RetryInterval, retryIntervalFlag, vars.RetryInterval, "")
+
+	return cmd
+}
+
+func TestConfigureRetries(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		env           map[string]string
+		wantCount     int
+		wantInterval  time.Duration
+		wantReadiness retry.Policy
+		wantErr       string
+	}{
+		{
+			name:         "defaults",
+			wantCount:    vars.RetryCount,
+			wantInterval: vars.RetryInterval,
+		},
+		{
+			name:          "set by the environment",
+			env:           map[string]string{retryCountEnv: "5", retryIntervalEnv: "30s"},
+			wantCount:     5,
+			wantInterval:  30 * time.Second,
+			wantReadiness: retry.Policy{Attempts: 5, Interval: 30 * time.Second},
+		},
+		{
+			name:          "flags take precedence over the environment",
+			args:          []string{"--retry-count", "1", "--retry-interval", "2s"},
+			env:           map[string]string{retryCountEnv: "5", retryIntervalEnv: "30s"},
+			wantCount:     1,
+			wantInterval:  2 * time.Second,
+			wantReadiness: retry.Policy{Attempts: 1, Interval: 2 * time.Second},
+		},
+		{
+			name:          "flag and environment combined",
+			args:          []string{"--retry-count", "0"},
+			env:           map[string]string{retryIntervalEnv: "1m"},
+			wantCount:     0,
+			wantInterval:  time.Minute,
+			wantReadiness: retry.Policy{Attempts: 0, Interval: time.Minute},
+		},
+		{
+			name:          "only the count set",
+			args:          []string{"--retry-count", "8"},
+			wantCount:     8,
+			wantInterval:  vars.RetryInterval,
+			wantReadiness: retry.Policy{Attempts: 8, Interval: defaultReadiness.Interval},
+		},
+		{
+			name:         "empty environment ignored",
+			env:          map[string]string{retryCountEnv: "", retryIntervalEnv: ""},
+			wantCount:    vars.RetryCount,
+			wantInterval: vars.RetryInterval,
+		},
+		{
+			name:    "non-numeric count",
+			env:     map[string]string{retryCountEnv: "three"},
+			wantErr: "invalid AI_SERVICES_RETRY_COUNT 'three'",
+		},
+		{
+			name:    "invalid interval",
+			env:     map[string]string{retryIntervalEnv: "5"},
+			wantErr: "invalid AI_SERVICES_RETRY_INTERVAL '5'",
+		},
+		{
+			name:    "negative count flag",
+			args:    []string{"--retry-count", "-1"},
+			wantErr: "invalid retry count -1 (--retry-count or AI_SERVICES_RETRY_COUNT), must be between 0 and 20",
+		},
+		{
+			name:    "negative count environment",
+			env:     map[string]string{retryCountEnv: "-2"},
+			wantErr: "invalid retry count -2 (--retry-count or AI_SERVICES_RETRY_COUNT), must be between 0 and 20",
+		},
+		{
+			name:    "count too high",
+			env:     map[string]string{retryCountEnv: "21"},
+			wantErr: "invalid retry count 21 (--retry-count or AI_SERVICES_RETRY_COUNT), must be between 0 and 20",
+		},
+		{
+			name:    "negative interval",
+			args:    []string{"--retry-interval", "-5s"},
+			wantErr: "invalid retry interval -5s (--retry-interval or AI_SERVICES_RETRY_INTERVAL), must be between 0s and 10m0s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(retryCountEnv, "")
+			t.Setenv(retryIntervalEnv, "")
+			for env, value := range tt.env {
+				t.Setenv(env, value)
+			}
+
+			cmd := newRetryCmd(t)
+			if err := cmd.Flags().Parse(tt.args); err != nil {
+				t.Fatal(err)
+			}
+
+			err := configureRetries(cmd)
+			if tt.wantErr != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+					t.Errorf("configureRetries() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("configureRetries() unexpected error = %v", err)
+			}
+			if vars.RetryCount != tt.wantCount || vars.RetryInterval != tt.wantInterval {
+				t.Errorf("configureRetries() = %d retries every %s, want %d every %s", vars.RetryCount, vars.RetryInterval, tt.wantCount, tt.wantInterval)
+			}
+
+			wantReadiness := tt.wantReadiness
+			if wantReadiness == (retry.Policy{}) {
+				wantReadiness = defaultReadiness
+			}
+			if retry.Readiness != wantReadiness {
+				t.Errorf("retry.Readiness = %+v, want %+v", retry.Readiness, wantReadiness)
+			}
+		})
+	}
+}
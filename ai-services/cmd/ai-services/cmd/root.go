@@ -9,49 +9,181 @@ import (
 
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/application"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/bootstrap"
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/supportbundle"
 	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+// runtimeFlag selects the runtime, detected from the environment when not set.
+const runtimeFlag = "runtime"
+
 var (
 	// Global runtime type flag.
 	runtimeType string
+
+	// Global quiet flag.
+	quiet bool
+
+	// Global audit log path flag.
+	auditLogPath string
+
+	// Global flag to skip the TLS certificate verification.
+	insecureSkipTLSVerify bool
+
+	// Global working directory flag.
+	workDir string
+
+	// Global flag of the exit code when the validation passes with warnings.
+	exitCodeOnWarn int
+
+	// preRunStarted is set once the command line is parsed and validated by cobra,
+	// the errors returned before are usage errors, Eg:- an unknown flag or a missing argument.
+	preRunStarted bool
 )
 
 // RootCmd represents the base command when called without any subcommands.
 var RootCmd = &cobra.Command{
-	Use:     "ai-services",
-	Short:   "AI Services CLI",
-	Long:    `A CLI tool for managing AI Services infrastructure.`,
+	Use:   "ai-services",
+	Short: "AI Services CLI",
+	Long: `A CLI tool for managing AI Services infrastructure.
+
+Exit codes:
+  0    Success
+  1    Generic error
+  2    Validation failure, including the warnings treated as errors with --warnings-as-errors
+  3    Usage error, e.g. an unknown flag or an invalid argument
+  124  Timeout, when the deadline set with --global-timeout is exceeded
+  N    Validation passed with warnings, when --exit-code-on-warn N is set`,
 	Version: version.GetVersion(),
 	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		preRunStarted = true
 		cmd.SilenceUsage = true
+		if err := startGlobalTimeout(cmd); err != nil {
+			return exitcode.MarkUsage(err)
+		}
+		startTrace()
+		recordDeprecatedFlags(cmd)
+		logger.SetQuiet(quiet)
+		if err := configureColorOutput(); err != nil {
+			return exitcode.MarkUsage(err)
+		}
+		if exitCodeOnWarn < exitcode.Success || exitCodeOnWarn > exitcode.MaxCode {
+			return exitcode.MarkUsage(fmt.Errorf("invalid --exit-code-on-warn %d, must be between %d and %d", exitCodeOnWarn, exitcode.Success, exitcode.MaxCode))
+		}
+		if err := changeWorkDir(workDir); err != nil {
+			return err
+		}
+		if err := configureLogFile(cmd); err != nil {
+			return exitcode.MarkUsage(err)
+		}
+		if err := validateToolImage(); err != nil {
+			return exitcode.MarkUsage(err)
+		}
+		if err := configureRetries(cmd); err != nil {
+			return exitcode.MarkUsage(err)
+		}
+		if err := acquireLock(cmd); err != nil {
+			return err
+		}
 		// Ensures logs flush after each command run
 		logger.Infoln("Logger initialized (PersistentPreRun)", logger.VerbosityLevelDebug)
 
-		// Initialize runtime factory based on flag or environment
+		// Initialize runtime factory based on flag or environment, --runtime always wins over the detected one
+		if !cmd.Flags().Changed(runtimeFlag) {
+			runtimeType = string(runtime.DefaultRuntimeType())
+		}
 		rt := types.RuntimeType(runtimeType)
 		if !rt.Valid() {
-			return fmt.Errorf("invalid runtime type: %s (must be 'podman' or 'openshift')", runtimeType)
+			return exitcode.MarkUsage(fmt.Errorf("invalid runtime type: %s (must be 'podman' or 'openshift')", runtimeType))
 		}
 
 		vars.RuntimeFactory = runtime.NewRuntimeFactory(rt)
+		if insecureSkipTLSVerify {
+			logger.Warningln("TLS certificate verification is disabled (--insecure-skip-tls-verify). " +
+				"Connections to the API server and image registries are insecure, use this only for test clusters.")
+			runtime.SetInsecureSkipTLSVerify(true)
+			utils.SetInsecureSkipTLSVerify(true)
+		}
 		logger.Infof("Using runtime: %s\n", rt, logger.VerbosityLevelDebug)
 
 		return nil
 	},
 }
 
+// validateToolImage validates the tool image set by --tool-image or the environment, pinned by tag or by digest.
+func validateToolImage() error {
+	if err := image.ValidateReference(vars.ToolImage); err != nil {
+		return fmt.Errorf("invalid tool image, set by --tool-image or %s: %w", constants.ToolImageEnv, err)
+	}
+	if image.IsUnpinned(vars.ToolImage) {
+		logger.Warningf("Tool image %s is not pinned to a version, pin it by tag or by digest for reproducible deployments\n", vars.ToolImage)
+	}
+
+	return nil
+}
+
+// changeWorkDir switches the working directory for the rest of the run, so that relative paths resolve against it.
+func changeWorkDir(dir string) error {
+	if dir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return exitcode.MarkUsage(fmt.Errorf("invalid --chdir directory: %w", err))
+	}
+	if !info.IsDir() {
+		return exitcode.MarkUsage(fmt.Errorf("invalid --chdir directory: '%s' is not a directory", dir))
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		return fmt.Errorf("failed to change the working directory to '%s': %w", dir, err)
+	}
+	logger.Infof("Working directory: %s\n", dir, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	defer logger.Flush()
-	err := RootCmd.Execute()
+	cmd, err := RootCmd.ExecuteC()
+	err = finishRun(err)
+	releaseLock()
+	finishTrace()
+	printTimeout(err)
+	if auditErr := audit.Record(auditLogPath, cmd, os.Args[1:], err); auditErr != nil {
+		logger.Warningf("failed to record the audit log entry: %v\n", auditErr)
+	}
 	if err != nil {
-		os.Exit(1)
+		printErrorHint(err)
+		if !preRunStarted {
+			err = exitcode.MarkUsage(err)
+		}
+	}
+	closeLogFile()
+
+	if code := exitcode.Of(err, exitCodeOnWarn); code != exitcode.Success {
+		os.Exit(code)
+	}
+}
+
+// printErrorHint prints the actionable hint for the known kinds of errors, next to the error printed by cobra.
+// It is printed in quiet mode too, as it belongs to the failure output.
+func printErrorHint(err error) {
+	if hint := errhints.Hint(err); hint != "" {
+		fmt.Fprintf(RootCmd.ErrOrStderr(), "%s %s\n", style.Hint().Render("HINT:"), hint)
 	}
 }
 
@@ -62,13 +194,64 @@ func init() {
 	// Add runtime flag
 	RootCmd.PersistentFlags().StringVar(
 		&runtimeType,
-		"runtime",
-		string(types.RuntimeTypePodman),
-		fmt.Sprintf("Container runtime to use (options: %s, %s).", types.RuntimeTypePodman, types.RuntimeTypeOpenShift),
+		runtimeFlag,
+		// no static default, which would hide that the runtime is detected when the flag is not set
+		"",
+		fmt.Sprintf("Container runtime to use (options: %s, %s). Detected when not set: %s when running inside a pod, "+
+			"e.g. as a Job in the cluster, and %s on a bare host.",
+			types.RuntimeTypePodman, types.RuntimeTypeOpenShift, types.RuntimeTypeOpenShift, types.RuntimeTypePodman),
 	)
 
+	RootCmd.PersistentFlags().BoolVarP(
+		&quiet,
+		"quiet",
+		"q",
+		false,
+		"Suppress informational output, printing only errors and the final result.",
+	)
+
+	RootCmd.PersistentFlags().StringVar(
+		&auditLogPath,
+		"audit-log",
+		audit.DefaultLogPath,
+		"Path of the append-only audit log recording the mutating commands. When the default one is not writable, "+
+			"e.g. for a non-root user, the one of the user state directory is used, e.g. ~/.local/state/ai-services/audit.log.",
+	)
+
+	RootCmd.PersistentFlags().BoolVar(
+		&insecureSkipTLSVerify,
+		"insecure-skip-tls-verify",
+		false,
+		"Skip the TLS certificate verification of the OpenShift API server, the image registries and the other HTTPS endpoints called (insecure).",
+	)
+
+	RootCmd.PersistentFlags().StringVar(
+		&workDir,
+		"chdir",
+		"",
+		"Change to the given directory before running the command, relative paths such as values files and output files resolve against it.",
+	)
+
+	RootCmd.PersistentFlags().IntVar(
+		&exitCodeOnWarn,
+		"exit-code-on-warn",
+		exitcode.Success,
+		"Exit code when the validation passes with warnings, e.g. 4 to tell such runs apart in scripts.",
+	)
+	RootCmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return exitcode.MarkUsage(err)
+	})
+
+	initColorFlags()
+	initLogFileFlags()
+	initGlobalTimeoutFlag()
+	initRetryFlags()
+	initLockFlags()
+	initTraceFlags()
+
 	RootCmd.AddCommand(version.VersionCmd)
 	RootCmd.AddCommand(bootstrap.BootstrapCmd())
 	RootCmd.AddCommand(application.ApplicationCmd)
+	RootCmd.AddCommand(supportbundle.SupportBundleCmd)
 	// catalog.CatalogCmd() is registered in catalog_enabled.go when catalog_api build tag is set
 }
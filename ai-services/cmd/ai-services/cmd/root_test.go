@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/testutil"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TestRootCmdBootstrapValidate runs bootstrap validate through the real root command, whose pre-run recreates the
+// runtime factory, takes the lock of the mutating commands and tees the output to the log file.
+func TestRootCmdBootstrapValidate(t *testing.T) {
+	testutil.FakeHost(t, testutil.Power11Host)
+	fake := testutil.NewFakeRuntime(types.RuntimeTypePodman)
+	testutil.UseRuntime(t, fake)
+	testutil.TempLockDir(t)
+	dir := t.TempDir()
+	logPath, reportPath := filepath.Join(dir, "cli.log"), filepath.Join(dir, "report.json")
+	t.Cleanup(func() {
+		closeLogFile()
+		vars.LogFile = ""
+	})
+
+	// only the checks reading the fixture host are run, the others depend on the real one, Eg:- the user or podman
+	res := testutil.Run(t, RootCmd, "bootstrap", "validate", "--runtime", "podman", "--log-file", logPath, "--report-file", reportPath,
+		"--skip-validation", "root,rootless,power,rhn,spyre,vfio,vfio-bindings,registry,servicereport")
+	if res.Err != nil {
+		t.Fatalf("bootstrap validate error = %v, stderr:\n%s", res.Err, res.Stderr)
+	}
+	if !strings.Contains(res.Stdout, "All validations passed") {
+		t.Errorf("Stdout = %q, want the validation to pass", res.Stdout)
+	}
+
+	report, err := bootstrap.ReadReportFile(reportPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checks := map[string]bootstrap.CheckResult{}
+	for _, check := range report.Checks {
+		checks[check.Name] = check
+	}
+	if numa := checks["numa"]; numa.Status != bootstrap.CheckStatusPassed || numa.Measurements["lpar_affinity_percent"] != 100 {
+		t.Errorf("numa check = %+v, want it passed on the fixture host", numa)
+	}
+	if rhel := checks["rhel"]; rhel.Status != bootstrap.CheckStatusPassed || rhel.Versions["rhel"] != "9.6" {
+		t.Errorf("rhel check = %+v, want it passed on the fixture host", rhel)
+	}
+
+	data, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("failed to read the log file: %v", err)
+	}
+	if !strings.Contains(string(data), "Running bootstrap validation...") {
+		t.Errorf("log file = %q, want the output teed to it", data)
+	}
+
+	// the factory recreated by the root command still creates the fake runtime
+	if rt, err := vars.RuntimeFactory.Create(""); err != nil || rt != fake {
+		t.Errorf("RuntimeFactory.Create() = %v, %v, want the fake runtime", rt, err)
+	}
+}
+
+func TestChangeWorkDir(t *testing.T) {
+	orig, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = os.Chdir(orig) })
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "values.yaml")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		dir     string
+		wantErr string
+	}{
+		{name: "not set", dir: ""},
+		{name: "directory", dir: dir},
+		{name: "missing directory", dir: filepath.Join(dir, "missing"), wantErr: "invalid --chdir directory: stat "},
+		{name: "file", dir: file, wantErr: "invalid --chdir directory: '" + file + "' is not a directory"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := changeWorkDir(tt.dir)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("changeWorkDir() unexpected error = %v", err)
+				}
+				if wd, _ := os.Getwd(); tt.dir != "" && wd != tt.dir {
+					t.Errorf("working directory = %s, want %s", wd, tt.dir)
+				}
+
+				return
+			}
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Fatalf("changeWorkDir() error = %v, want %q", err, tt.wantErr)
+			}
+			if !errors.Is(err, exitcode.ErrUsage) || exitcode.Of(err, exitcode.Success) != exitcode.Usage {
+				t.Errorf("changeWorkDir() error = %v, want a usage error", err)
+			}
+		})
+	}
+}
+
+func TestRuntimeFlagUsage(t *testing.T) {
+	flag := RootCmd.PersistentFlags().Lookup(runtimeFlag)
+	if flag == nil {
+		t.Fatalf("--%s flag is not registered", runtimeFlag)
+	}
+
+	// the runtime is detected when the flag is not set, so the help must not claim a static default
+	usage := RootCmd.PersistentFlags().FlagUsages()
+	for _, line := range strings.Split(usage, "\n") {
+		if strings.Contains(line, "--"+runtimeFlag+" ") && strings.Contains(line, "(default") {
+			t.Errorf("--%s usage = %q, want no static default", runtimeFlag, line)
+		}
+	}
+	if !strings.Contains(flag.Usage, "Detected when not set") {
+		t.Errorf("--%s usage = %q, want the detection described", runtimeFlag, flag.Usage)
+	}
+}
@@ -0,0 +1,68 @@
+package supportbundle
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/cmd/ai-services/cmd/version"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/support"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+var SupportBundleCmd = &cobra.Command{
+	Use:   "support-bundle [path]",
+	Short: "Collects environment diagnostics into a tarball for support cases",
+	Long: `Collects non-secret diagnostics of the environment into a single gzip compressed tarball,
+to be attached to a support case. Sensitive values found in the collected logs are redacted.
+
+Collected diagnostics:
+  - CLI version and the platform of the host
+  - podman version, Spyre card count and LPAR affinity (podman runtime)
+  - Phases of the required operators (OpenShift runtime)
+  - Recent audit log entries, and CLI log entries when logging to a file with --log-file
+
+Arguments
+  - [path]: Path of the tarball to write (Required)`,
+	Example: `  # Collect the diagnostics of the podman environment
+  ai-services support-bundle /tmp/ai-services-support.tar.gz
+
+  # Collect the diagnostics of the OpenShift environment
+  ai-services support-bundle /tmp/ai-services-support.tar.gz --runtime openshift`,
+	Args: cobra.ExactArgs(1),
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		dir := filepath.Dir(args[0])
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return fmt.Errorf("directory '%s' does not exist", dir)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path := args[0]
+
+		opts := support.Options{
+			Runtime: vars.RuntimeFactory.GetRuntimeType(),
+			Version: fmt.Sprintf("Version: %s\nGitCommit: %s\nBuildDate: %s\n", version.Version, version.GitCommit, version.BuildDate),
+		}
+		if f := cmd.Flag("audit-log"); f != nil {
+			opts.AuditLogPath = f.Value.String()
+		}
+
+		logger.Infoln("Collecting diagnostics...")
+		items, errs := support.Collect(support.Collectors(opts))
+		for _, err := range errs {
+			logger.Warningf("%v\n", err)
+		}
+
+		if err := support.WriteBundle(path, items); err != nil {
+			return err
+		}
+		logger.Infof("Support bundle written to %s\n", path)
+
+		return nil
+	},
+}
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	globalTimeoutFlag = "global-timeout"
+
+	// timeoutGracePeriod is the time left to the command to return once cancelled, before the CLI exits anyway.
+	// Not every operation watches the context, Eg:- the podman bindings calls.
+	timeoutGracePeriod = 5 * time.Second
+)
+
+var (
+	// Global overall deadline flag.
+	globalTimeout time.Duration
+
+	// deadline is the context cancelled once --global-timeout is exceeded, nil when not set.
+	deadline       context.Context
+	cancelDeadline context.CancelFunc
+
+	// finishMu is held by whichever of the command and the timeout finishes the run first.
+	finishMu sync.Mutex
+	finished bool
+)
+
+func initGlobalTimeoutFlag() {
+	RootCmd.PersistentFlags().DurationVar(&globalTimeout, globalTimeoutFlag, 0,
+		fmt.Sprintf("Overall deadline of the command (e.g. 30m), which is cancelled and exits with code %d once exceeded. "+
+			"Disabled by default.", exitcode.Timeout))
+}
+
+// startGlobalTimeout sets the deadline given by --global-timeout on the context of cmd.
+// The per-operation timeouts and the signal handling derive from it, so that the first one reached wins.
+func startGlobalTimeout(cmd *cobra.Command) error {
+	if globalTimeout < 0 {
+		return fmt.Errorf("invalid --%s %s, must not be negative", globalTimeoutFlag, globalTimeout)
+	}
+	if globalTimeout == 0 {
+		return nil
+	}
+
+	deadline, cancelDeadline = context.WithTimeout(cmd.Context(), globalTimeout)
+	cmd.SetContext(deadline)
+
+	go func() {
+		<-deadline.Done()
+		if !errors.Is(deadline.Err(), context.DeadlineExceeded) {
+			return
+		}
+		time.Sleep(timeoutGracePeriod)
+		exitOnTimeout(cmd)
+	}()
+
+	return nil
+}
+
+// exitOnTimeout exits the CLI when the command did not return within the grace period after the deadline.
+func exitOnTimeout(cmd *cobra.Command) {
+	finishMu.Lock()
+	if finished {
+		finishMu.Unlock()
+
+		return
+	}
+
+	releaseLock()
+	err := timeoutError(context.DeadlineExceeded)
+	fmt.Fprintf(cmd.Root().ErrOrStderr(), "Error: %v\n", err)
+	if auditErr := audit.Record(auditLogPath, cmd, os.Args[1:], err); auditErr != nil {
+		logger.Warningf("failed to record the audit log entry: %v\n", auditErr)
+	}
+	logger.Flush()
+	if logFile != nil {
+		_ = logFile.Close()
+	}
+
+	os.Exit(exitcode.Timeout)
+}
+
+// finishRun stops the timeout once the command returned, marking its error as a timeout if the deadline was exceeded.
+func finishRun(err error) error {
+	finishMu.Lock()
+	finished = true
+	finishMu.Unlock()
+
+	if deadline == nil {
+		return err
+	}
+	defer cancelDeadline()
+
+	if err != nil && errors.Is(deadline.Err(), context.DeadlineExceeded) {
+		return timeoutError(err)
+	}
+
+	return err
+}
+
+// printTimeout tells that the command failed on --global-timeout, the error itself being already printed by cobra.
+func printTimeout(err error) {
+	if errors.Is(err, exitcode.ErrTimeout) {
+		fmt.Fprintf(RootCmd.ErrOrStderr(), "The command timed out after %s (--%s)\n", globalTimeout, globalTimeoutFlag)
+	}
+}
+
+func timeoutError(err error) error {
+	return exitcode.MarkTimeout(fmt.Errorf("command timed out after %s (--%s): %w", globalTimeout, globalTimeoutFlag, err))
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+)
+
+const (
+	traceFlag     = "trace"
+	traceFileFlag = "trace-file"
+
+	traceFilePerm = 0o644
+)
+
+var (
+	// Global execution trace flags.
+	traceEnabled  bool
+	traceFilePath string
+)
+
+func initTraceFlags() {
+	RootCmd.PersistentFlags().BoolVar(&traceEnabled, traceFlag, false,
+		"Record the major operations of the run, e.g. the client construction, the checks, the image pulls, the applies and the waits, "+
+			"and print their timeline with durations at the end, to find where the time goes in a slow run.")
+	RootCmd.PersistentFlags().StringVar(&traceFilePath, traceFileFlag, "",
+		"Path of a file the execution timeline is written to, implies recording it as with --trace.")
+}
+
+// startTrace starts recording the execution timeline when requested by --trace or --trace-file.
+func startTrace() {
+	if traceEnabled || traceFilePath != "" {
+		trace.Enable()
+	}
+}
+
+// finishTrace prints the execution timeline recorded for --trace, and writes it to the file given by --trace-file.
+// It is printed in quiet mode too, as it was explicitly requested.
+func finishTrace() {
+	r := trace.Default()
+	if r == nil {
+		return
+	}
+
+	var timeline strings.Builder
+	if err := r.Render(&timeline); err != nil {
+		logger.Warningf("failed to render the execution timeline: %v\n", err)
+
+		return
+	}
+	if traceEnabled {
+		fmt.Fprint(RootCmd.ErrOrStderr(), timeline.String())
+	}
+	if traceFilePath != "" {
+		if err := os.WriteFile(traceFilePath, []byte(timeline.String()), traceFilePerm); err != nil {
+			logger.Warningf("failed to write the execution timeline to %s: %v\n", traceFilePath, err)
+		}
+	}
+}
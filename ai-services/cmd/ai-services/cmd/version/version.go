@@ -1,6 +1,7 @@
 package version
 
 import (
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/spf13/cobra"
 )
@@ -11,6 +12,15 @@ var (
 	BuildDate string = ""
 )
 
+var outputFormat string
+
+// versionInfo is the version information, emitted with --output json or yaml.
+type versionInfo struct {
+	Version   string `json:"version"`
+	GitCommit string `json:"gitCommit"`
+	BuildDate string `json:"buildDate"`
+}
+
 func GetVersion() string {
 	return Version
 }
@@ -18,7 +28,22 @@ func GetVersion() string {
 var VersionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Prints CLI version with more info",
-	Run: func(cmd *cobra.Command, args []string) {
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		_, err := output.Parse(outputFormat)
+
+		return err
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if format, _ := output.Parse(outputFormat); format.Structured() {
+			return output.Print(versionInfo{Version: Version, GitCommit: GitCommit, BuildDate: BuildDate}, format)
+		}
+
 		logger.Infof("Version: %s\nGitCommit: %s\nBuildDate: %s\n", Version, GitCommit, BuildDate)
+
+		return nil
 	},
 }
+
+func init() {
+	VersionCmd.Flags().StringVarP(&outputFormat, "output", "o", "", "Output format (json or yaml)")
+}
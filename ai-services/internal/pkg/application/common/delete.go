@@ -0,0 +1,95 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// DeleteOutcome is the outcome of the deletion of one resource of an application.
+type DeleteOutcome struct {
+	// Resource names the deleted resource, Eg:- pod rag-vllm.
+	Resource string
+	// Err is the error the deletion failed with, nil when the resource was deleted.
+	Err error
+	// NotFound is set when the resource was already gone, a success with --ignore-not-found.
+	NotFound bool
+}
+
+// Failed tells whether the resource is left behind.
+func (o DeleteOutcome) Failed(ignoreNotFound bool) bool {
+	return o.Err != nil && !(o.NotFound && ignoreNotFound)
+}
+
+// DeleteReport collects the outcome of the deletion of each resource of an application,
+// so that a failed deletion does not stop the others and the user is told which resources are left behind.
+type DeleteReport struct {
+	app            string
+	ignoreNotFound bool
+	outcomes       []DeleteOutcome
+}
+
+// NewDeleteReport returns an empty report of the deletion of the application.
+func NewDeleteReport(app string, ignoreNotFound bool) *DeleteReport {
+	return &DeleteReport{app: app, ignoreNotFound: ignoreNotFound}
+}
+
+// Record records the outcome of the deletion of the resource, err is nil when it was deleted.
+func (r *DeleteReport) Record(resource string, err error) {
+	outcome := DeleteOutcome{Resource: resource, Err: err, NotFound: errors.Is(err, types.ErrNotFound)}
+	r.outcomes = append(r.outcomes, outcome)
+
+	switch {
+	case err == nil:
+		logger.Infof("Deleted %s\n", resource, logger.VerbosityLevelDebug)
+	case !outcome.Failed(r.ignoreNotFound):
+		logger.Infof("%s already deleted\n", resource, logger.VerbosityLevelDebug)
+	default:
+		logger.Infof("Failed to delete %s: %v\n", resource, err, logger.VerbosityLevelDebug)
+	}
+}
+
+// Outcomes returns the recorded outcomes, in the order of the deletions.
+func (r *DeleteReport) Outcomes() []DeleteOutcome {
+	return r.outcomes
+}
+
+// Print reports the deleted resources, the ones which failed to be deleted are reported by Err along with why.
+func (r *DeleteReport) Print() {
+	var deleted []string
+	for _, o := range r.outcomes {
+		switch {
+		case o.Err == nil:
+			deleted = append(deleted, o.Resource)
+		case !o.Failed(r.ignoreNotFound):
+			deleted = append(deleted, o.Resource+" (already deleted)")
+		}
+	}
+	if len(deleted) == 0 {
+		return
+	}
+
+	logger.Infoln("Deleted:")
+	for _, d := range deleted {
+		logger.Infof("\t-> %s\n", d)
+	}
+}
+
+// Err returns the error listing the resources which failed to be deleted, nil when every deletion succeeded.
+func (r *DeleteReport) Err() error {
+	var failed []string
+	for _, o := range r.outcomes {
+		if o.Failed(r.ignoreNotFound) {
+			failed = append(failed, fmt.Sprintf("%s: %v", o.Resource, o.Err))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("failed to delete %d of the %d resources of the application %s:\n%s",
+		len(failed), len(r.outcomes), r.app, strings.Join(failed, "\n"))
+}
@@ -0,0 +1,85 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestDeleteReportErr(t *testing.T) {
+	goneErr := fmt.Errorf("failed to delete the pod: %w: no such pod", types.ErrNotFound)
+	stuckErr := errors.New("finalizer kubernetes.io/pvc-protection pending")
+
+	tests := []struct {
+		name           string
+		ignoreNotFound bool
+		outcomes       map[string]error
+		wantErr        string
+	}{
+		{
+			name:     "all deleted",
+			outcomes: map[string]error{"pod rag-vllm": nil, "pod rag-backend": nil},
+		},
+		{
+			name:     "one failed",
+			outcomes: map[string]error{"pod rag-vllm": nil, "persistent volume claims": stuckErr},
+			wantErr: "failed to delete 1 of the 2 resources of the application rag:\n" +
+				"persistent volume claims: finalizer kubernetes.io/pvc-protection pending",
+		},
+		{
+			name:     "already gone",
+			outcomes: map[string]error{"pod rag-vllm": goneErr},
+			wantErr: "failed to delete 1 of the 1 resources of the application rag:\n" +
+				"pod rag-vllm: failed to delete the pod: not found: no such pod",
+		},
+		{
+			name:           "already gone ignored",
+			ignoreNotFound: true,
+			outcomes:       map[string]error{"pod rag-vllm": goneErr, "pod rag-backend": nil},
+		},
+		{
+			name:           "failure not ignored",
+			ignoreNotFound: true,
+			outcomes:       map[string]error{"persistent volume claims": stuckErr},
+			wantErr: "failed to delete 1 of the 1 resources of the application rag:\n" +
+				"persistent volume claims: finalizer kubernetes.io/pvc-protection pending",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := NewDeleteReport("rag", tt.ignoreNotFound)
+			for resource, err := range tt.outcomes {
+				report.Record(resource, err)
+			}
+
+			err := report.Err()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Err() = %v, want nil", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Err() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestDeleteReportOutcomes(t *testing.T) {
+	report := NewDeleteReport("rag", false)
+	report.Record("pod rag-vllm", nil)
+	report.Record("pod rag-backend", fmt.Errorf("failed to delete the pod: %w", types.ErrNotFound))
+
+	outcomes := report.Outcomes()
+	if len(outcomes) != 2 || outcomes[0].Resource != "pod rag-vllm" || outcomes[1].Resource != "pod rag-backend" {
+		t.Fatalf("Outcomes() = %+v, want the two pods in the order of the deletions", outcomes)
+	}
+	if outcomes[0].NotFound || !outcomes[1].NotFound {
+		t.Errorf("NotFound = %v, %v, want false, true", outcomes[0].NotFound, outcomes[1].NotFound)
+	}
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	dumpDirPerm = 0o755
+	// dumpFilePerm keeps the dumped manifests private, as they may hold secrets.
+	dumpFilePerm = 0o600
+)
+
+// DumpManifest writes the final manifest of a resource, as applied to the runtime, to <dir>/<name>.yaml for --dump-manifests.
+// It is a no-op when dir is empty.
+func DumpManifest(dir, name string, manifest []byte) error {
+	if dir == "" {
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, dumpDirPerm); err != nil {
+		return fmt.Errorf("failed to create the manifests directory: %w", err)
+	}
+
+	path := filepath.Join(dir, name+".yaml")
+	if err := os.WriteFile(path, manifest, dumpFilePerm); err != nil {
+		return fmt.Errorf("failed to dump the manifest of '%s': %w", name, err)
+	}
+	logger.Infof("Dumped the manifest of '%s' to %s\n", name, path, logger.VerbosityLevelDebug)
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDumpManifest(t *testing.T) {
+	if err := DumpManifest("", "rag-vllm", []byte("kind: Pod\n")); err != nil {
+		t.Fatalf("DumpManifest() without a directory error = %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "manifests")
+	if err := DumpManifest(dir, "rag-vllm", []byte("kind: Pod\n")); err != nil {
+		t.Fatalf("DumpManifest() error = %v", err)
+	}
+
+	path := filepath.Join(dir, "rag-vllm.yaml")
+	data, err := os.ReadFile(path)
+	if err != nil || string(data) != "kind: Pod\n" {
+		t.Fatalf("dumped manifest = %q, %v, want the manifest", data, err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("dumped manifest mode = %v, want 0600", info.Mode().Perm())
+	}
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// execTarget is a container of an application, the command of application exec can be run in.
+type execTarget struct {
+	pod string
+	// container is the name of the container in the runtime, Eg:- prefixed by the pod name in podman.
+	container string
+	// name is the name of the container in the template.
+	name string
+}
+
+// Exec runs the command in the container of the application selected by the options.
+func Exec(r runtime.Runtime, opts appTypes.ExecOptions) error {
+	pods, err := FetchFilteredPods(r, opts.Name)
+	if err != nil {
+		return err
+	}
+	if len(pods) == 0 {
+		return fmt.Errorf("application %s does not exist", opts.Name)
+	}
+
+	target, err := selectContainer(pods, opts.PodName, opts.ContainerName)
+	if err != nil {
+		return err
+	}
+
+	execOpts := types.ExecOptions{
+		Command: opts.Command,
+		Stdin:   opts.Stdin,
+		Stdout:  opts.Stdout,
+		Stderr:  opts.Stderr,
+		TTY:     opts.TTY,
+	}
+	if err := r.ExecContainer(target.pod, target.container, execOpts); err != nil {
+		return fmt.Errorf("failed to exec into the container %s of pod %s: %w", target.name, target.pod, err)
+	}
+
+	return nil
+}
+
+// selectContainer returns the container of the pods matching the given pod and container names,
+// or the only container of the pods when no container name is given.
+func selectContainer(pods []types.Pod, podName, containerName string) (execTarget, error) {
+	candidates := listTargets(pods, podName)
+	if len(candidates) == 0 {
+		if podName != "" {
+			return execTarget{}, fmt.Errorf("pod %s has no containers in the application", podName)
+		}
+
+		return execTarget{}, fmt.Errorf("application has no containers")
+	}
+
+	if containerName == "" {
+		if len(candidates) > 1 {
+			return execTarget{}, fmt.Errorf("application has several containers, select one with --container: %s", formatTargets(candidates))
+		}
+
+		return candidates[0], nil
+	}
+
+	var matches []execTarget
+	for _, target := range candidates {
+		if target.name == containerName || target.container == containerName {
+			matches = append(matches, target)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return execTarget{}, fmt.Errorf("container %s not found, the containers are: %s", containerName, formatTargets(candidates))
+	case 1:
+		return matches[0], nil
+	default:
+		return execTarget{}, fmt.Errorf("container %s is run by several pods, select one with --pod: %s", containerName, formatTargets(matches))
+	}
+}
+
+// listTargets returns the containers of the pods, or of the given pod only, leaving out the infra containers.
+func listTargets(pods []types.Pod, podName string) []execTarget {
+	var targets []execTarget
+	for _, pod := range pods {
+		if podName != "" && pod.Name != podName {
+			continue
+		}
+		for _, c := range pod.Containers {
+			if pod.InfraContainerID != "" && c.ID == pod.InfraContainerID {
+				continue
+			}
+			targets = append(targets, execTarget{pod: pod.Name, container: c.Name, name: strings.TrimPrefix(c.Name, pod.Name+"-")})
+		}
+	}
+
+	return targets
+}
+
+// formatTargets lists the containers along with their pod, Eg:- "vllm (pod rag-vllm)".
+func formatTargets(targets []execTarget) string {
+	parts := make([]string, 0, len(targets))
+	for _, t := range targets {
+		parts = append(parts, fmt.Sprintf("%s (pod %s)", t.name, t.pod))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestSelectContainer(t *testing.T) {
+	pods := []types.Pod{
+		{
+			Name:             "rag-vllm",
+			InfraContainerID: "infra1",
+			Containers: []types.Container{
+				{ID: "infra1", Name: "a1b2c3d4e5f6-infra"},
+				{ID: "c1", Name: "rag-vllm-vllm"},
+			},
+		},
+		{
+			Name:             "rag-backend",
+			InfraContainerID: "infra2",
+			Containers: []types.Container{
+				{ID: "infra2", Name: "f6e5d4c3b2a1-infra"},
+				{ID: "c2", Name: "rag-backend-backend"},
+				{ID: "c3", Name: "rag-backend-vllm"},
+			},
+		},
+	}
+	single := []types.Pod{{Name: "rag-ui", Containers: []types.Container{{ID: "c4", Name: "ui"}}}}
+
+	tests := []struct {
+		name          string
+		pods          []types.Pod
+		podName       string
+		containerName string
+		want          execTarget
+		wantErr       bool
+	}{
+		{
+			name:          "by its name in the template",
+			pods:          pods,
+			containerName: "backend",
+			want:          execTarget{pod: "rag-backend", container: "rag-backend-backend", name: "backend"},
+		},
+		{
+			name:          "by its name in the runtime",
+			pods:          pods,
+			containerName: "rag-backend-backend",
+			want:          execTarget{pod: "rag-backend", container: "rag-backend-backend", name: "backend"},
+		},
+		{
+			name:          "name shared by several pods",
+			pods:          pods,
+			containerName: "vllm",
+			wantErr:       true,
+		},
+		{
+			name:          "name shared by several pods narrowed down by pod",
+			pods:          pods,
+			podName:       "rag-vllm",
+			containerName: "vllm",
+			want:          execTarget{pod: "rag-vllm", container: "rag-vllm-vllm", name: "vllm"},
+		},
+		{
+			name:          "unknown container",
+			pods:          pods,
+			containerName: "opensearch",
+			wantErr:       true,
+		},
+		{
+			name:          "infra container is left out",
+			pods:          pods,
+			containerName: "a1b2c3d4e5f6-infra",
+			wantErr:       true,
+		},
+		{
+			name:    "several containers without a name",
+			pods:    pods,
+			wantErr: true,
+		},
+		{
+			name: "only container without a name",
+			pods: single,
+			want: execTarget{pod: "rag-ui", container: "ui", name: "ui"},
+		},
+		{
+			name:    "unknown pod",
+			pods:    pods,
+			podName: "rag-ui",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectContainer(tt.pods, tt.podName, tt.containerName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectContainer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("selectContainer() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+const (
+	// podRunning is the status of a running pod, in both the podman and openshift runtimes.
+	podRunning = "Running"
+	// podSucceeded is the status of an openshift pod which ran to completion, Eg:- the one of a job.
+	podSucceeded = "Succeeded"
+)
+
+// ContainerStatusFunc returns the status of a container of the pod, Eg:- "healthy" for a container which is ready.
+type ContainerStatusFunc func(pod types.Pod, container types.Container) string
+
+// Health rolls up the health of the pods of every application deployed from a template, sorted by application name.
+func Health(pods []types.Pod, containerStatus ContainerStatusFunc) []appTypes.ApplicationHealth {
+	byApp := map[string]*appTypes.ApplicationHealth{}
+	for _, pod := range pods {
+		appName := fetchPodNameFromLabels(pod.Labels)
+		template := pod.Labels[string(vars.TemplateLabel)]
+		// only the resources deployed from a template are reported
+		if appName == "" || template == "" {
+			continue
+		}
+		// the pods which ran to completion are not replicas of the application
+		if pod.Status == podSucceeded {
+			continue
+		}
+
+		health, ok := byApp[appName]
+		if !ok {
+			health = &appTypes.ApplicationHealth{Name: appName, Template: template}
+			byApp[appName] = health
+		}
+
+		health.Replicas++
+		if issue := podIssue(pod, containerStatus); issue != "" {
+			health.Issues = append(health.Issues, issue)
+		} else {
+			health.ReadyReplicas++
+		}
+	}
+
+	healths := make([]appTypes.ApplicationHealth, 0, len(byApp))
+	for _, health := range byApp {
+		sort.Strings(health.Issues)
+		health.State = HealthState(*health)
+		healths = append(healths, *health)
+	}
+	sort.Slice(healths, func(i, j int) bool { return healths[i].Name < healths[j].Name })
+
+	return healths
+}
+
+// HealthState rolls up the state of the application from its ready pods and issues.
+func HealthState(health appTypes.ApplicationHealth) appTypes.HealthState {
+	switch {
+	case health.ReadyReplicas == 0:
+		return appTypes.HealthFailed
+	case health.ReadyReplicas < health.Replicas || len(health.Issues) > 0:
+		return appTypes.HealthDegraded
+	default:
+		return appTypes.HealthReady
+	}
+}
+
+// podIssue explains why the pod is not ready, empty for a running pod with all its containers ready.
+func podIssue(pod types.Pod, containerStatus ContainerStatusFunc) string {
+	if pod.Status != podRunning {
+		return fmt.Sprintf("pod %s is %s", pod.Name, strings.ToLower(pod.Status))
+	}
+
+	var notReady []string
+	for _, c := range pod.Containers {
+		if pod.InfraContainerID != "" && c.ID == pod.InfraContainerID {
+			continue
+		}
+		if status := containerStatus(pod, c); status != string(constants.Ready) {
+			notReady = append(notReady, fmt.Sprintf("%s (%s)", strings.TrimPrefix(c.Name, pod.Name+"-"), status))
+		}
+	}
+	if len(notReady) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("pod %s has containers not ready: %s", pod.Name, strings.Join(notReady, ", "))
+}
+
+// ContainerStatus returns the status of the inspected container, its health when it is running.
+func ContainerStatus(cInfo *types.Container) string {
+	return fetchContainerStatus(cInfo)
+}
@@ -0,0 +1,81 @@
+package common
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+func TestHealth(t *testing.T) {
+	labels := func(app string) map[string]string {
+		return map[string]string{"ai-services.io/application": app, "ai-services.io/template": "rag"}
+	}
+	pods := []types.Pod{
+		{
+			Name: "rag-vllm", Status: "Running", Labels: labels("rag"), InfraContainerID: "infra1",
+			Containers: []types.Container{{ID: "infra1", Name: "a1b2c3d4e5f6-infra"}, {ID: "c1", Name: "rag-vllm-vllm"}},
+		},
+		{
+			Name: "rag-backend", Status: "Running", Labels: labels("rag"),
+			Containers: []types.Container{{ID: "c2", Name: "rag-backend-backend"}},
+		},
+		{Name: "chat-vllm", Status: "Running", Labels: labels("chat"), Containers: []types.Container{{ID: "c3", Name: "chat-vllm-vllm"}}},
+		{Name: "old-vllm", Status: "Exited", Labels: labels("old"), Containers: []types.Container{{ID: "c4", Name: "old-vllm-vllm"}}},
+		{Name: "old-ingest", Status: "Succeeded", Labels: labels("old")},
+		// pods not deployed from a template are left out
+		{Name: "adhoc", Status: "Running", Labels: map[string]string{"ai-services.io/application": "adhoc"}},
+	}
+	statuses := map[string]string{"c1": "healthy", "c2": "unhealthy", "c3": "healthy", "c4": "exited"}
+	containerStatus := func(_ types.Pod, c types.Container) string {
+		if c.ID == "infra1" {
+			t.Error("the status of the infra container was checked")
+		}
+
+		return statuses[c.ID]
+	}
+
+	want := []appTypes.ApplicationHealth{
+		{Name: "chat", Template: "rag", State: appTypes.HealthReady, ReadyReplicas: 1, Replicas: 1},
+		{Name: "old", Template: "rag", State: appTypes.HealthFailed, Replicas: 1, Issues: []string{"pod old-vllm is exited"}},
+		{
+			Name: "rag", Template: "rag", State: appTypes.HealthDegraded, ReadyReplicas: 1, Replicas: 2,
+			Issues: []string{"pod rag-backend has containers not ready: backend (unhealthy)"},
+		},
+	}
+
+	got := Health(pods, containerStatus)
+	if !slices.EqualFunc(got, want, func(a, b appTypes.ApplicationHealth) bool {
+		return a.Name == b.Name && a.Template == b.Template && a.State == b.State &&
+			a.ReadyReplicas == b.ReadyReplicas && a.Replicas == b.Replicas && slices.Equal(a.Issues, b.Issues)
+	}) {
+		t.Errorf("Health() = %+v, want %+v", got, want)
+	}
+}
+
+func TestHealthState(t *testing.T) {
+	tests := []struct {
+		name   string
+		health appTypes.ApplicationHealth
+		want   appTypes.HealthState
+	}{
+		{name: "all ready", health: appTypes.ApplicationHealth{ReadyReplicas: 2, Replicas: 2}, want: appTypes.HealthReady},
+		{name: "some ready", health: appTypes.ApplicationHealth{ReadyReplicas: 1, Replicas: 2}, want: appTypes.HealthDegraded},
+		{
+			name:   "all ready with spyre cards issues",
+			health: appTypes.ApplicationHealth{ReadyReplicas: 2, Replicas: 2, Issues: []string{"spyre cards not bound to vfio-pci: 0000:01:00.0"}},
+			want:   appTypes.HealthDegraded,
+		},
+		{name: "none ready", health: appTypes.ApplicationHealth{Replicas: 2}, want: appTypes.HealthFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HealthState(tt.health); got != tt.want {
+				t.Errorf("HealthState() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
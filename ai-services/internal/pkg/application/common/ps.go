@@ -7,20 +7,16 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 )
 
-// FetchFilteredPods Fetch all pods for a given app based on label.
+// FetchFilteredPods Fetch all pods for a given app based on label, or the pods of all the apps when appName is empty.
 func FetchFilteredPods(r runtime.Runtime, appName string) ([]types.Pod, error) {
-	listFilters := map[string][]string{}
-	if appName != "" {
-		listFilters["label"] = []string{fmt.Sprintf("ai-services.io/application=%s", appName)}
-	}
-
-	pods, err := r.ListPods(listFilters)
+	pods, err := r.ListPods(selector.ForApplication(appName).Filters())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
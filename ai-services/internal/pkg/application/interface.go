@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
@@ -24,12 +25,24 @@ type Application interface {
 	// List returns information about running applications.
 	List(opts types.ListOptions) ([]types.ApplicationInfo, error)
 
+	// Health returns the rolled-up health of every application deployed from a template.
+	Health() ([]types.ApplicationHealth, error)
+
 	// Info displays detailed information about an application.
 	Info(opts types.InfoOptions) error
 
 	// Logs displays logs from an application pod.
 	Logs(opts types.LogsOptions) error
 
+	// Exec runs a command inside a container of the application.
+	Exec(opts types.ExecOptions) error
+
+	// Diff compares the deployed resources of an application with the ones rendered from its template.
+	Diff(ctx context.Context, opts types.DiffOptions) ([]diff.Change, error)
+
+	// Rollback redeploys the version of an application deployed before the current one, along with its parameters.
+	Rollback(ctx context.Context, opts types.RollbackOptions) error
+
 	// Type returns the runtime type.
 	Type() runtimeTypes.RuntimeType
 }
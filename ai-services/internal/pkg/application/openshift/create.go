@@ -1,17 +1,28 @@
 package openshift
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"helm.sh/helm/v4/pkg/chart"
+	"helm.sh/helm/v4/pkg/postrenderer"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/helm"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
@@ -19,7 +30,18 @@ import (
 func (o *OpenshiftApplication) Create(ctx context.Context, opts types.CreateOptions) error {
 	logger.Infof("Creating application '%s' using template '%s'\n", opts.Name, opts.TemplateName)
 
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+	if err != nil {
+		return fmt.Errorf("failed to load application templates: %w", err)
+	}
+
+	if err := validateMinSpyreCards(ctx, tp, opts.TemplateName); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		return dryRun(ctx, tp, opts)
+	}
 
 	// Step1: Fetch the operation timeout
 	timeout, err := getOperationTimeout(ctx, tp, opts)
@@ -57,6 +79,53 @@ func (o *OpenshiftApplication) Create(ctx context.Context, opts types.CreateOpti
 	return nil
 }
 
+// spyreResource is the extended resource of the Spyre cards, advertised by the nodes and requested by the pods.
+const spyreResource = corev1.ResourceName("ibm.com/spyre_pf")
+
+// validateMinSpyreCards fails early when the Spyre cards allocatable in the cluster are below the minimum declared
+// by the application template.
+func validateMinSpyreCards(ctx context.Context, tp templates.Template, templateName string) error {
+	appMetadata, err := tp.LoadMetadata(templateName, false)
+	if err != nil {
+		return fmt.Errorf("failed to read the app metadata: %w", err)
+	}
+	if appMetadata.MinSpyreCards == 0 {
+		return nil
+	}
+
+	kc, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return fmt.Errorf("failed to create openshift client: %w", err)
+	}
+	cards, err := allocatableSpyreCards(ctx, kc.Client)
+	if err != nil {
+		return err
+	}
+	if cards < appMetadata.MinSpyreCards {
+		return fmt.Errorf("application template '%s' requires at least %d spyre cards, only %d allocatable in the cluster",
+			templateName, appMetadata.MinSpyreCards, cards)
+	}
+
+	return nil
+}
+
+// allocatableSpyreCards returns the total of the Spyre cards allocatable on the nodes of the cluster.
+func allocatableSpyreCards(ctx context.Context, c client.Reader) (int, error) {
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return 0, fmt.Errorf("failed to list the cluster nodes: %w", err)
+	}
+
+	total := 0
+	for _, node := range nodes.Items {
+		if cards, ok := node.Status.Allocatable[spyreResource]; ok {
+			total += int(cards.Value())
+		}
+	}
+
+	return total, nil
+}
+
 func getOperationTimeout(ctx context.Context, tp templates.Template, opts types.CreateOptions) (time.Duration, error) {
 	s := spinner.New("Setting the operation timeout...")
 
@@ -94,6 +163,40 @@ func loadCharts(ctx context.Context, tp templates.Template, opts types.CreateOpt
 	return chart, nil
 }
 
+// errStoppedWaiting is returned when the user interrupted the wait for a deployment.
+var errStoppedWaiting = errors.New("stopped waiting for the deployment")
+
+// waitWithProgress runs deploy, streaming the progress of the pods in the namespace while it waits for them.
+// Ctrl-C only stops waiting: deploy is not cancelled, so that the helm release is neither rolled back nor deleted.
+func waitWithProgress(ctx context.Context, namespace string, deploy func() error) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		watchProgress(watchCtx, namespace)
+	}()
+
+	result := make(chan error, 1)
+	go func() {
+		result <- deploy()
+	}()
+
+	var err error
+	select {
+	case err = <-result:
+	case <-ctx.Done():
+		err = errStoppedWaiting
+	}
+	cancelWatch()
+	<-watchDone
+
+	return err
+}
+
 func deployApp(ctx context.Context, chart chart.Charter, timeout time.Duration, values map[string]any, opts types.CreateOptions) error {
 	// Fetch app name and derive namespace
 	app := opts.Name
@@ -118,22 +221,68 @@ func deployApp(ctx context.Context, chart chart.Charter, timeout time.Duration,
 		return err
 	}
 
-	if !isAppExist {
-		// if App does not exist then perform install
-		logger.Infof("App: %s does not exist, proceeding with install...", app)
-		err = helmClient.Install(app, chart, &helm.InstallOpts{Values: values, Timeout: timeout})
-	} else {
+	helmClient.SetApplyTimeout(opts.ApplyTimeout)
+	renderer := newManifestRenderer(opts)
+	// the spinner is stopped before waiting, as it would otherwise redraw its line over the progress lines of the pods
+	s.Stop("Prepared the deployment of application '" + app + "'")
+
+	err = waitWithProgress(ctx, namespace, func() error {
+		if !isAppExist {
+			// if App does not exist then perform install
+			logger.Infof("App: %s does not exist, proceeding with install...", app)
+
+			return helmClient.Install(app, chart, &helm.InstallOpts{Values: values, Timeout: timeout, PostRenderer: renderer})
+		}
+
 		// if App exists, perform upgrade so that the actual state of the app meets the desired state
 		logger.Infof("App: %s already exist, proceeding with reconciling...", app)
-		err = helmClient.Upgrade(app, chart, &helm.UpgradeOpts{Values: values, Timeout: timeout})
+
+		return helmClient.Upgrade(app, chart, &helm.UpgradeOpts{Values: values, Timeout: timeout, PostRenderer: renderer})
+	})
+	if errors.Is(err, errStoppedWaiting) {
+		logger.Infof("Stopped waiting for application '%s'\n", app)
+		logger.Infof("The deployment continues in the cluster, check its status with: ai-services application ps %s --runtime openshift\n", app)
+
+		return err
 	}
 	if err != nil {
-		s.Fail("failed to create application")
-
 		return fmt.Errorf("failed to perform app installation: %w", err)
 	}
 
-	s.Stop("Application '" + app + "' deployed successfully")
+	logger.Infof("Application '%s' deployed successfully\n", app)
 
 	return nil
 }
+
+// manifestRenderer sets the custom labels and annotations on the manifests rendered by the chart,
+// and dumps the resulting manifests for --dump-manifests.
+type manifestRenderer struct {
+	app      string
+	metadata specs.Metadata
+	dumpDir  string
+}
+
+// newManifestRenderer returns the post renderer of the manifests, nil when there is nothing to set nor to dump.
+func newManifestRenderer(opts types.CreateOptions) postrenderer.PostRenderer {
+	if opts.Metadata.Empty() && opts.DumpManifestsDir == "" {
+		return nil
+	}
+
+	return manifestRenderer{app: opts.Name, metadata: opts.Metadata, dumpDir: opts.DumpManifestsDir}
+}
+
+func (r manifestRenderer) Run(rendered *bytes.Buffer) (*bytes.Buffer, error) {
+	out := rendered.Bytes()
+	if !r.metadata.Empty() {
+		var err error
+		if out, err = r.metadata.Apply(out); err != nil {
+			return nil, fmt.Errorf("failed to set the custom labels and annotations: %w", err)
+		}
+	}
+
+	if err := common.DumpManifest(r.dumpDir, r.app, out); err != nil {
+		return nil, err
+	}
+
+	return bytes.NewBuffer(out), nil
+}
@@ -0,0 +1,66 @@
+package openshift
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+)
+
+func TestManifestRenderer(t *testing.T) {
+	if r := newManifestRenderer(types.CreateOptions{Name: "rag"}); r != nil {
+		t.Fatalf("newManifestRenderer() = %v, want nil without metadata nor dump directory", r)
+	}
+
+	dir := t.TempDir()
+	r := newManifestRenderer(types.CreateOptions{
+		Name:             "rag",
+		Metadata:         specs.Metadata{Labels: map[string]string{"cost-center": "1234"}},
+		DumpManifestsDir: dir,
+	})
+	rendered := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: rag-config\n"
+	out, err := r.Run(bytes.NewBufferString(rendered))
+	if err != nil {
+		t.Fatalf("Run() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "cost-center") {
+		t.Errorf("Run() = %q, want the custom label set", out.String())
+	}
+
+	dumped, err := os.ReadFile(filepath.Join(dir, "rag.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read the dumped manifests: %v", err)
+	}
+	if string(dumped) != out.String() {
+		t.Errorf("dumped manifests = %q, want the applied ones %q", dumped, out.String())
+	}
+}
+
+func TestAllocatableSpyreCards(t *testing.T) {
+	node := func(name string, cards string) *corev1.Node {
+		n := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if cards != "" {
+			n.Status.Allocatable = corev1.ResourceList{spyreResource: resource.MustParse(cards)}
+		}
+
+		return n
+	}
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).
+		WithObjects(node("worker-0", "4"), node("worker-1", "2"), node("master-0", "")).Build()
+
+	got, err := allocatableSpyreCards(context.Background(), c)
+	if err != nil || got != 6 {
+		t.Errorf("allocatableSpyreCards() = %d, %v, want 6 cards across the nodes", got, err)
+	}
+}
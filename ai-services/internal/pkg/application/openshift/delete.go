@@ -2,17 +2,24 @@ package openshift
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"helm.sh/helm/v4/pkg/storage/driver"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/helm"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // Delete removes an application and its associated resources.
+// Every resource is attempted even when the deletion of another one failed, the failed ones are reported in the returned error.
 func (o *OpenshiftApplication) Delete(ctx context.Context, opts types.DeleteOptions) error {
 	app := opts.Name
 	namespace := app
@@ -30,12 +37,17 @@ func (o *OpenshiftApplication) Delete(ctx context.Context, opts types.DeleteOpti
 	}
 
 	if !isAppExist {
-		logger.Infof("Application '%s' does not exist in namespace '%s'\n", app, namespace)
+		if opts.IgnoreNotFound {
+			logger.Infof("Application '%s' does not exist in namespace '%s'\n", app, namespace)
+
+			return nil
+		}
 
-		return nil
+		return fmt.Errorf("application '%s' in namespace '%s' %w", app, namespace, runtimeTypes.ErrNotFound)
 	}
 
-	if err := o.confirmDeletion(opts); err != nil {
+	confirmed, err := o.confirmDeletion(opts)
+	if err != nil || !confirmed {
 		return err
 	}
 
@@ -47,46 +59,52 @@ func (o *OpenshiftApplication) Delete(ctx context.Context, opts types.DeleteOpti
 		timeout = defaultDeleteTimeout
 	}
 
+	report := common.NewDeleteReport(app, opts.IgnoreNotFound)
+
 	s := spinner.New("Deleting application '" + app + "'...")
 
 	s.Start(ctx)
 
 	// Perform helm uninstall
 	err = helmClient.Uninstall(app, &helm.UninstallOpts{Timeout: timeout})
+	if errors.Is(err, driver.ErrReleaseNotFound) {
+		// uninstalled meanwhile
+		err = fmt.Errorf("%w: %w", runtimeTypes.ErrNotFound, err)
+	}
+	report.Record("helm release "+app, err)
 	if err != nil {
 		s.Fail("failed to delete application")
-
-		return fmt.Errorf("failed to perform app deletion: %w", err)
+	} else {
+		s.Stop("Application '" + app + "' deleted successfully")
 	}
 
-	s.Stop("Application '" + app + "' deleted successfully")
-
+	// the PVCs are kept by the uninstall, so they are cleaned up even when it failed
 	if !opts.SkipCleanup {
 		logger.Infoln("Cleaning up Persistent Volume Claims...", logger.VerbosityLevelDebug)
-
-		if err := o.runtime.DeletePVCs(fmt.Sprintf("ai-services.io/application=%s", app)); err != nil {
-			return fmt.Errorf("failed to cleanup PVCs: %w", err)
-		}
+		report.Record("persistent volume claims", o.runtime.DeletePVCs(selector.ForApplication(app).String()))
 	}
 
-	return nil
+	report.Print()
+
+	return report.Err()
 }
 
-func (o *OpenshiftApplication) confirmDeletion(opts types.DeleteOptions) error {
+// confirmDeletion prompts for the deletion unless auto-accepted, it returns whether to proceed.
+func (o *OpenshiftApplication) confirmDeletion(opts types.DeleteOptions) (bool, error) {
 	if opts.AutoYes {
-		return nil
+		return true, nil
 	}
 
 	confirmDelete, err := utils.ConfirmAction("Are you sure you want to delete the application '" + opts.Name + "'?")
 	if err != nil {
-		return fmt.Errorf("failed to take user input: %w", err)
+		return false, fmt.Errorf("failed to take user input: %w", err)
 	}
 
 	if !confirmDelete {
 		logger.Infoln("Deletion cancelled")
 
-		return nil
+		return false, nil
 	}
 
-	return nil
+	return true, nil
 }
@@ -0,0 +1,226 @@
+package openshift
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
+	"github.com/project-ai-services/ai-services/internal/pkg/helm"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// helmFieldManager is the field manager used by helm for server side apply.
+const helmFieldManager = "helm"
+
+// diffIgnoredFields are the fields maintained by the API server, which are left out of the diff.
+var diffIgnoredFields = []string{
+	"metadata.managedFields",
+	"metadata.resourceVersion",
+	"metadata.generation",
+	"metadata.uid",
+	"metadata.creationTimestamp",
+	"status",
+}
+
+// Diff compares the live resources of the release with the ones its upgrade would apply.
+// The rendered resources go through a server side apply dry-run, so that the defaults set by the API server
+// and admission do not show up as changes.
+func (o *OpenshiftApplication) Diff(ctx context.Context, opts types.DiffOptions) ([]diff.Change, error) {
+	namespace := opts.Name
+
+	helmClient, err := helm.NewHelm(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := helmClient.IsReleaseExist(opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("application '%s' is not deployed", opts.Name)
+	}
+
+	rendered, current, err := o.renderRelease(helmClient, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	kc, err := openshift.NewOpenshiftClientWithNamespace(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	desired, live, err := releaseResources(ctx, kc, rendered, current)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff.Compare(desired, live, diff.Options{Ignore: diffIgnoredFields, LiveOnlyFields: true}), nil
+}
+
+// renderRelease returns the manifest the upgrade of the release would apply, along with the current one.
+func (o *OpenshiftApplication) renderRelease(helmClient *helm.Helm, opts types.DiffOptions) (string, string, error) {
+	templateName, err := o.deployedTemplate(opts)
+	if err != nil {
+		return "", "", err
+	}
+
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: vars.RuntimeFactory.GetRuntimeType()})
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load application templates: %w", err)
+	}
+
+	chart, err := tp.LoadChart(templateName)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to load the chart: %w", err)
+	}
+
+	values, err := tp.LoadValues(templateName, opts.ValuesFiles, opts.ArgParams)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to prepare values: %w", err)
+	}
+
+	rendered, err := helmClient.Render(opts.Name, chart, values)
+	if err != nil {
+		return "", "", err
+	}
+
+	current, err := helmClient.Manifest(opts.Name)
+	if err != nil {
+		return "", "", err
+	}
+
+	return rendered, current, nil
+}
+
+// deployedTemplate returns the template requested via the options, or else the one the application was deployed with.
+func (o *OpenshiftApplication) deployedTemplate(opts types.DiffOptions) (string, error) {
+	if opts.TemplateName != "" {
+		return opts.TemplateName, nil
+	}
+
+	pods, err := o.runtime.ListPods(selector.ForApplication(opts.Name).Filters())
+	if err != nil {
+		return "", fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	for _, pod := range pods {
+		if name := pod.Labels[string(vars.TemplateLabel)]; name != "" {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("cannot find the template application '%s' was deployed with, please provide it with --template", opts.Name)
+}
+
+// releaseResources returns the server side dry-run result of every rendered resource as the desired state,
+// along with the live state of the rendered resources and of the ones in the current release.
+func releaseResources(ctx context.Context, kc *openshift.OpenshiftClient, rendered, current string) ([]diff.Resource, []diff.Resource, error) {
+	renderedResources, err := diff.ParseManifest([]byte(rendered))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	currentResources, err := diff.ParseManifest([]byte(current))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	desired := make([]diff.Resource, 0, len(renderedResources))
+	live := []diff.Resource{}
+	fetched := map[string]bool{}
+
+	for _, r := range renderedResources {
+		u, err := toUnstructured(kc, r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		liveObj, err := fetchLive(ctx, kc, u)
+		if err != nil {
+			return nil, nil, err
+		}
+		if liveObj != nil {
+			live = append(live, diff.Resource{Kind: r.Kind, Name: r.Name, Object: liveObj.Object})
+		}
+		fetched[r.Key()] = true
+
+		if err := kc.Client.Apply(ctx, client.ApplyConfigurationFromUnstructured(u),
+			client.DryRunAll, client.FieldOwner(helmFieldManager), client.ForceOwnership); err != nil {
+			return nil, nil, fmt.Errorf("server side dry-run of %s failed: %w", r.Key(), err)
+		}
+		desired = append(desired, diff.Resource{Kind: r.Kind, Name: r.Name, Object: u.Object})
+	}
+
+	// resources of the current release which are no longer rendered get removed by the upgrade
+	for _, r := range currentResources {
+		if fetched[r.Key()] {
+			continue
+		}
+
+		u, err := toUnstructured(kc, r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		liveObj, err := fetchLive(ctx, kc, u)
+		if err != nil {
+			return nil, nil, err
+		}
+		if liveObj != nil {
+			live = append(live, diff.Resource{Kind: r.Kind, Name: r.Name, Object: liveObj.Object})
+		}
+	}
+
+	return desired, live, nil
+}
+
+// toUnstructured converts the rendered resource, defaulting its namespace to the one of the application.
+func toUnstructured(kc *openshift.OpenshiftClient, r diff.Resource) (*unstructured.Unstructured, error) {
+	// round trip through JSON, so that the values get the types expected by unstructured objects
+	data, err := json.Marshal(r.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %s: %w", r.Key(), err)
+	}
+
+	u := &unstructured.Unstructured{}
+	if err := u.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", r.Key(), err)
+	}
+
+	namespaced, err := kc.Client.IsObjectNamespaced(u)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up the scope of %s: %w", r.Key(), err)
+	}
+	if namespaced && u.GetNamespace() == "" {
+		u.SetNamespace(kc.Namespace)
+	}
+
+	return u, nil
+}
+
+// fetchLive returns the live state of the given resource, or nil if it does not exist.
+func fetchLive(ctx context.Context, kc *openshift.OpenshiftClient, u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(u.GroupVersionKind())
+
+	err := kc.Client.Get(ctx, client.ObjectKeyFromObject(u), live)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s/%s: %w", u.GetKind(), u.GetName(), err)
+	}
+
+	return live, nil
+}
@@ -0,0 +1,168 @@
+package openshift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"helm.sh/helm/v4/pkg/chart"
+	"helm.sh/helm/v4/pkg/postrenderer"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
+	"github.com/project-ai-services/ai-services/internal/pkg/helm"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+)
+
+// dryRun loads the chart and the values of the template, and validates the resources the deployment would apply.
+func dryRun(ctx context.Context, tp templates.Template, opts types.CreateOptions) error {
+	chart, err := loadCharts(ctx, tp, opts)
+	if err != nil {
+		return err
+	}
+
+	values, err := tp.LoadValues(opts.TemplateName, opts.ValuesFiles, opts.ArgParams)
+	if err != nil {
+		return fmt.Errorf("failed to prepare values: %w", err)
+	}
+
+	return dryRunApp(ctx, chart, values, newManifestRenderer(opts), opts)
+}
+
+// dryRunApp validates the resources the deployment would apply against the cluster, through a server side apply dry-run,
+// so that the schema validation and the admission, Eg:- the webhooks, run without anything being persisted.
+func dryRunApp(ctx context.Context, chart chart.Charter, values map[string]any, renderer postrenderer.PostRenderer, opts types.CreateOptions) error {
+	app := opts.Name
+	namespace := app
+
+	s := spinner.New("Rendering application '" + app + "'...")
+	s.Start(ctx)
+	rendered, err := renderApp(chart, values, renderer, app, namespace)
+	if err != nil {
+		s.Fail("failed to render application")
+
+		return err
+	}
+	s.Stop("Rendered application '" + app + "'")
+
+	resources, err := diff.ParseManifest([]byte(rendered))
+	if err != nil {
+		return err
+	}
+
+	kc, err := openshift.NewOpenshiftClientWithNamespace(namespace)
+	if err != nil {
+		return fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	s = spinner.New(fmt.Sprintf("Validating %d resource(s) of application '%s' against the cluster...", len(resources), app))
+	s.Start(ctx)
+	rejected, unvalidated, err := dryRunResources(ctx, kc, resources)
+	if err != nil {
+		s.Fail("failed to validate application")
+
+		return err
+	}
+	if len(rejected) > 0 {
+		s.Fail(fmt.Sprintf("The API server rejected %d resource(s) of application '%s'", len(rejected), app))
+
+		return exitcode.MarkValidationFailed(fmt.Errorf("dry run of application '%s' failed:\n  - %s", app, strings.Join(rejected, "\n  - ")))
+	}
+	s.Stop(fmt.Sprintf("The API server accepted the %d resource(s) of application '%s'", len(resources)-len(unvalidated), app))
+
+	if len(unvalidated) > 0 {
+		logger.Warningf("%d resource(s) were NOT validated, as the API server needs namespace '%s' to exist, which the install creates: %s\n",
+			len(unvalidated), namespace, strings.Join(unvalidated, ", "))
+	}
+	logger.Infoln("Dry run: nothing was persisted in the cluster")
+
+	return nil
+}
+
+// renderApp renders the manifest of the install of the application, or of its upgrade when already deployed.
+func renderApp(chart chart.Charter, values map[string]any, renderer postrenderer.PostRenderer, app, namespace string) (string, error) {
+	helmClient, err := helm.NewHelm(namespace)
+	if err != nil {
+		return "", err
+	}
+
+	isAppExist, err := helmClient.IsReleaseExist(app)
+	if err != nil {
+		return "", err
+	}
+	if isAppExist {
+		return helmClient.RenderUpgrade(app, chart, &helm.UpgradeOpts{Values: values, PostRenderer: renderer})
+	}
+
+	return helmClient.RenderInstall(app, chart, &helm.InstallOpts{Values: values, PostRenderer: renderer})
+}
+
+// dryRunResources applies every resource with dryRun=All, returning the rejections of the API server, along with
+// the namespaced resources it could not validate as their namespace, created by the install, does not exist yet.
+func dryRunResources(ctx context.Context, kc *openshift.OpenshiftClient, resources []diff.Resource) ([]string, []string, error) {
+	nsExists, err := namespaceExists(ctx, kc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rejected, unvalidated []string
+	if !nsExists {
+		// the install creates the namespace, which goes through the admission too
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: kc.Namespace}}
+		if err := kc.Client.Create(ctx, ns, client.DryRunAll); err != nil {
+			rejected = append(rejected, fmt.Sprintf("Namespace/%s: %v", kc.Namespace, err))
+		}
+	}
+
+	for _, r := range resources {
+		u, err := toUnstructured(kc, r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = kc.Client.Apply(ctx, client.ApplyConfigurationFromUnstructured(u),
+			client.DryRunAll, client.FieldOwner(helmFieldManager), client.ForceOwnership)
+		switch {
+		case err == nil:
+		case !nsExists && isNamespaceNotFound(err, kc.Namespace):
+			// expected, the namespace is only created by the install
+			unvalidated = append(unvalidated, r.Key())
+		default:
+			rejected = append(rejected, fmt.Sprintf("%s: %v", r.Key(), err))
+		}
+	}
+
+	return rejected, unvalidated, nil
+}
+
+// isNamespaceNotFound reports whether the API server rejected the resource as the namespace does not exist.
+func isNamespaceNotFound(err error, namespace string) bool {
+	var status apierrors.APIStatus
+	if !apierrors.IsNotFound(err) || !errors.As(err, &status) {
+		return false
+	}
+	details := status.Status().Details
+
+	return details != nil && details.Kind == "namespaces" && details.Name == namespace
+}
+
+func namespaceExists(ctx context.Context, kc *openshift.OpenshiftClient) (bool, error) {
+	err := kc.Client.Get(ctx, client.ObjectKey{Name: kc.Namespace}, &corev1.Namespace{})
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get namespace '%s': %w", kc.Namespace, err)
+	}
+
+	return true, nil
+}
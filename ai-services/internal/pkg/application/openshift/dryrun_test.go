@@ -0,0 +1,156 @@
+package openshift
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+)
+
+func newDryRunResource(kind, name string) diff.Resource {
+	return diff.Resource{Kind: kind, Name: name, Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name},
+	}}
+}
+
+// newDryRunClient returns a fake client applying the resources as the API server would, failing the ones
+// of a missing namespace, and the ones named in rejected with the given error.
+func newDryRunClient(t *testing.T, nsExists bool, rejected map[string]error) *openshift.OpenshiftClient {
+	t.Helper()
+
+	mapper := meta.NewDefaultRESTMapper(nil)
+	for kind, scope := range map[string]meta.RESTScope{
+		"Namespace":        meta.RESTScopeRoot,
+		"PersistentVolume": meta.RESTScopeRoot,
+		"ConfigMap":        meta.RESTScopeNamespace,
+		"Service":          meta.RESTScopeNamespace,
+	} {
+		mapper.Add(corev1.SchemeGroupVersion.WithKind(kind), scope)
+	}
+
+	builder := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithRESTMapper(mapper)
+	if nsExists {
+		builder = builder.WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "rag"}})
+	}
+	builder = builder.WithInterceptorFuncs(interceptor.Funcs{
+		Apply: func(ctx context.Context, c client.WithWatch, obj runtime.ApplyConfiguration, opts ...client.ApplyOption) error {
+			data, err := json.Marshal(obj)
+			if err != nil {
+				return err
+			}
+			var meta struct {
+				Metadata metav1.ObjectMeta `json:"metadata"`
+			}
+			if err := json.Unmarshal(data, &meta); err != nil {
+				return err
+			}
+			if err, ok := rejected[meta.Metadata.Name]; ok {
+				return err
+			}
+			if !nsExists && meta.Metadata.Namespace != "" {
+				return apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, meta.Metadata.Namespace)
+			}
+
+			return nil
+		},
+	})
+
+	return &openshift.OpenshiftClient{Client: builder.Build(), Namespace: "rag"}
+}
+
+func TestDryRunResources(t *testing.T) {
+	resources := []diff.Resource{
+		newDryRunResource("ConfigMap", "rag-config"),
+		newDryRunResource("Service", "rag-ui"),
+		newDryRunResource("PersistentVolume", "rag-data"),
+	}
+	invalid := apierrors.NewInvalid(schema.GroupKind{Kind: "Service"}, "rag-ui", nil)
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "persistentvolumes"}, "rag-data", errors.New("denied by the webhook"))
+	otherNamespace := apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "shared")
+
+	tests := []struct {
+		name            string
+		nsExists        bool
+		rejected        map[string]error
+		wantRejected    int
+		wantUnvalidated []string
+	}{
+		{
+			name:     "all accepted",
+			nsExists: true,
+		},
+		{
+			name:         "rejected resources",
+			nsExists:     true,
+			rejected:     map[string]error{"rag-ui": invalid, "rag-data": forbidden},
+			wantRejected: 2,
+		},
+		{
+			name:            "missing namespace",
+			wantUnvalidated: []string{"ConfigMap/rag-config", "Service/rag-ui"},
+		},
+		{
+			name:            "rejected despite the missing namespace",
+			rejected:        map[string]error{"rag-ui": invalid, "rag-data": forbidden},
+			wantRejected:    2,
+			wantUnvalidated: []string{"ConfigMap/rag-config"},
+		},
+		{
+			name:         "another missing namespace",
+			nsExists:     true,
+			rejected:     map[string]error{"rag-config": otherNamespace},
+			wantRejected: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc := newDryRunClient(t, tt.nsExists, tt.rejected)
+			rejected, unvalidated, err := dryRunResources(context.Background(), kc, resources)
+			if err != nil {
+				t.Fatalf("dryRunResources() error = %v", err)
+			}
+			if len(rejected) != tt.wantRejected {
+				t.Errorf("dryRunResources() rejected = %q, want %d rejections", rejected, tt.wantRejected)
+			}
+			if !reflect.DeepEqual(unvalidated, tt.wantUnvalidated) {
+				t.Errorf("dryRunResources() unvalidated = %q, want %q", unvalidated, tt.wantUnvalidated)
+			}
+		})
+	}
+}
+
+func TestIsNamespaceNotFound(t *testing.T) {
+	tests := map[string]struct {
+		err  error
+		want bool
+	}{
+		"namespace not found":       {err: apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "rag"), want: true},
+		"other namespace not found": {err: apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "shared")},
+		"resource not found":        {err: apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "rag")},
+		"forbidden":                 {err: apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "rag", errors.New("denied"))},
+		"not an API error":          {err: errors.New("connection refused")},
+	}
+	for name, tt := range tests {
+		if got := isNamespaceNotFound(tt.err, "rag"); got != tt.want {
+			t.Errorf("isNamespaceNotFound() of %s = %v, want %v", name, got, tt.want)
+		}
+	}
+}
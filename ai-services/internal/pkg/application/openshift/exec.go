@@ -0,0 +1,11 @@
+package openshift
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Exec runs a command inside a container of the application.
+func (o *OpenshiftApplication) Exec(opts types.ExecOptions) error {
+	return common.Exec(o.runtime, opts)
+}
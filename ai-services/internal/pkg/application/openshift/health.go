@@ -0,0 +1,26 @@
+package openshift
+
+import (
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// Health returns the rolled-up health of every application deployed from a template,
+// across the namespaces the runtime lists the pods of.
+func (o *OpenshiftApplication) Health() ([]appTypes.ApplicationHealth, error) {
+	pods, err := o.runtime.ListPods(selector.NewAIServicesSelector("", "").Filters())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// the listed containers already carry their status and readiness, no need to inspect them
+	containerStatus := func(_ types.Pod, c types.Container) string {
+		return common.ContainerStatus(&c)
+	}
+
+	return common.Health(pods, containerStatus), nil
+}
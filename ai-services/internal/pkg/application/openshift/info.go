@@ -6,6 +6,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
@@ -13,12 +14,7 @@ import (
 func (o *OpenshiftApplication) Info(opts types.InfoOptions) error {
 	// Step1: Do List pods and filter for given application name
 
-	listFilters := map[string][]string{}
-	if opts.Name != "" {
-		listFilters["label"] = []string{fmt.Sprintf("ai-services.io/application=%s", opts.Name)}
-	}
-
-	pods, err := o.runtime.ListPods(listFilters)
+	pods, err := o.runtime.ListPods(selector.ForApplication(opts.Name).Filters())
 	if err != nil {
 		return fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -0,0 +1,169 @@
+package openshift
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+)
+
+// progressEventReasons are the normal events worth reporting while waiting for a deployment,
+// warning events are always reported.
+var progressEventReasons = map[string]bool{
+	"Scheduled": true,
+	"Pulling":   true,
+	"Pulled":    true,
+}
+
+// progressWatcher streams the pod phase transitions and the relevant events of a namespace to the logger.
+type progressWatcher struct {
+	client *openshift.OpenshiftClient
+	since  time.Time
+
+	mu       sync.Mutex
+	statuses map[string]string
+}
+
+// watchProgress reports the progress of the deployment in the given namespace until ctx is done.
+// Failing to watch is not fatal, as it only affects the reporting and not the deployment itself.
+func watchProgress(ctx context.Context, namespace string) {
+	client, err := openshift.NewOpenshiftClientWithNamespace(namespace)
+	if err != nil {
+		logger.Warningf("unable to report the deployment progress: %v\n", err)
+
+		return
+	}
+
+	w := &progressWatcher{client: client, since: time.Now(), statuses: map[string]string{}}
+
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		w.watch(ctx, "pods", func(opts metav1.ListOptions) (watch.Interface, error) {
+			return client.KubeClient.CoreV1().Pods(namespace).Watch(ctx, opts)
+		}, w.handlePod)
+	})
+	wg.Go(func() {
+		w.watch(ctx, "events", func(opts metav1.ListOptions) (watch.Interface, error) {
+			return client.KubeClient.CoreV1().Events(namespace).Watch(ctx, opts)
+		}, w.handleEvent)
+	})
+	wg.Wait()
+}
+
+// watch consumes the watch returned by start, restarting it whenever the server closes it, until ctx is done.
+func (w *progressWatcher) watch(ctx context.Context, kind string, start func(metav1.ListOptions) (watch.Interface, error), handle func(watch.Event)) {
+	for ctx.Err() == nil {
+		watcher, err := start(metav1.ListOptions{})
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Infof("failed to watch %s: %v\n", kind, err, logger.VerbosityLevelDebug)
+			}
+
+			return
+		}
+
+		for event := range watcher.ResultChan() {
+			handle(event)
+		}
+		watcher.Stop()
+	}
+}
+
+func (w *progressWatcher) handlePod(event watch.Event) {
+	pod, ok := event.Object.(*corev1.Pod)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if event.Type == watch.Deleted {
+		delete(w.statuses, pod.Name)
+
+		return
+	}
+
+	status := podStatus(pod)
+	previous, seen := w.statuses[pod.Name]
+	if seen && previous == status {
+		return
+	}
+	w.statuses[pod.Name] = status
+
+	if !seen {
+		logger.Infof("pod %s: %s\n", pod.Name, status)
+
+		return
+	}
+	logger.Infof("pod %s: %s → %s\n", pod.Name, previous, status)
+}
+
+func (w *progressWatcher) handleEvent(event watch.Event) {
+	ev, ok := event.Object.(*corev1.Event)
+	if !ok || event.Type == watch.Deleted {
+		return
+	}
+
+	// the watch starts by replaying the existing events, skip the ones from earlier deployments
+	if eventTime(ev).Before(w.since) {
+		return
+	}
+
+	if ev.Type != corev1.EventTypeWarning && !progressEventReasons[ev.Reason] {
+		return
+	}
+
+	msg := fmt.Sprintf("%s %s: %s: %s", strings.ToLower(ev.InvolvedObject.Kind), ev.InvolvedObject.Name, ev.Reason, ev.Message)
+	if ev.Type == corev1.EventTypeWarning {
+		logger.Warningln(msg)
+
+		return
+	}
+	logger.Infoln(msg)
+}
+
+// podStatus returns a short status of the pod, preferring the reason a container is waiting on
+// (e.g. ContainerCreating, ImagePullBackOff) over the coarse pod phase.
+func podStatus(pod *corev1.Pod) string {
+	if pod.DeletionTimestamp != nil {
+		return "Terminating"
+	}
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		if cs.State.Waiting != nil && cs.State.Waiting.Reason != "" {
+			return cs.State.Waiting.Reason
+		}
+	}
+
+	if pod.Status.Phase == corev1.PodRunning {
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodReady && cond.Status == corev1.ConditionTrue {
+				return "Ready"
+			}
+		}
+	}
+
+	return string(pod.Status.Phase)
+}
+
+// eventTime returns the time the event last occurred at.
+func eventTime(ev *corev1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case !ev.EventTime.IsZero():
+		return ev.EventTime.Time
+	default:
+		return ev.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,113 @@
+package openshift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/helm"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+const defaultRollbackTimeout = 10 * time.Minute
+
+// Rollback redeploys the latest earlier version of the application, the newest revision of the helm release
+// which was successfully deployed with another version than the current one.
+// Every create of a deployed application upgrades its release, and the release history records the chart,
+// whose app version is the template version, along with the values of each revision.
+func (o *OpenshiftApplication) Rollback(ctx context.Context, opts types.RollbackOptions) error {
+	app := opts.Name
+	namespace := app
+
+	helmClient, err := helm.NewHelm(namespace)
+	if err != nil {
+		return fmt.Errorf("failed to create helm client: %w", err)
+	}
+
+	isAppExist, err := helmClient.IsReleaseExist(app)
+	if err != nil {
+		return fmt.Errorf("failed to check if application exists: %w", err)
+	}
+	if !isAppExist {
+		return fmt.Errorf("application '%s' does not exist in namespace '%s'", app, namespace)
+	}
+
+	revisions, err := helmClient.History(app)
+	if err != nil {
+		return err
+	}
+	current, previous, err := rollbackTarget(revisions, app)
+	if err != nil {
+		return err
+	}
+
+	logger.Infof("Rolling back application '%s' from version %s (revision %d) to version %s (revision %d) deployed at %s\n",
+		app, current.AppVersion, current.Number, previous.AppVersion, previous.Number, previous.DeployedAt.Format(time.RFC3339))
+
+	if !opts.AutoYes {
+		confirmed, err := utils.ConfirmAction("Are you sure you want to roll back the application '" + app + "'?")
+		if err != nil {
+			return fmt.Errorf("failed to take user input: %w", err)
+		}
+		if !confirmed {
+			logger.Infoln("Rollback cancelled")
+
+			return nil
+		}
+	}
+
+	timeout := opts.Timeout
+	if timeout <= 0 {
+		timeout = defaultRollbackTimeout
+	}
+
+	return rollbackRelease(ctx, helmClient, app, previous.Number, timeout)
+}
+
+// rollbackTarget returns the current revision of the release, the last of the history sorted oldest first, along with
+// the revision to roll back to. That is the newest earlier one which was deployed, skipping the failed or pending ones,
+// with another version than the current one, skipping the re-deployments of the current version Eg:- to change a value.
+func rollbackTarget(revisions []helm.Revision, app string) (helm.Revision, helm.Revision, error) {
+	if len(revisions) == 0 {
+		return helm.Revision{}, helm.Revision{}, fmt.Errorf("application '%s' has no revision recorded", app)
+	}
+
+	current := revisions[len(revisions)-1]
+	for i := len(revisions) - 2; i >= 0; i-- {
+		rev := revisions[i]
+		if (rev.Status == helm.StatusDeployed || rev.Status == helm.StatusSuperseded) && rev.AppVersion != current.AppVersion {
+			return current, rev, nil
+		}
+	}
+
+	return helm.Revision{}, helm.Revision{}, fmt.Errorf("application '%s' has no prior version recorded to roll back to, "+
+		"it has only been deployed with version %s", app, current.AppVersion)
+}
+
+func rollbackRelease(ctx context.Context, helmClient *helm.Helm, app string, revision int, timeout time.Duration) error {
+	s := spinner.New("Rolling back application '" + app + "'...")
+	s.Start(ctx)
+
+	err := waitWithProgress(ctx, app, func() error {
+		return helmClient.Rollback(app, revision, &helm.RollbackOpts{Timeout: timeout})
+	})
+	if errors.Is(err, errStoppedWaiting) {
+		s.Stop("Stopped waiting for application '" + app + "'")
+		logger.Infof("The rollback continues in the cluster, check its status with: ai-services application ps %s --runtime openshift\n", app)
+
+		return err
+	}
+	if err != nil {
+		s.Fail("failed to roll back application")
+
+		return fmt.Errorf("failed to roll back the application: %w", err)
+	}
+
+	s.Stop("Application '" + app + "' rolled back successfully")
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package openshift
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/helm"
+)
+
+func TestRollbackTarget(t *testing.T) {
+	tests := []struct {
+		name      string
+		revisions []helm.Revision
+		want      int
+		wantErr   string
+	}{
+		{
+			name: "previous version",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusSuperseded},
+				{Number: 2, AppVersion: "1.1.0", Status: helm.StatusDeployed},
+			},
+			want: 1,
+		},
+		{
+			name: "failed upgrade skipped",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusSuperseded},
+				{Number: 2, AppVersion: "1.1.0", Status: "failed"},
+				{Number: 3, AppVersion: "1.2.0", Status: helm.StatusDeployed},
+			},
+			want: 1,
+		},
+		{
+			name: "re-deployments of the current version skipped",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusSuperseded},
+				{Number: 2, AppVersion: "1.1.0", Status: helm.StatusSuperseded},
+				{Number: 3, AppVersion: "1.1.0", Status: helm.StatusSuperseded},
+				{Number: 4, AppVersion: "1.1.0", Status: helm.StatusDeployed},
+			},
+			want: 1,
+		},
+		{
+			name: "newest earlier version",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusSuperseded},
+				{Number: 2, AppVersion: "1.1.0", Status: helm.StatusSuperseded},
+				{Number: 3, AppVersion: "1.0.0", Status: "pending-upgrade"},
+				{Number: 4, AppVersion: "1.2.0", Status: helm.StatusDeployed},
+			},
+			want: 2,
+		},
+		{
+			name: "after a rollback",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusSuperseded},
+				{Number: 2, AppVersion: "1.1.0", Status: helm.StatusSuperseded},
+				{Number: 3, AppVersion: "1.0.0", Status: helm.StatusDeployed},
+			},
+			want: 2,
+		},
+		{
+			name: "deployed once",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusDeployed},
+			},
+			wantErr: "application 'rag' has no prior version recorded to roll back to, it has only been deployed with version 1.0.0",
+		},
+		{
+			name: "only failed earlier versions",
+			revisions: []helm.Revision{
+				{Number: 1, AppVersion: "1.0.0", Status: helm.StatusSuperseded},
+				{Number: 2, AppVersion: "0.9.0", Status: "failed"},
+				{Number: 3, AppVersion: "1.0.0", Status: helm.StatusDeployed},
+			},
+			wantErr: "application 'rag' has no prior version recorded to roll back to, it has only been deployed with version 1.0.0",
+		},
+		{
+			name:    "no revision",
+			wantErr: "application 'rag' has no revision recorded",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, target, err := rollbackTarget(tt.revisions, "rag")
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("rollbackTarget() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("rollbackTarget() unexpected error = %v", err)
+			}
+			if current.Number != tt.revisions[len(tt.revisions)-1].Number {
+				t.Errorf("rollbackTarget() current = %d, want the last revision", current.Number)
+			}
+			if target.Number != tt.want {
+				t.Errorf("rollbackTarget() target = %d, want %d", target.Number, tt.want)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
 	"slices"
 	"strconv"
@@ -14,10 +15,13 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
@@ -26,6 +30,7 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	spyrerule "github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
@@ -37,6 +42,10 @@ var (
 
 // Create deploys a new application based on a template.
 func (p *PodmanApplication) Create(ctx context.Context, opts types.CreateOptions) error {
+	if opts.ManifestFile != "" {
+		return p.createFromManifest(ctx, opts)
+	}
+
 	// Proceed to create application
 	logger.Infof("Creating application '%s' using template '%s'\n", opts.Name, opts.TemplateName)
 
@@ -51,7 +60,10 @@ func (p *PodmanApplication) Create(ctx context.Context, opts types.CreateOptions
 	}
 	s.Stop("SMT level configured successfully")
 
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load application templates: %w", err)
+	}
 
 	// validate whether the provided template name is correct
 	if err := validators.ValidateAppTemplateExist(tp, opts.TemplateName); err != nil {
@@ -103,34 +115,33 @@ func (p *PodmanApplication) Create(ctx context.Context, opts types.CreateOptions
 }
 
 func (p *PodmanApplication) validateAndAllocateSpyreCards(templateName, appName string, tmpls map[string]*template.Template) ([]string, error) {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
-
-	reqSpyreCardsCount, err := p.calculateReqSpyreCards(tp, utils.ExtractMapKeys(tmpls), templateName, appName)
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
 	if err != nil {
-		return nil, fmt.Errorf("failed to calculateReqSpyreCards: %w", err)
+		return nil, fmt.Errorf("failed to load application templates: %w", err)
 	}
 
-	if reqSpyreCardsCount == 0 {
-		return nil, nil
-	}
-
-	// calculate the actual available spyre cards
-	pciAddresses, err := helpers.FindFreeSpyreCards()
+	reqSpyreCardsCount, totalSpyreCardsCount, err := p.calculateReqSpyreCards(tp, utils.ExtractMapKeys(tmpls), templateName, appName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find free Spyre Cards: %w", err)
+		return nil, fmt.Errorf("failed to calculateReqSpyreCards: %w", err)
 	}
 
-	actualSpyreCardsCount := len(pciAddresses)
-
-	// validate spyre card requirements
-	if err := p.validateSpyreCardRequirements(reqSpyreCardsCount, actualSpyreCardsCount); err != nil {
+	if err := p.validateMinSpyreCards(tp, templateName, totalSpyreCardsCount); err != nil {
 		return nil, err
 	}
 
-	return pciAddresses, nil
+	if reqSpyreCardsCount == 0 {
+		return nil, nil
+	}
+
+	return p.reserveSpyreCards(appName, reqSpyreCardsCount)
 }
 
 func (p *PodmanApplication) prepareApplicationArtifacts(ctx context.Context, opts types.CreateOptions) error {
+	// Build the local images, which are left out of the download
+	if err := p.buildImagesForTemplate(ctx, opts.TemplateName); err != nil {
+		return err
+	}
+
 	// Download Container Images
 	if err := p.downloadImagesForTemplate(opts.TemplateName, opts.Name, opts.ImagePullPolicy); err != nil {
 		return err
@@ -143,12 +154,34 @@ func (p *PodmanApplication) prepareApplicationArtifacts(ctx context.Context, opt
 		}
 	}
 
+	// the pods are only deployed once the models are staged
+	if opts.WaitForModel > 0 {
+		if err := p.waitForModels(ctx, opts.TemplateName, opts.Name, opts.WaitForModel); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (p *PodmanApplication) deployApplication(ctx context.Context, opts types.CreateOptions, tmpls map[string]*template.Template, appMetadata *templates.AppMetadata, pciAddresses []string) error {
 	logger.Infof("Total Pod Templates to be processed: %d\n", len(tmpls))
 
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load application templates: %w", err)
+	}
+
+	params, err := hookParams(tp, opts, appMetadata)
+	if err != nil {
+		return err
+	}
+
+	// a failing pre-deploy hook aborts the deployment
+	if err := p.runHooks(ctx, tp, opts.TemplateName, hookPhasePreDeploy, appMetadata.Hooks.PreDeploy, params); err != nil {
+		return err
+	}
+
 	s := spinner.New("Deploying application '" + opts.Name + "'...")
 	s.Start(ctx)
 
@@ -157,15 +190,17 @@ func (p *PodmanApplication) deployApplication(ctx context.Context, opts types.Cr
 		return fmt.Errorf("failed while checking existing pods for application: %w", err)
 	}
 
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
-
 	// execute the pod Templates
-	if err := p.executePodTemplates(tp, opts.Name, appMetadata, tmpls, pciAddresses, existingPods, opts.ValuesFiles, opts.ArgParams); err != nil {
+	if err := p.executePodTemplates(ctx, tp, opts.TemplateName, opts.Name, appMetadata, tmpls, pciAddresses, existingPods, opts.ValuesFiles, opts.ArgParams, opts.Metadata, opts.DumpManifestsDir); err != nil {
 		return err
 	}
 
 	s.Stop("Application '" + opts.Name + "' deployed successfully")
 
+	if err := p.runHooks(ctx, tp, opts.TemplateName, hookPhasePostDeploy, appMetadata.Hooks.PostDeploy, params); err != nil {
+		return fmt.Errorf("application '%s' is deployed, but %w", opts.Name, err)
+	}
+
 	logger.Infoln("-------")
 
 	// print the next steps to be performed at the end of create
@@ -287,7 +322,10 @@ func (p *PodmanApplication) getSMTLevel(output string) (int, error) {
 }
 
 func (p *PodmanApplication) getTargetSMTLevel(templateName string) (*int, error) {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load application templates: %w", err)
+	}
 
 	// validate whether the provided template name is correct
 	if err := validators.ValidateAppTemplateExist(tp, templateName); err != nil {
@@ -320,29 +358,80 @@ func (p *PodmanApplication) verifyPodTemplateExists(tmpls map[string]*template.T
 	return nil
 }
 
+// validateMinSpyreCards fails early when the spyre cards requested by the pod templates, or the ones detected on the host,
+// are below the minimum declared by the application template.
+func (p *PodmanApplication) validateMinSpyreCards(tp templates.Template, templateName string, requested int) error {
+	appMetadata, err := tp.LoadMetadata(templateName, false)
+	if err != nil {
+		return fmt.Errorf("failed to read the app metadata: %w", err)
+	}
+	if appMetadata.MinSpyreCards == 0 {
+		return nil
+	}
+
+	cards, err := spyrerule.ListDevices(hostfs.OS)
+	if err != nil {
+		return fmt.Errorf("failed to detect the spyre cards of the host: %w", err)
+	}
+
+	return checkMinSpyreCards(templateName, appMetadata.MinSpyreCards, requested, len(cards))
+}
+
+// checkMinSpyreCards compares the requested and detected spyre cards with the minimum of the application template.
+func checkMinSpyreCards(templateName string, minCards, requested, detected int) error {
+	if requested < minCards {
+		return fmt.Errorf("application template '%s' requires at least %d spyre cards, its pods request only %d", templateName, minCards, requested)
+	}
+	if detected < minCards {
+		return fmt.Errorf("application template '%s' requires at least %d spyre cards, only %d detected on the host", templateName, minCards, detected)
+	}
+
+	return nil
+}
+
 func (p *PodmanApplication) validateSpyreCardRequirements(req int, actual int) error {
 	if actual < req {
-		return fmt.Errorf("insufficient spyre cards. Require: %d spyre cards to proceed", req)
+		return fmt.Errorf("insufficient spyre cards. Require: %d spyre cards to proceed, %d free on the host and not reserved by other applications", req, actual)
 	}
 
 	return nil
 }
 
-func (p *PodmanApplication) calculateReqSpyreCards(tp templates.Template, podTemplateFileNames []string, appTemplateName, appName string) (int, error) {
+// logSpyreAllocationPlan logs the spyre cards each container of the pod gets, returning the total of the pod.
+func logSpyreAllocationPlan(podName string, plan []specs.SpyreAllocation) int {
+	total := specs.TotalSpyreCards(plan)
+	if total > 0 {
+		logger.Infof("Pod '%s' spyre cards allocation: %s\n", podName, specs.FormatSpyreAllocationPlan(plan))
+	}
+
+	return total
+}
+
+// calculateReqSpyreCards returns the spyre cards to allocate to the pods not deployed yet,
+// along with the total requested by all the pods of the application.
+func (p *PodmanApplication) calculateReqSpyreCards(tp templates.Template, podTemplateFileNames []string, appTemplateName, appName string) (int, int, error) {
 	totalReqSpyreCounts := 0
+	totalSpyreCounts := 0
 
 	// Calculate Req Spyre Counts
 	for _, podTemplateFileName := range podTemplateFileNames {
 		// fetch pod spec
 		podSpec, err := p.fetchPodSpec(tp, appTemplateName, podTemplateFileName, appName, nil, nil)
 		if err != nil {
-			return totalReqSpyreCounts, fmt.Errorf("failed to load pod Template: '%s' for appTemplate: '%s' with error: %w", podTemplateFileName, appTemplateName, err)
+			return 0, 0, fmt.Errorf("failed to load pod Template: '%s' for appTemplate: '%s' with error: %w", podTemplateFileName, appTemplateName, err)
 		}
 
+		plan, err := specs.SpyreAllocationPlan(*podSpec)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid pod Template: '%s' for appTemplate: '%s': %w", podTemplateFileName, appTemplateName, err)
+		}
+
+		totalSpyreCounts += specs.TotalSpyreCards(plan)
+
 		// check if pod already exists and skip counting if it does exists
 		exists, err := p.runtime.PodExists(podSpec.Name)
 		if err != nil {
-			return totalReqSpyreCounts, fmt.Errorf("failed to check pod status: %w", err)
+			return 0, 0, fmt.Errorf("failed to check pod status: %w", err)
 		}
 
 		if exists {
@@ -351,16 +440,10 @@ func (p *PodmanApplication) calculateReqSpyreCards(tp templates.Template, podTem
 			continue
 		}
 
-		// fetch the spyreCount for all containers from the annotations
-		spyreCount, _, err := p.fetchSpyreCardsFromPodAnnotations(podSpec.Annotations)
-		if err != nil {
-			return totalReqSpyreCounts, err
-		}
-
-		totalReqSpyreCounts += spyreCount
+		totalReqSpyreCounts += logSpyreAllocationPlan(podSpec.Name, plan)
 	}
 
-	return totalReqSpyreCounts, nil
+	return totalReqSpyreCounts, totalSpyreCounts, nil
 }
 
 func (p *PodmanApplication) fetchPodSpec(tp templates.Template, appTemplateName, podTemplateFileName, appName string, valuesFiles []string, argParams map[string]string) (*models.PodSpec, error) {
@@ -401,6 +484,41 @@ func (p *PodmanApplication) fetchSpyreCardsFromPodAnnotations(annotations map[st
 	return spyreCards, spyreCardContainerMap, nil
 }
 
+// buildImagesForTemplate builds the local images declared by the template, from the build contexts shipped with it.
+func (p *PodmanApplication) buildImagesForTemplate(ctx context.Context, templateName string) error {
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to load application templates: %w", err)
+	}
+
+	appMetadata, err := tp.LoadMetadata(templateName, true)
+	if err != nil {
+		return fmt.Errorf("failed to read the app metadata: %w", err)
+	}
+
+	for _, build := range appMetadata.Builds {
+		if err := p.buildImage(ctx, tp, templateName, build); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *PodmanApplication) buildImage(ctx context.Context, tp templates.Template, templateName string, build templates.Build) error {
+	contextDir, err := os.MkdirTemp("", "ai-services-build-")
+	if err != nil {
+		return fmt.Errorf("failed to create the build context directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(contextDir) }()
+
+	if err := tp.CopyBuildContext(templateName, build.Context, contextDir); err != nil {
+		return fmt.Errorf("failed to prepare the build context of image '%s': %w", build.Tag, err)
+	}
+
+	return p.runtime.Build(ctx, contextDir, build.Tag)
+}
+
 func (p *PodmanApplication) downloadImagesForTemplate(templateName, appName string, imagePullPolicy image.ImagePullPolicy) error {
 	// create a new imagePull object based on imagePullPolicy
 	imagePull := image.NewImagePull(p.runtime, imagePullPolicy, appName, templateName)
@@ -409,12 +527,13 @@ func (p *PodmanApplication) downloadImagesForTemplate(templateName, appName stri
 	return imagePull.Run()
 }
 
-func (p *PodmanApplication) executePodTemplates(tp templates.Template,
-	appName string, appMetadata *templates.AppMetadata,
+// executePodTemplates deploys the pod templates of the template reference, which may pin a version of the application template.
+func (p *PodmanApplication) executePodTemplates(ctx context.Context, tp templates.Template,
+	templateRef, appName string, appMetadata *templates.AppMetadata,
 	tmpls map[string]*template.Template, pciAddresses []string, existingPods []string,
-	valuesFiles []string, argParams map[string]string) error {
+	valuesFiles []string, argParams map[string]string, metadata specs.Metadata, dumpDir string) error {
 	// Load values for template rendering
-	values, err := tp.LoadValues(appMetadata.Name, valuesFiles, argParams)
+	values, err := tp.LoadValues(templateRef, valuesFiles, argParams)
 	if err != nil {
 		return fmt.Errorf("failed to load params for application: %w", err)
 	}
@@ -424,6 +543,7 @@ func (p *PodmanApplication) executePodTemplates(tp templates.Template,
 		"AppTemplateName": appMetadata.Name,
 		"Version":         appMetadata.Version,
 		"Values":          values,
+		"Facts":           facts.Get(),
 		// Key -> container name
 		// Value -> range of key-value env pairs
 		"env": map[string]map[string]string{},
@@ -441,7 +561,7 @@ func (p *PodmanApplication) executePodTemplates(tp templates.Template,
 			wg.Add(1)
 			go func(t string) {
 				defer wg.Done()
-				if err := p.executePodTemplateLayer(tp, tmpls, globalParams, pciAddresses, existingPods, podTemplateName, appName, valuesFiles, argParams); err != nil {
+				if err := p.executePodTemplateLayer(ctx, tp, tmpls, globalParams, &pciAddresses, existingPods, templateRef, podTemplateName, appName, valuesFiles, argParams, metadata, dumpDir); err != nil {
 					errCh <- err
 				}
 			}(podTemplateName)
@@ -467,16 +587,16 @@ func (p *PodmanApplication) executePodTemplates(tp templates.Template,
 	return nil
 }
 
-func (p *PodmanApplication) executePodTemplateLayer(tp templates.Template, tmpls map[string]*template.Template,
-	globalParams map[string]any, pciAddresses []string, existingPods []string, podTemplateName, appName string,
-	valuesFiles []string, argParams map[string]string) error {
+func (p *PodmanApplication) executePodTemplateLayer(ctx context.Context, tp templates.Template, tmpls map[string]*template.Template,
+	globalParams map[string]any, pciAddresses *[]string, existingPods []string, templateRef, podTemplateName, appName string,
+	valuesFiles []string, argParams map[string]string, metadata specs.Metadata, dumpDir string) error {
 	logger.Infof("'%s': Processing template...\n", podTemplateName)
 
 	// Shallow Copy globalParams Map
 	params := utils.CopyMap(globalParams)
 
 	// fetch pod Spec
-	podSpec, err := p.fetchPodSpec(tp, globalParams["AppTemplateName"].(string), podTemplateName, appName, valuesFiles, argParams)
+	podSpec, err := p.fetchPodSpec(tp, templateRef, podTemplateName, appName, valuesFiles, argParams)
 	if err != nil {
 		return err
 	}
@@ -491,7 +611,7 @@ func (p *PodmanApplication) executePodTemplateLayer(tp templates.Template, tmpls
 	podAnnotations := p.fetchPodAnnotations(podSpec)
 
 	// get the env params for a given pod
-	env, err := p.returnEnvParamsForPod(podSpec, podAnnotations, &pciAddresses)
+	env, err := p.returnEnvParamsForPod(podSpec, podAnnotations, pciAddresses)
 	if err != nil {
 		return fmt.Errorf("'%s': Failed to fetch env params: %w", podTemplateName, err)
 	}
@@ -504,11 +624,21 @@ func (p *PodmanApplication) executePodTemplateLayer(tp templates.Template, tmpls
 		return fmt.Errorf("'%s': Failed to parse pod template: %w", podTemplateName, err)
 	}
 
+	// set the custom labels and annotations on top of the ones of the template
+	body, err := metadata.Apply(rendered.Bytes())
+	if err != nil {
+		return fmt.Errorf("'%s': Failed to set the custom labels and annotations: %w", podTemplateName, err)
+	}
+
+	if err := common.DumpManifest(dumpDir, podSpec.Name, body); err != nil {
+		return fmt.Errorf("'%s': %w", podTemplateName, err)
+	}
+
 	// Wrap the bytes in a bytes.Reader
-	reader := bytes.NewReader(rendered.Bytes())
+	reader := bytes.NewReader(body)
 
 	// Deploy the Pod and do Readiness check
-	if err := p.deployPodAndReadinessCheck(podSpec, podTemplateName, reader, p.constructPodDeployOptions(podAnnotations)); err != nil {
+	if err := p.deployPodAndReadinessCheck(ctx, podSpec, podTemplateName, reader, p.constructPodDeployOptions(podAnnotations)); err != nil {
 		return fmt.Errorf("'%s': Failed to deploy pod and do readiness check: %w", podTemplateName, err)
 	}
 
@@ -528,6 +658,10 @@ func (p *PodmanApplication) returnEnvParamsForPod(podSpec *models.PodSpec, podAn
 		env[containerName] = map[string]string{}
 	}
 
+	if err := specs.ValidateSpyreCardAnnotations(*podSpec); err != nil {
+		return env, err
+	}
+
 	// fetch the spyre cards and spyre card count required for each container in a pod
 	spyreCards, spyreCardContainerMap, err := p.fetchSpyreCardsFromPodAnnotations(podAnnotations)
 	if err != nil {
@@ -552,7 +686,7 @@ func (p *PodmanApplication) returnEnvParamsForPod(podSpec *models.PodSpec, podAn
 	return env, nil
 }
 
-func (p *PodmanApplication) deployPodAndReadinessCheck(podSpec *models.PodSpec,
+func (p *PodmanApplication) deployPodAndReadinessCheck(ctx context.Context, podSpec *models.PodSpec,
 	podTemplateName string, body io.Reader, opts map[string]string) error {
 	pods, err := podman.RunPodmanKubePlay(body, opts)
 	if err != nil {
@@ -573,13 +707,13 @@ func (p *PodmanApplication) deployPodAndReadinessCheck(podSpec *models.PodSpec,
 		logger.Infof("'%s', '%s': Starting Pod Readiness check...\n", podTemplateName, podName)
 
 		// Step1: ---- Containers Creation Check ----
-		if err := p.doContainersCreationCheck(podSpec, podTemplateName, pInfo.Name, pInfo.ID); err != nil {
+		if err := p.doContainersCreationCheck(ctx, podSpec, podTemplateName, pInfo.Name, pInfo.ID); err != nil {
 			return err
 		}
 
 		// Step2: ---- Containers Readiness Check ----
 		for _, container := range pInfo.Containers {
-			if err := p.doContainerReadinessCheck(podTemplateName, pInfo.Name, container.ID); err != nil {
+			if err := p.doContainerReadinessCheck(ctx, podTemplateName, pInfo.Name, container.ID); err != nil {
 				return err
 			}
 			logger.Infoln("-------")
@@ -593,14 +727,14 @@ func (p *PodmanApplication) deployPodAndReadinessCheck(podSpec *models.PodSpec,
 	return nil
 }
 
-func (p *PodmanApplication) doContainersCreationCheck(podSpec *models.PodSpec, podTemplateName, podName, podID string) error {
+func (p *PodmanApplication) doContainersCreationCheck(ctx context.Context, podSpec *models.PodSpec, podTemplateName, podName, podID string) error {
 	logger.Infof("'%s', '%s': Performing Containers Creation check for pod...\n", podTemplateName, podName)
 
 	expectedContainerCount := len(specs.FetchContainerNames(*podSpec))
 
 	logger.Infof("'%s', '%s': Waiting for Containers Creation... Timeout set: %s\n", podTemplateName, podName, containerCreationTimeout)
 	// wait for all containers for a given pod are created
-	if err := helpers.WaitForContainersCreation(p.runtime, podID, expectedContainerCount, containerCreationTimeout); err != nil {
+	if err := helpers.WaitForContainersCreation(ctx, p.runtime, podID, expectedContainerCount, containerCreationTimeout); err != nil {
 		return fmt.Errorf("containers creation check failed for pod: '%s' with error: %w", podName, err)
 	}
 
@@ -609,7 +743,7 @@ func (p *PodmanApplication) doContainersCreationCheck(podSpec *models.PodSpec, p
 	return nil
 }
 
-func (p *PodmanApplication) doContainerReadinessCheck(podTemplateName, podName, containerID string) error {
+func (p *PodmanApplication) doContainerReadinessCheck(ctx context.Context, podTemplateName, podName, containerID string) error {
 	cInfo, err := p.runtime.InspectContainer(containerID)
 	if err != nil {
 		return fmt.Errorf("failed to do container inspect for containerID: '%s' with error: %w", containerID, err)
@@ -634,7 +768,7 @@ func (p *PodmanApplication) doContainerReadinessCheck(podTemplateName, podName,
 
 	logger.Infof("'%s', '%s', '%s': Waiting for Container Readiness... Timeout set: %s\n", podTemplateName, podName, cInfo.Name, readinessTimeout)
 
-	if err := helpers.WaitForContainerReadiness(p.runtime, containerID, readinessTimeout); err != nil {
+	if err := helpers.WaitForContainerReadiness(ctx, p.runtime, containerID, readinessTimeout); err != nil {
 		return fmt.Errorf("readiness check failed for container: '%s'!: %w", cInfo.Name, err)
 	}
 	logger.Infof("'%s', '%s', '%s': Readiness Check for the container is completed!\n", podTemplateName, podName, cInfo.Name)
@@ -0,0 +1,34 @@
+package podman
+
+import "testing"
+
+func TestCheckMinSpyreCards(t *testing.T) {
+	tests := []struct {
+		name      string
+		minCards  int
+		requested int
+		detected  int
+		wantErr   string
+	}{
+		{name: "no minimum", requested: 0, detected: 0},
+		{name: "enough cards", minCards: 4, requested: 4, detected: 8},
+		{name: "pods request too few", minCards: 4, requested: 2, detected: 8, wantErr: "application template 'rag' requires at least 4 spyre cards, its pods request only 2"},
+		{name: "host has too few", minCards: 4, requested: 4, detected: 3, wantErr: "application template 'rag' requires at least 4 spyre cards, only 3 detected on the host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkMinSpyreCards("rag", tt.minCards, tt.requested, tt.detected)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkMinSpyreCards() error = %v, want none", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("checkMinSpyreCards() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,36 +5,42 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // Delete removes an application and its associated resources.
+// Every resource is attempted even when the deletion of another one failed, the failed ones are reported in the returned error.
 func (p *PodmanApplication) Delete(_ context.Context, opts appTypes.DeleteOptions) error {
 	appDir := filepath.Join(constants.ApplicationsPath, filepath.Base(opts.Name))
 	appExists := utils.FileExists(appDir)
 
-	pods, err := p.runtime.ListPods(map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", opts.Name)},
-	})
+	pods, err := p.runtime.ListPods(selector.ForApplication(opts.Name).Filters())
 	if err != nil {
 		return fmt.Errorf("failed to list pods: %w", err)
 	}
 	podsExists := len(pods) != 0
 
-	if !podsExists {
-		logger.Infof("No pods found for application: %s\n", opts.Name)
+	if !podsExists && (!appExists || opts.SkipCleanup) {
+		if opts.IgnoreNotFound {
+			logger.Infof("No pods found for application: %s\n", opts.Name)
 
-		return nil
+			return nil
+		}
+
+		return fmt.Errorf("application %s %w", opts.Name, types.ErrNotFound)
 	}
 
 	// print relevant app pod status
-	p.logPodsToBeDeleted(opts.Name, pods)
+	if podsExists {
+		p.logPodsToBeDeleted(opts.Name, pods)
+	}
 
 	if !opts.AutoYes {
 		confirmDelete, err := p.deleteConfirmation(opts.Name, podsExists, appExists, opts.SkipCleanup)
@@ -50,17 +56,20 @@ func (p *PodmanApplication) Delete(_ context.Context, opts appTypes.DeleteOption
 
 	logger.Infoln("Proceeding with deletion...")
 
-	if err := p.podsDeletion(pods); err != nil {
-		return err
+	report := common.NewDeleteReport(opts.Name, opts.IgnoreNotFound)
+	p.podsDeletion(report, pods)
+
+	if err := p.releaseSpyreCards(opts.Name); err != nil {
+		report.Record("spyre card reservations", err)
 	}
 
 	if appExists && !opts.SkipCleanup {
-		if err := p.appDataDeletion(appDir); err != nil {
-			return err
-		}
+		report.Record("application data "+appDir, p.appDataDeletion(appDir))
 	}
 
-	return nil
+	report.Print()
+
+	return report.Err()
 }
 
 func (p *PodmanApplication) logPodsToBeDeleted(appName string, pods []types.Pod) {
@@ -93,37 +102,15 @@ func (p *PodmanApplication) deleteConfirmation(appName string, podsExists, appEx
 	return confirmDelete, nil
 }
 
-func (p *PodmanApplication) podsDeletion(pods []types.Pod) error {
-	var errors []string
-
+func (p *PodmanApplication) podsDeletion(report *common.DeleteReport, pods []types.Pod) {
 	for _, pod := range pods {
 		logger.Infof("Deleting pod: %s\n", pod.Name)
-
-		if err := p.runtime.DeletePod(pod.ID, utils.BoolPtr(true)); err != nil {
-			errors = append(errors, fmt.Sprintf("pod %s: %v", pod.Name, err))
-
-			continue
-		}
-
-		logger.Infof("Successfully removed pod: %s\n", pod.Name)
-	}
-
-	// Aggregate errors at the end
-	if len(errors) > 0 {
-		return fmt.Errorf("failed to remove pods: \n%s", strings.Join(errors, "\n"))
+		report.Record("pod "+pod.Name, p.runtime.DeletePod(pod.ID, utils.BoolPtr(true)))
 	}
-
-	return nil
 }
 
 func (p *PodmanApplication) appDataDeletion(appDir string) error {
 	logger.Infoln("Cleaning up application data")
 
-	if err := os.RemoveAll(appDir); err != nil {
-		return fmt.Errorf("failed to delete application data: %w", err)
-	}
-
-	logger.Infoln("Application data cleaned up successfully")
-
-	return nil
+	return os.RemoveAll(appDir)
 }
@@ -0,0 +1,185 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// diffIgnoredFields are the rendered fields which podman does not keep in the run spec of a pod.
+// Init containers are removed once they complete, and the pod annotations are only read by kube play.
+var diffIgnoredFields = []string{
+	"metadata.annotations",
+	"spec.initContainers",
+}
+
+// Diff compares the run spec of the deployed pods with the pods rendered from the template.
+// Only the fields set by the template are compared, the ones defaulted by podman are not reported.
+func (p *PodmanApplication) Diff(ctx context.Context, opts types.DiffOptions) ([]diff.Change, error) {
+	pods, err := p.runtime.ListPods(selector.ForApplication(opts.Name).Filters())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("application '%s' is not deployed", opts.Name)
+	}
+
+	templateName := opts.TemplateName
+	if templateName == "" {
+		templateName = pods[0].Labels[string(vars.TemplateLabel)]
+	}
+
+	desired, err := p.renderPods(opts, templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	live := make([]diff.Resource, 0, len(pods))
+	for _, pod := range pods {
+		out, err := podman.GenerateKube(pod.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch the run spec of pod '%s': %w", pod.Name, err)
+		}
+
+		resources, err := diff.ParseManifest(out)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the run spec of pod '%s': %w", pod.Name, err)
+		}
+		for _, r := range resources {
+			if r.Kind == "Pod" {
+				setVersionLabel(r.Object, pod.Labels[string(vars.VersionLabel)])
+				live = append(live, r)
+			}
+		}
+	}
+
+	return diff.Compare(desired, live, diff.Options{Ignore: diffIgnoredFields}), nil
+}
+
+// renderPods renders the pod templates of the application template with the given parameters.
+func (p *PodmanApplication) renderPods(opts types.DiffOptions, templateName string) ([]diff.Resource, error) {
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load application templates: %w", err)
+	}
+
+	if err := validators.ValidateAppTemplateExist(tp, templateName); err != nil {
+		return nil, err
+	}
+
+	tmpls, err := tp.LoadAllTemplates(templateName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the templates: %w", err)
+	}
+
+	appMetadata, err := tp.LoadMetadata(templateName, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the app metadata: %w", err)
+	}
+
+	values, err := tp.LoadValues(templateName, opts.ValuesFiles, opts.ArgParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load params for application: %w", err)
+	}
+
+	params := map[string]any{
+		"AppName":         opts.Name,
+		"AppTemplateName": appMetadata.Name,
+		"Version":         appMetadata.Version,
+		"Values":          values,
+		"Facts":           facts.Get(),
+	}
+
+	desired := make([]diff.Resource, 0, len(tmpls))
+	for name, tmpl := range tmpls {
+		resources, err := p.renderPod(tp, tmpl, templateName, name, opts, params)
+		if err != nil {
+			return nil, err
+		}
+		desired = append(desired, resources...)
+	}
+
+	return desired, nil
+}
+
+func (p *PodmanApplication) renderPod(tp templates.Template, tmpl *template.Template, templateName, name string,
+	opts types.DiffOptions, globalParams map[string]any) ([]diff.Resource, error) {
+	podSpec, err := p.fetchPodSpec(tp, templateName, name, opts.Name, opts.ValuesFiles, opts.ArgParams)
+	if err != nil {
+		return nil, err
+	}
+
+	// the spyre cards are allocated at deploy time, so the env of the containers is left empty
+	env := map[string]map[string]string{}
+	for _, container := range specs.FetchContainerNames(*podSpec) {
+		env[container] = map[string]string{}
+	}
+	params := utils.CopyMap(globalParams)
+	params["env"] = env
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, params); err != nil {
+		return nil, fmt.Errorf("'%s': failed to render pod template: %w", name, err)
+	}
+
+	resources, err := diff.ParseManifest(rendered.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("'%s': %w", name, err)
+	}
+	for _, r := range resources {
+		stripVolumeOptions(r.Object)
+		setVersionLabel(r.Object, globalParams["Version"].(string))
+	}
+
+	return resources, nil
+}
+
+// setVersionLabel sets the version label of the pod to the version of the template it was rendered from, so that
+// a change of the template version is reported whether the pod template sets the label or not.
+func setVersionLabel(obj map[string]any, version string) {
+	if version == "" {
+		return
+	}
+
+	metadata, ok := obj["metadata"].(map[string]any)
+	if !ok {
+		metadata = map[string]any{}
+		obj["metadata"] = metadata
+	}
+	labels, ok := metadata["labels"].(map[string]any)
+	if !ok {
+		labels = map[string]any{}
+		metadata["labels"] = labels
+	}
+	labels[string(vars.VersionLabel)] = version
+}
+
+// stripVolumeOptions drops the podman specific options appended to the mount paths, Eg:- "/data:z" -> "/data",
+// as they are not part of the mount path reported back by podman.
+func stripVolumeOptions(obj map[string]any) {
+	spec, _ := obj["spec"].(map[string]any)
+	containers, _ := spec["containers"].([]any)
+	for _, c := range containers {
+		container, _ := c.(map[string]any)
+		mounts, _ := container["volumeMounts"].([]any)
+		for _, m := range mounts {
+			mount, _ := m.(map[string]any)
+			if path, ok := mount["mountPath"].(string); ok {
+				mount["mountPath"], _, _ = strings.Cut(path, ":")
+			}
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package podman
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/diff"
+)
+
+func TestStripVolumeOptions(t *testing.T) {
+	resources, err := diff.ParseManifest([]byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: app--opensearch
+spec:
+  containers:
+    - name: opensearch
+      volumeMounts:
+        - name: data
+          mountPath: /usr/share/opensearch/data:z
+        - name: config
+          mountPath: /etc/opensearch
+`))
+	if err != nil {
+		t.Fatalf("ParseManifest() error = %v", err)
+	}
+
+	live, err := diff.ParseManifest([]byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: app--opensearch
+spec:
+  containers:
+    - name: opensearch
+      volumeMounts:
+        - name: data
+          mountPath: /usr/share/opensearch/data
+        - name: config
+          mountPath: /etc/opensearch
+`))
+	if err != nil {
+		t.Fatalf("ParseManifest() error = %v", err)
+	}
+
+	stripVolumeOptions(resources[0].Object)
+	if changes := diff.Compare(resources, live, diff.Options{Ignore: diffIgnoredFields}); len(changes) != 0 {
+		t.Errorf("Compare() = %+v, want no changes once the volume options are stripped", changes)
+	}
+}
+
+func TestSetVersionLabel(t *testing.T) {
+	parse := func(manifest string) []diff.Resource {
+		t.Helper()
+		resources, err := diff.ParseManifest([]byte(manifest))
+		if err != nil {
+			t.Fatalf("ParseManifest() error = %v", err)
+		}
+
+		return resources
+	}
+	// the pod template does not set the version label, the live pod holds the one of the deployed version
+	desired := parse("apiVersion: v1\nkind: Pod\nmetadata:\n  name: app--chat-bot\n")
+	live := parse("apiVersion: v1\nkind: Pod\nmetadata:\n  name: app--chat-bot\n")
+
+	setVersionLabel(desired[0].Object, "0.0.2")
+	setVersionLabel(live[0].Object, "0.0.1")
+	changes := diff.Compare(desired, live, diff.Options{Ignore: diffIgnoredFields})
+	if len(changes) != 1 || len(changes[0].Fields) != 1 || changes[0].Fields[0] != "metadata.labels.ai-services.io/version" {
+		t.Errorf("Compare() = %+v, want the change of the version label", changes)
+	}
+
+	setVersionLabel(live[0].Object, "0.0.2")
+	if changes := diff.Compare(desired, live, diff.Options{Ignore: diffIgnoredFields}); len(changes) != 0 {
+		t.Errorf("Compare() = %+v, want no changes for the same version", changes)
+	}
+}
@@ -0,0 +1,11 @@
+package podman
+
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Exec runs a command inside a container of the application.
+func (p *PodmanApplication) Exec(opts types.ExecOptions) error {
+	return common.Exec(p.runtime, opts)
+}
@@ -0,0 +1,110 @@
+package podman
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/vfio"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// containerUnknown is the status of a container which could not be inspected.
+const containerUnknown = "unknown"
+
+// Health returns the rolled-up health of every application deployed from a template,
+// along with the spyre cards issues of the applications using them.
+func (p *PodmanApplication) Health() ([]appTypes.ApplicationHealth, error) {
+	pods, err := p.runtime.ListPods(selector.NewAIServicesSelector("", "").Filters())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+
+	// spyreApps are the applications with a container the spyre cards are passed through to
+	spyreApps := map[string]bool{}
+	containerStatus := func(pod types.Pod, c types.Container) string {
+		cInfo, err := p.runtime.InspectContainer(c.ID)
+		if err != nil {
+			logger.Infof("failed to do container inspect for pod: '%s', containerID: '%s' with error: %v", pod.Name, c.ID, err, logger.VerbosityLevelDebug)
+
+			return containerUnknown
+		}
+		if usesSpyreCards(cInfo.Annotations) {
+			spyreApps[pod.Labels[constants.ApplicationAnnotationKey]] = true
+		}
+
+		return common.ContainerStatus(cInfo)
+	}
+
+	healths := common.Health(pods, containerStatus)
+	p.setSpyreReservations(healths)
+	if len(spyreApps) == 0 {
+		return healths, nil
+	}
+
+	issue := spyreBindingsIssue()
+	if issue == "" {
+		return healths, nil
+	}
+	for i := range healths {
+		if spyreApps[healths[i].Name] {
+			healths[i].Issues = append(healths[i].Issues, issue)
+			healths[i].State = common.HealthState(healths[i])
+		}
+	}
+
+	return healths, nil
+}
+
+// setSpyreReservations sets the spyre cards reserved by each application.
+func (p *PodmanApplication) setSpyreReservations(healths []appTypes.ApplicationHealth) {
+	reservations, err := spyre.LoadReservations(spyre.DefaultReservationsPath)
+	if err != nil {
+		logger.Infof("failed to load the spyre cards reservations: %v\n", err, logger.VerbosityLevelDebug)
+
+		return
+	}
+	for i := range healths {
+		healths[i].SpyreCards = reservations[healths[i].Name]
+	}
+}
+
+func usesSpyreCards(annotations map[string]string) bool {
+	for key := range annotations {
+		if vars.SpyreCardAnnotationRegex.MatchString(key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// spyreBindingsIssue reports the spyre cards of the host which are not bound to vfio-pci,
+// and so cannot be passed through to the pods, empty for none.
+func spyreBindingsIssue() string {
+	bindings, err := vfio.CheckBindings(hostfs.OS)
+	if err != nil {
+		logger.Infof("failed to check the vfio-pci bindings of the spyre cards: %v\n", err, logger.VerbosityLevelDebug)
+
+		return ""
+	}
+	if len(bindings.Unbound) == 0 {
+		return ""
+	}
+
+	cards := make([]string, 0, len(bindings.Unbound))
+	for card := range bindings.Unbound {
+		cards = append(cards, card)
+	}
+	sort.Strings(cards)
+
+	return "spyre cards not bound to vfio-pci: " + strings.Join(cards, ", ")
+}
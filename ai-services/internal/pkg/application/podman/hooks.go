@@ -0,0 +1,121 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"text/template"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+)
+
+// hookPhase is the point of the deployment a hook runs at.
+type hookPhase string
+
+const (
+	hookPhasePreDeploy  hookPhase = "pre-deploy"
+	hookPhasePostDeploy hookPhase = "post-deploy"
+)
+
+// runHooks runs the given hooks in order, stopping at the first failure.
+// params are the same parameters the pod templates are rendered with.
+func (p *PodmanApplication) runHooks(ctx context.Context, tp templates.Template, templateName string, phase hookPhase, hooks []templates.Hook, params map[string]any) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	logger.Infof("Running %d %s hook(s)\n", len(hooks), phase)
+	for i, hook := range hooks {
+		s := spinner.New(fmt.Sprintf("Running %s hook %d/%d '%s'...", phase, i+1, len(hooks), hook.Name))
+		s.Start(ctx)
+
+		if err := p.runHook(ctx, tp, templateName, hook, params); err != nil {
+			s.Fail(fmt.Sprintf("%s hook '%s' failed", phase, hook.Name))
+
+			return fmt.Errorf("%s hook '%s' failed: %w", phase, hook.Name, err)
+		}
+		s.Stop(fmt.Sprintf("%s hook '%s' completed", phase, hook.Name))
+	}
+
+	return nil
+}
+
+// hookParams returns the parameters the hooks are rendered with, the same ones available to the pod templates.
+func hookParams(tp templates.Template, opts types.CreateOptions, appMetadata *templates.AppMetadata) (map[string]any, error) {
+	values, err := tp.LoadValues(opts.TemplateName, opts.ValuesFiles, opts.ArgParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load params for application: %w", err)
+	}
+
+	return map[string]any{
+		"AppName":         opts.Name,
+		"AppTemplateName": appMetadata.Name,
+		"Version":         appMetadata.Version,
+		"Values":          values,
+		"Facts":           facts.Get(),
+	}, nil
+}
+
+func (p *PodmanApplication) runHook(ctx context.Context, tp templates.Template, templateName string, hook templates.Hook, params map[string]any) error {
+	switch {
+	case len(hook.Command) > 0 && hook.Manifest != "":
+		return errors.New("only one of command or manifest can be set")
+	case len(hook.Command) > 0:
+		return runHookCommand(ctx, hook.Command, params)
+	case hook.Manifest != "":
+		manifest, err := tp.LoadHookManifest(templateName, hook.Manifest, params)
+		if err != nil {
+			return err
+		}
+		if _, err := p.runtime.CreatePod(bytes.NewReader(manifest)); err != nil {
+			return fmt.Errorf("failed to apply manifest '%s': %w", hook.Manifest, err)
+		}
+
+		return nil
+	default:
+		return errors.New("either command or manifest must be set")
+	}
+}
+
+func runHookCommand(ctx context.Context, command []string, params map[string]any) error {
+	args := make([]string, 0, len(command))
+	for _, arg := range command {
+		rendered, err := renderHookArg(arg, params)
+		if err != nil {
+			return err
+		}
+		args = append(args, rendered)
+	}
+
+	logger.Infof("Executing hook command: %s\n", strings.Join(args, " "), logger.VerbosityLevelDebug)
+	out, err := exec.CommandContext(ctx, args[0], args[1:]...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("command '%s' failed: %w, output: %s", args[0], err, strings.TrimSpace(string(out)))
+	}
+	if len(out) > 0 {
+		logger.Infof("%s\n", strings.TrimSpace(string(out)), logger.VerbosityLevelDebug)
+	}
+
+	return nil
+}
+
+func renderHookArg(arg string, params map[string]any) (string, error) {
+	tmpl, err := template.New("hookArg").Option("missingkey=error").Parse(arg)
+	if err != nil {
+		return "", fmt.Errorf("parse hook argument '%s': %w", arg, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, params); err != nil {
+		return "", fmt.Errorf("failed to render hook argument '%s': %w", arg, err)
+	}
+
+	return rendered.String(), nil
+}
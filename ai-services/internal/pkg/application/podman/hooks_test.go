@@ -0,0 +1,48 @@
+package podman
+
+import (
+	"context"
+	"testing"
+)
+
+func TestRenderHookArg(t *testing.T) {
+	params := map[string]any{
+		"AppName": "rag",
+		"Values":  map[string]any{"storage": map[string]any{"size": "10Gi"}},
+	}
+
+	tests := []struct {
+		arg     string
+		want    string
+		wantErr bool
+	}{
+		{arg: "volume", want: "volume"},
+		{arg: "{{ .AppName }}-data", want: "rag-data"},
+		{arg: "--size={{ .Values.storage.size }}", want: "--size=10Gi"},
+		{arg: "{{ .Unknown }}", wantErr: true},
+		{arg: "{{ .AppName", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := renderHookArg(tt.arg, params)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("renderHookArg() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("renderHookArg() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunHookCommand(t *testing.T) {
+	params := map[string]any{"AppName": "rag"}
+
+	if err := runHookCommand(context.Background(), []string{"sh", "-c", `test "$0" = rag`, "{{ .AppName }}"}, params); err != nil {
+		t.Errorf("runHookCommand() unexpected error: %v", err)
+	}
+	if err := runHookCommand(context.Background(), []string{"sh", "-c", "exit 3"}, params); err == nil {
+		t.Error("runHookCommand() expected an error for a failing command")
+	}
+}
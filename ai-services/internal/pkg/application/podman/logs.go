@@ -5,6 +5,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
 // Logs displays logs from an application pod.
@@ -13,7 +14,7 @@ func (p *PodmanApplication) Logs(opts types.LogsOptions) error {
 	logger.Infof("Fetching logs for application pod: %s", opts.PodName)
 
 	if opts.ContainerNameOrID == "" {
-		if err := p.runtime.PodLogs(opts.PodName); err != nil {
+		if err := p.runtime.PodLogs(opts.PodName, runtimeTypes.LogsOptions{Since: opts.Since}); err != nil {
 			return fmt.Errorf("failed to fetch pod: %s logs; err: %w", opts.PodName, err)
 		}
 
@@ -30,7 +31,7 @@ func (p *PodmanApplication) Logs(opts types.LogsOptions) error {
 	}
 
 	logger.Infof("Fetching logs for container: %s", opts.ContainerNameOrID)
-	if err := p.runtime.ContainerLogs(opts.ContainerNameOrID); err != nil {
+	if err := p.runtime.ContainerLogs(opts.ContainerNameOrID, runtimeTypes.LogsOptions{Since: opts.Since}); err != nil {
 		return fmt.Errorf("failed to fetch container: %s logs; err: %w", opts.ContainerNameOrID, err)
 	}
 
@@ -0,0 +1,223 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	apiyaml "k8s.io/apimachinery/pkg/util/yaml"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	// manifestTemplateName is the template label of the pods deployed from a manifest, unless set by the manifest itself.
+	manifestTemplateName = "custom"
+	// manifestVersion is the version label of the pods deployed from a manifest, unless set by the manifest itself.
+	manifestVersion = "0.0.0"
+
+	manifestDecoderBufSz = 4096
+)
+
+// createFromManifest deploys the pods of a hand-crafted manifest, bypassing the template rendering.
+// The pods get the same labels, spyre cards validation and readiness checks as the ones deployed from a template.
+func (p *PodmanApplication) createFromManifest(ctx context.Context, opts types.CreateOptions) error {
+	logger.Infof("Creating application '%s' from manifest '%s'\n", opts.Name, opts.ManifestFile)
+
+	data, err := os.ReadFile(opts.ManifestFile)
+	if err != nil {
+		return fmt.Errorf("failed to read the manifest: %w", err)
+	}
+
+	podSpecs, err := parseManifest(data, opts.Name)
+	if err != nil {
+		return fmt.Errorf("invalid manifest '%s': %w", opts.ManifestFile, err)
+	}
+
+	existingPods, err := helpers.CheckExistingPodsForApplication(p.runtime, opts.Name)
+	if err != nil {
+		return fmt.Errorf("failed while checking existing pods for application: %w", err)
+	}
+
+	pending := make([]*models.PodSpec, 0, len(podSpecs))
+	for _, podSpec := range podSpecs {
+		if slices.Contains(existingPods, podSpec.Name) {
+			logger.Infof("Skipping pod deploy as '%s' it already exists\n", podSpec.Name)
+
+			continue
+		}
+		pending = append(pending, podSpec)
+	}
+
+	if len(pending) == 0 {
+		logger.Infof("Pods for given app: %s are already deployed. Please use 'ai-services application ps %s' to see the pods deployed\n", opts.Name, opts.Name)
+
+		return nil
+	}
+
+	pciAddresses, err := p.allocateManifestSpyreCards(opts.Name, pending)
+	if err != nil {
+		return err
+	}
+
+	s := spinner.New("Deploying application '" + opts.Name + "'...")
+	s.Start(ctx)
+
+	for _, podSpec := range pending {
+		if err := p.deployManifestPod(ctx, podSpec, &pciAddresses, opts.Metadata, opts.DumpManifestsDir); err != nil {
+			s.Fail("failed to deploy application '" + opts.Name + "'")
+
+			return err
+		}
+	}
+
+	s.Stop("Application '" + opts.Name + "' deployed successfully")
+
+	return nil
+}
+
+// parseManifest parses the pods of a multi document manifest, labelled as part of the given application.
+func parseManifest(data []byte, appName string) ([]*models.PodSpec, error) {
+	var podSpecs []*models.PodSpec
+	names := map[string]bool{}
+
+	decoder := apiyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), manifestDecoderBufSz)
+	for i := 1; ; i++ {
+		podSpec := &models.PodSpec{}
+		err := decoder.Decode(podSpec)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: failed to parse: %w", i, err)
+		}
+		// empty documents are skipped
+		if podSpec.Kind == "" && podSpec.Name == "" {
+			continue
+		}
+
+		if err := validateManifestPod(podSpec, names); err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		names[podSpec.Name] = true
+
+		labelManifestPod(podSpec, appName)
+		podSpecs = append(podSpecs, podSpec)
+	}
+
+	if len(podSpecs) == 0 {
+		return nil, errors.New("no pods found")
+	}
+
+	return podSpecs, nil
+}
+
+func validateManifestPod(podSpec *models.PodSpec, names map[string]bool) error {
+	if podSpec.Kind != "Pod" {
+		return fmt.Errorf("kind '%s' is not supported, only Pod is", podSpec.Kind)
+	}
+	if podSpec.Name == "" {
+		return errors.New("pod is missing metadata.name")
+	}
+	if names[podSpec.Name] {
+		return fmt.Errorf("pod '%s' is defined more than once", podSpec.Name)
+	}
+
+	return specs.ValidateSpyreCardAnnotations(*podSpec)
+}
+
+// labelManifestPod sets the labels identifying the pod as part of the application.
+// The application label always matches the application name, the template and version ones are kept when set by the manifest.
+func labelManifestPod(podSpec *models.PodSpec, appName string) {
+	if podSpec.Labels == nil {
+		podSpec.Labels = map[string]string{}
+	}
+
+	if app, ok := podSpec.Labels[constants.ApplicationAnnotationKey]; ok && app != appName {
+		logger.Warningf("Pod '%s': replacing the '%s' label '%s' with '%s'\n", podSpec.Name, constants.ApplicationAnnotationKey, app, appName)
+	}
+	podSpec.Labels[constants.ApplicationAnnotationKey] = appName
+
+	if podSpec.Labels[string(vars.TemplateLabel)] == "" {
+		podSpec.Labels[string(vars.TemplateLabel)] = manifestTemplateName
+	}
+	if podSpec.Labels[string(vars.VersionLabel)] == "" {
+		podSpec.Labels[string(vars.VersionLabel)] = manifestVersion
+	}
+}
+
+func (p *PodmanApplication) allocateManifestSpyreCards(appName string, podSpecs []*models.PodSpec) ([]string, error) {
+	reqSpyreCardsCount := 0
+	for _, podSpec := range podSpecs {
+		plan, err := specs.SpyreAllocationPlan(*podSpec)
+		if err != nil {
+			return nil, fmt.Errorf("pod '%s': %w", podSpec.Name, err)
+		}
+		reqSpyreCardsCount += logSpyreAllocationPlan(podSpec.Name, plan)
+	}
+
+	if reqSpyreCardsCount == 0 {
+		return nil, nil
+	}
+
+	return p.reserveSpyreCards(appName, reqSpyreCardsCount)
+}
+
+func (p *PodmanApplication) deployManifestPod(ctx context.Context, podSpec *models.PodSpec, pciAddresses *[]string, metadata specs.Metadata, dumpDir string) error {
+	podAnnotations := p.fetchPodAnnotations(podSpec)
+
+	env, err := p.returnEnvParamsForPod(podSpec, podAnnotations, pciAddresses)
+	if err != nil {
+		return fmt.Errorf("'%s': Failed to fetch env params: %w", podSpec.Name, err)
+	}
+	injectContainerEnv(podSpec, env)
+
+	body, err := k8syaml.Marshal(podSpec.Pod)
+	if err != nil {
+		return fmt.Errorf("'%s': Failed to marshal pod spec: %w", podSpec.Name, err)
+	}
+	if body, err = metadata.Apply(body); err != nil {
+		return fmt.Errorf("'%s': Failed to set the custom labels and annotations: %w", podSpec.Name, err)
+	}
+
+	if err := common.DumpManifest(dumpDir, podSpec.Name, body); err != nil {
+		return fmt.Errorf("'%s': %w", podSpec.Name, err)
+	}
+
+	if err := p.deployPodAndReadinessCheck(ctx, podSpec, podSpec.Name, bytes.NewReader(body), p.constructPodDeployOptions(podAnnotations)); err != nil {
+		return fmt.Errorf("'%s': Failed to deploy pod and do readiness check: %w", podSpec.Name, err)
+	}
+
+	return nil
+}
+
+// injectContainerEnv sets the env of each container, Eg:- the PCI addresses of the spyre cards allocated to it.
+// The pod templates render it through the "env" parameter instead.
+func injectContainerEnv(podSpec *models.PodSpec, env map[string]map[string]string) {
+	for i := range podSpec.Spec.Containers {
+		container := &podSpec.Spec.Containers[i]
+		for name, value := range env[container.Name] {
+			idx := slices.IndexFunc(container.Env, func(e v1.EnvVar) bool { return e.Name == name })
+			if idx >= 0 {
+				container.Env[idx].Value = value
+
+				continue
+			}
+			container.Env = append(container.Env, v1.EnvVar{Name: name, Value: value})
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package podman
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		name         string
+		manifest     string
+		wantPods     []string
+		wantTemplate string
+		wantErr      string
+	}{
+		{
+			name: "labels the pods of the application",
+			manifest: `apiVersion: v1
+kind: Pod
+metadata:
+  name: chat--vllm
+  labels:
+    ai-services.io/application: other
+spec:
+  containers:
+    - name: vllm
+---
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: chat--ui
+spec:
+  containers:
+    - name: ui
+`,
+			wantPods:     []string{"chat--vllm", "chat--ui"},
+			wantTemplate: manifestTemplateName,
+		},
+		{
+			name: "keeps the template label of the manifest",
+			manifest: `apiVersion: v1
+kind: Pod
+metadata:
+  name: chat--vllm
+  labels:
+    ai-services.io/template: rag
+spec:
+  containers:
+    - name: vllm
+`,
+			wantPods:     []string{"chat--vllm"},
+			wantTemplate: "rag",
+		},
+		{
+			name: "rejects other kinds",
+			manifest: `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: chat
+`,
+			wantErr: "document 1: kind 'Deployment' is not supported",
+		},
+		{
+			name: "rejects duplicated pods",
+			manifest: `kind: Pod
+metadata:
+  name: chat--vllm
+---
+kind: Pod
+metadata:
+  name: chat--vllm
+`,
+			wantErr: "document 2: pod 'chat--vllm' is defined more than once",
+		},
+		{
+			name: "validates the spyre cards annotations",
+			manifest: `kind: Pod
+metadata:
+  name: chat--vllm
+  annotations:
+    ai-services.io/missing--spyre-cards: "1"
+spec:
+  containers:
+    - name: vllm
+`,
+			wantErr: "refers to container(s) not found",
+		},
+		{
+			name:     "rejects empty manifests",
+			manifest: "---\n",
+			wantErr:  "no pods found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			podSpecs, err := parseManifest([]byte(tt.manifest), "chat")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("parseManifest() error = %v, want it to contain %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseManifest() error = %v", err)
+			}
+
+			if len(podSpecs) != len(tt.wantPods) {
+				t.Fatalf("parseManifest() returned %d pods, want %d", len(podSpecs), len(tt.wantPods))
+			}
+			for i, podSpec := range podSpecs {
+				if podSpec.Name != tt.wantPods[i] {
+					t.Errorf("pod %d name = %s, want %s", i, podSpec.Name, tt.wantPods[i])
+				}
+				if app := podSpec.Labels[constants.ApplicationAnnotationKey]; app != "chat" {
+					t.Errorf("pod %s application label = %s, want chat", podSpec.Name, app)
+				}
+				if tmpl := podSpec.Labels[string(vars.TemplateLabel)]; tmpl != tt.wantTemplate {
+					t.Errorf("pod %s template label = %s, want %s", podSpec.Name, tmpl, tt.wantTemplate)
+				}
+				if version := podSpec.Labels[string(vars.VersionLabel)]; version != manifestVersion {
+					t.Errorf("pod %s version label = %s, want %s", podSpec.Name, version, manifestVersion)
+				}
+			}
+		})
+	}
+}
+
+func TestInjectContainerEnv(t *testing.T) {
+	podSpec := &models.PodSpec{}
+	podSpec.Spec.Containers = []v1.Container{
+		{Name: "vllm", Env: []v1.EnvVar{{Name: string(constants.PCIAddressKey), Value: "stale"}, {Name: "PORT", Value: "8000"}}},
+		{Name: "ui"},
+	}
+
+	injectContainerEnv(podSpec, map[string]map[string]string{
+		"vllm": {string(constants.PCIAddressKey): "0381:50:00.0"},
+		"ui":   {},
+	})
+
+	vllmEnv := podSpec.Spec.Containers[0].Env
+	if len(vllmEnv) != 2 || vllmEnv[0].Value != "0381:50:00.0" || vllmEnv[1].Value != "8000" {
+		t.Errorf("vllm env = %v, want the PCI addresses replaced and PORT kept", vllmEnv)
+	}
+	if uiEnv := podSpec.Spec.Containers[1].Env; len(uiEnv) != 0 {
+		t.Errorf("ui env = %v, want none", uiEnv)
+	}
+}
@@ -0,0 +1,121 @@
+package podman
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	// hfCacheDir is where hf download keeps the state of an ongoing download, under the local directory of the model.
+	hfCacheDir = ".cache"
+	// hfIncompleteSuffix is the suffix of the files hf download has not completed yet.
+	hfIncompleteSuffix = ".incomplete"
+
+	// modelStagingPollInterval is the interval between the checks for the models to be staged.
+	modelStagingPollInterval = 5 * time.Second
+)
+
+// waitForModels waits for the models referenced by the template to be staged into the model directory,
+// so that the pods do not crash-loop on a model still being pulled, Eg:- by the download run before or by another host.
+func (p *PodmanApplication) waitForModels(ctx context.Context, templateName, appName string, timeout time.Duration) error {
+	models, err := helpers.ListModels(templateName, appName)
+	if err != nil {
+		return err
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	defer trace.Start("wait for the models")()
+
+	s := spinner.New("Waiting for the models to be staged...")
+	s.Start(ctx)
+
+	var pending []string
+	err = utils.WaitFor(ctx, modelStagingPollInterval, timeout, func() (bool, error) {
+		pending = pending[:0]
+		for _, model := range models {
+			staged, err := modelStaged(vars.ModelDirectory, model)
+			if err != nil {
+				return false, err
+			}
+			if !staged {
+				pending = append(pending, model)
+			}
+		}
+		if len(pending) > 0 {
+			s.UpdateMessage("Waiting for the models to be staged: " + strings.Join(pending, ", ") + "...")
+		}
+
+		return len(pending) == 0, nil
+	})
+	if err != nil {
+		s.Fail("models are not staged")
+		if len(pending) > 0 {
+			return fmt.Errorf("models %s are not staged in %s after %s: %w", strings.Join(pending, ", "), vars.ModelDirectory, timeout, err)
+		}
+
+		return fmt.Errorf("failed to check the staged models: %w", err)
+	}
+
+	s.Stop("Models are staged")
+	logger.Infof("Models staged in %s: %s\n", vars.ModelDirectory, strings.Join(models, ", "), logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+// modelStaged reports whether the files of the model are present in the model directory, with none of them
+// still being downloaded.
+func modelStaged(modelDir, model string) (bool, error) {
+	dir := filepath.Join(modelDir, model)
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to read the model directory: %w", err)
+	}
+
+	hasFiles := false
+	for _, entry := range entries {
+		if entry.Name() != hfCacheDir {
+			hasFiles = true
+
+			break
+		}
+	}
+	if !hasFiles {
+		return false, nil
+	}
+
+	incomplete := false
+	err = filepath.WalkDir(filepath.Join(dir, hfCacheDir), func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && strings.HasSuffix(d.Name(), hfIncompleteSuffix) {
+			incomplete = true
+
+			return filepath.SkipAll
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf("failed to check the ongoing downloads of the model: %w", err)
+	}
+
+	return !incomplete, nil
+}
@@ -0,0 +1,46 @@
+package podman
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestModelStaged(t *testing.T) {
+	const model = "ibm-granite/granite-3.3-8b-instruct"
+
+	tests := []struct {
+		name  string
+		files []string
+		want  bool
+	}{
+		{name: "not downloaded"},
+		{name: "only the download state", files: []string{".cache/huggingface/download/config.json.metadata"}},
+		{name: "download ongoing", files: []string{"config.json", ".cache/huggingface/download/model.safetensors.incomplete"}},
+		{name: "downloaded", files: []string{"config.json", "model.safetensors", ".cache/huggingface/download/config.json.metadata"}, want: true},
+		{name: "copied without the download state", files: []string{"config.json"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			modelDir := t.TempDir()
+			for _, f := range tt.files {
+				path := filepath.Join(modelDir, model, f)
+				if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+					t.Fatal(err)
+				}
+				if err := os.WriteFile(path, nil, 0o600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := modelStaged(modelDir, model)
+			if err != nil {
+				t.Fatalf("modelStaged() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("modelStaged() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
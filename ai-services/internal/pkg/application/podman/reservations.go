@@ -0,0 +1,97 @@
+package podman
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/spyre"
+)
+
+// reserveSpyreCards allocates count spyre cards to the application, out of the free cards of the host
+// which are not reserved by another application, and records them in the reservations of the host.
+func (p *PodmanApplication) reserveSpyreCards(appName string, count int) ([]string, error) {
+	pciAddresses, err := helpers.FindFreeSpyreCards()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find free Spyre Cards: %w", err)
+	}
+
+	reservations, err := p.loadSpyreReservations()
+	if err != nil {
+		return nil, err
+	}
+
+	free := reservations.Free(pciAddresses, appName)
+	if reserved := len(pciAddresses) - len(free); reserved > 0 {
+		logger.Infof("%d free spyre card(s) are reserved by other applications\n", reserved, logger.VerbosityLevelDebug)
+	}
+
+	// validate spyre card requirements
+	if err := p.validateSpyreCardRequirements(count, len(free)); err != nil {
+		return nil, err
+	}
+
+	allocated := free[:count]
+	reservations.Reserve(appName, allocated)
+	if err := reservations.Save(spyre.DefaultReservationsPath); err != nil {
+		return nil, err
+	}
+	logger.Infof("Reserved spyre cards for application '%s': %s\n", appName, strings.Join(reservations[appName], ", "), logger.VerbosityLevelDebug)
+
+	return allocated, nil
+}
+
+// releaseSpyreCards drops the reservations of the spyre cards of the deleted application.
+func (p *PodmanApplication) releaseSpyreCards(appName string) error {
+	reservations, err := spyre.LoadReservations(spyre.DefaultReservationsPath)
+	if err != nil {
+		return err
+	}
+	if _, ok := reservations[appName]; !ok {
+		return nil
+	}
+
+	reservations.Release(appName)
+
+	return reservations.Save(spyre.DefaultReservationsPath)
+}
+
+// loadSpyreReservations loads the reservations of the spyre cards, without the ones of the applications
+// which are no longer deployed.
+func (p *PodmanApplication) loadSpyreReservations() (spyre.Reservations, error) {
+	reservations, err := spyre.LoadReservations(spyre.DefaultReservationsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	deployed, err := p.deployedApplications()
+	if err != nil {
+		return nil, err
+	}
+	if pruned := reservations.Prune(deployed); len(pruned) > 0 {
+		logger.Infof("Releasing the spyre cards reserved by the applications no longer deployed: %s\n", strings.Join(pruned, ", "), logger.VerbosityLevelDebug)
+	}
+
+	return reservations, nil
+}
+
+// deployedApplications returns the names of the applications with pods on the host.
+func (p *PodmanApplication) deployedApplications() ([]string, error) {
+	pods, err := common.FetchFilteredPods(p.runtime, "")
+	if err != nil {
+		return nil, err
+	}
+
+	apps := []string{}
+	for _, pod := range pods {
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" && !slices.Contains(apps, app) {
+			apps = append(apps, app)
+		}
+	}
+
+	return apps, nil
+}
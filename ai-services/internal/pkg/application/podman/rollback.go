@@ -0,0 +1,15 @@
+package podman
+
+import (
+	"context"
+	"errors"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/types"
+)
+
+// Rollback is not supported, since the pods of a deployed application are never upgraded in place:
+// application create skips the pods that already exist, so no earlier version is ever recorded.
+func (p *PodmanApplication) Rollback(ctx context.Context, opts types.RollbackOptions) error {
+	return errors.New("rollback is not supported for podman runtime, delete the application and create it again " +
+		"with --template-version to deploy an earlier version")
+}
@@ -7,6 +7,7 @@ import (
 	appTypes "github.com/project-ai-services/ai-services/internal/pkg/application/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
@@ -39,9 +40,7 @@ func (p *PodmanApplication) Start(opts appTypes.StartOptions) error {
 
 // Start implementation helper methods.
 func (p *PodmanApplication) fetchPodsFromRuntime(appName string) ([]types.Pod, error) {
-	pods, err := p.runtime.ListPods(map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
-	})
+	pods, err := p.runtime.ListPods(selector.ForApplication(appName).Filters())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -142,7 +141,7 @@ func (p *PodmanApplication) startPods(podsToStart []types.Pod) error {
 func (p *PodmanApplication) printPodLogs(podsToStart []types.Pod) error {
 	logger.Infof("\n--- Following logs for pod: %s ---\n", podsToStart[0].Name)
 
-	if err := p.runtime.PodLogs(podsToStart[0].Name); err != nil {
+	if err := p.runtime.PodLogs(podsToStart[0].Name, types.LogsOptions{}); err != nil {
 		if strings.Contains(err.Error(), "signal: interrupt") || strings.Contains(err.Error(), "context canceled") {
 			logger.Infoln("Log following stopped.")
 
@@ -1,9 +1,11 @@
 package types
 
 import (
+	"io"
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/image"
+	"github.com/project-ai-services/ai-services/internal/pkg/specs"
 )
 
 // CreateOptions contains parameters for creating an application.
@@ -13,6 +15,10 @@ type CreateOptions struct {
 	TemplateName string
 	SkipChecks   []string
 	ArgParams    map[string]string
+	// Metadata are the custom labels and annotations set on the deployed resources.
+	Metadata specs.Metadata
+	// DumpManifestsDir is the directory the final manifests are written to right before they are applied, none when empty.
+	DumpManifestsDir string
 
 	// Podman
 	SkipModelDownload bool
@@ -21,9 +27,17 @@ type CreateOptions struct {
 	Values            map[string]any
 	ImagePullPolicy   image.ImagePullPolicy
 	AutoYes           bool
+	// ManifestFile is the path of a hand-crafted manifest deployed in place of a template.
+	ManifestFile string
+	// WaitForModel is how long to wait for the referenced models to be staged before deploying the pods, 0 does not wait.
+	WaitForModel time.Duration
 
 	// Openshift
 	Timeout time.Duration
+	// ApplyTimeout bounds each request applying the resources, apart from the readiness wait bounded by Timeout, 0 does not bound them.
+	ApplyTimeout time.Duration
+	// DryRun validates the resources against the cluster through a server side dry-run, without persisting them.
+	DryRun bool
 }
 
 // DeleteOptions contains parameters for deleting an application.
@@ -32,6 +46,8 @@ type DeleteOptions struct {
 	PodNames    []string
 	AutoYes     bool
 	SkipCleanup bool
+	// IgnoreNotFound treats the application or its resources already gone as deleted.
+	IgnoreNotFound bool
 
 	// Openshift
 	Timeout time.Duration
@@ -67,6 +83,66 @@ type InfoOptions struct {
 type LogsOptions struct {
 	PodName           string
 	ContainerNameOrID string
+	// Since limits the logs to the lines newer than the duration, all of them when zero.
+	Since time.Duration
+}
+
+// ExecOptions contains parameters for running a command inside an application container.
+type ExecOptions struct {
+	Name string
+	// PodName narrows down the container lookup to a pod, when the container name is shared by several of them.
+	PodName string
+	// ContainerName is the name of the container in the template, optional for an application with a single container.
+	ContainerName string
+	Command       []string
+	Stdin         io.Reader
+	Stdout        io.Writer
+	Stderr        io.Writer
+	TTY           bool
+}
+
+// DiffOptions contains parameters for comparing a deployed application with its template.
+type DiffOptions struct {
+	Name string
+	// TemplateName defaults to the template the application was deployed with.
+	TemplateName string
+	ArgParams    map[string]string
+	ValuesFiles  []string
+}
+
+// RollbackOptions contains parameters for rolling back an application to its previous version.
+type RollbackOptions struct {
+	Name    string
+	AutoYes bool
+
+	// Openshift
+	Timeout time.Duration
+}
+
+// HealthState is the rolled-up health of an application.
+type HealthState string
+
+const (
+	// HealthReady is an application with all its pods ready.
+	HealthReady HealthState = "Ready"
+	// HealthDegraded is an application with only some of its pods ready, or with spyre cards issues.
+	HealthDegraded HealthState = "Degraded"
+	// HealthFailed is an application with none of its pods ready, Eg:- a stopped application.
+	HealthFailed HealthState = "Failed"
+)
+
+// ApplicationHealth represents the rolled-up health of a deployed application.
+type ApplicationHealth struct {
+	Name     string      `json:"name"`
+	Template string      `json:"template"`
+	State    HealthState `json:"state"`
+	// ReadyReplicas is the count of pods of the application which are running with all their containers ready.
+	ReadyReplicas int `json:"readyReplicas"`
+	Replicas      int `json:"replicas"`
+	// Issues explain why the application is not ready, Eg:- the pods which are not ready.
+	Issues []string `json:"issues,omitempty"`
+	// SpyreCards are the PCI addresses of the spyre cards reserved by the application.
+	SpyreCards []string `json:"spyreCards,omitempty"`
 }
 
 // ApplicationInfo represents information about a deployed application.
@@ -0,0 +1,281 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	// DefaultLogPath is the default location of the audit log.
+	DefaultLogPath = "/var/log/ai-services/audit.log"
+
+	// annotationKey marks a cobra command as mutating, so that its runs are audited.
+	annotationKey = "ai-services.io/audit"
+
+	redacted = "<redacted>"
+
+	logDirPerm  = 0o750
+	logFilePerm = 0o600
+)
+
+// defaultLogPath is the audit log which falls back to the one of the user state directory, overridden by the tests.
+var defaultLogPath = DefaultLogPath
+
+// SensitiveKeys are the substrings of flag names and parameter keys whose values must not be recorded.
+var SensitiveKeys = []string{"password", "passwd", "secret", "token", "apikey", "api-key", "api_key", "credential"}
+
+// Entry is a single record of the audit log.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	User      string    `json:"user"`
+	Command   string    `json:"command"`
+	Args      []string  `json:"args"`
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// MarkMutating marks the given command as mutating, so that each of its runs gets recorded in the audit log.
+func MarkMutating(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[annotationKey] = "true"
+}
+
+// IsMutating reports whether the given command was marked as mutating.
+func IsMutating(cmd *cobra.Command) bool {
+	return cmd != nil && cmd.Annotations[annotationKey] == "true"
+}
+
+// Record appends an entry for the run of the given command to the audit log at path.
+// Read-only commands, which are not marked as mutating, are ignored. When the default audit log cannot be written,
+// Eg:- by a non-root user, the entry is quietly appended to the audit log of the user state directory instead.
+func Record(path string, cmd *cobra.Command, args []string, runErr error) error {
+	if !IsMutating(cmd) {
+		return nil
+	}
+
+	entry := Entry{
+		Timestamp: time.Now().UTC(),
+		User:      currentUser(),
+		Command:   cmd.CommandPath(),
+		Args:      redactArgs(cmd, args),
+		Outcome:   "success",
+	}
+	if runErr != nil {
+		entry.Outcome = "failure"
+		entry.Error = runErr.Error()
+	}
+
+	err := appendEntry(path, entry)
+	if err == nil || path != defaultLogPath {
+		return err
+	}
+
+	userPath, userErr := UserLogPath()
+	if userErr == nil {
+		userErr = appendEntry(userPath, entry)
+	}
+	if userErr != nil {
+		return errors.Join(err, userErr)
+	}
+
+	return nil
+}
+
+// UserLogPath returns the audit log of the user state directory, $XDG_STATE_HOME/ai-services/audit.log
+// or else ~/.local/state/ai-services/audit.log.
+func UserLogPath() (string, error) {
+	if dir := os.Getenv("XDG_STATE_HOME"); filepath.IsAbs(dir) {
+		return filepath.Join(dir, "ai-services", "audit.log"), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find the user state directory: %w", err)
+	}
+
+	return filepath.Join(home, ".local", "state", "ai-services", "audit.log"), nil
+}
+
+func appendEntry(path string, entry Entry) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(entry); err != nil {
+		return fmt.Errorf("failed to marshal audit entry: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), logDirPerm); err != nil {
+		return fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+
+	// the audit log is append-only, existing entries are never rewritten
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, logFilePerm)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+
+	return nil
+}
+
+// currentUser returns the user running the command, including the original user when run via sudo.
+func currentUser() string {
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+
+	if sudoUser := os.Getenv("SUDO_USER"); sudoUser != "" && sudoUser != name {
+		return fmt.Sprintf("%s (sudo: %s)", name, sudoUser)
+	}
+
+	return name
+}
+
+// redactArgs returns a copy of the command line arguments with the values of sensitive flags
+// and sensitive key=value parameters redacted.
+func redactArgs(cmd *cobra.Command, args []string) []string {
+	out := make([]string, 0, len(args))
+	redactNext := false
+
+	for _, arg := range args {
+		if redactNext {
+			out = append(out, redacted)
+			redactNext = false
+
+			continue
+		}
+
+		if !strings.HasPrefix(arg, "-") {
+			out = append(out, redactKeyValues(arg))
+
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		prefix := arg[:len(arg)-len(value)]
+		switch {
+		case !hasValue:
+			out = append(out, arg)
+			redactNext = isSensitive(longName(cmd, name)) && expectsValue(cmd, name)
+		case isSensitive(longName(cmd, name)):
+			out = append(out, prefix+redacted)
+		default:
+			out = append(out, prefix+redactKeyValues(value))
+		}
+	}
+
+	return out
+}
+
+// redactKeyValues redacts the values of sensitive keys in comma separated key=value pairs.
+// Eg:- "ui.port=3000,opensearch.password=secret" -> "ui.port=3000,opensearch.password=<redacted>".
+// The JSON value of a single key=value pair, as given to --set-json, is redacted at any depth.
+func redactKeyValues(arg string) string {
+	if !strings.Contains(arg, "=") {
+		return arg
+	}
+	if key, value, _ := strings.Cut(arg, "="); !isSensitive(key) {
+		if v, ok := redactJSON(value); ok {
+			return key + "=" + v
+		}
+	}
+
+	parts := strings.Split(arg, ",")
+	for i, part := range parts {
+		if key, _, ok := strings.Cut(part, "="); ok && isSensitive(key) {
+			parts[i] = key + "=" + redacted
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// redactJSON redacts the values of sensitive keys at any depth of the JSON object or array, re-encoded compactly.
+// Eg:- {"auth":{"password":"secret"}} -> {"auth":{"password":"<redacted>"}}. It reports false for the other values.
+func redactJSON(value string) (string, bool) {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+		return "", false
+	}
+
+	var v any
+	if err := json.Unmarshal([]byte(trimmed), &v); err != nil {
+		return "", false
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(v)); err != nil {
+		return "", false
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), true
+}
+
+// redactValue redacts the values of sensitive keys in the decoded JSON value, descending into objects and arrays.
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(value)
+			}
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = redactValue(value)
+		}
+	}
+
+	return v
+}
+
+// longName returns the name of the flag given by its shorthand, Eg:- "token" for "t", the name itself otherwise.
+func longName(cmd *cobra.Command, name string) string {
+	if len(name) == 1 {
+		if f := cmd.Flags().ShorthandLookup(name); f != nil {
+			return f.Name
+		}
+	}
+
+	return name
+}
+
+// expectsValue reports whether the flag takes a separate value, which is not the case for boolean flags.
+func expectsValue(cmd *cobra.Command, name string) bool {
+	f := cmd.Flags().Lookup(name)
+	if f == nil && len(name) == 1 {
+		f = cmd.Flags().ShorthandLookup(name)
+	}
+
+	return f == nil || f.NoOptDefVal == ""
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range SensitiveKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,180 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newAuditedCmd() *cobra.Command {
+	cmd := &cobra.Command{Use: "create"}
+	cmd.Flags().String("password", "", "")
+	cmd.Flags().String("db-password", "", "")
+	cmd.Flags().StringP("token", "t", "", "")
+	cmd.Flags().Bool("secret-check", false, "")
+	cmd.Flags().StringArray("set", nil, "")
+	cmd.Flags().StringSlice("params", nil, "")
+	cmd.Flags().StringArray("set-json", nil, "")
+	MarkMutating(cmd)
+
+	return cmd
+}
+
+func TestRedactArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{
+			name: "flag=value",
+			args: []string{"--password=s3cr3t", "--runtime=podman"},
+			want: []string{"--password=<redacted>", "--runtime=podman"},
+		},
+		{
+			name: "flag value",
+			args: []string{"--password", "s3cr3t", "rag"},
+			want: []string{"--password", "<redacted>", "rag"},
+		},
+		{
+			name: "shorthand flag value",
+			args: []string{"-t", "abc123", "rag"},
+			want: []string{"-t", "<redacted>", "rag"},
+		},
+		{
+			name: "boolean flag",
+			args: []string{"--secret-check", "rag"},
+			want: []string{"--secret-check", "rag"},
+		},
+		{
+			name: "set key=value",
+			args: []string{"--set", "opensearch.password=s3cr3t", "--set=ui.port=3000"},
+			want: []string{"--set", "opensearch.password=<redacted>", "--set=ui.port=3000"},
+		},
+		{
+			name: "comma separated params",
+			args: []string{"--params", "ui.port=3000,opensearch.auth.password=s3cr3t,api_key=abc"},
+			want: []string{"--params", "ui.port=3000,opensearch.auth.password=<redacted>,api_key=<redacted>"},
+		},
+		{
+			name: "mixed case",
+			args: []string{"--DB-Password", "s3cr3t", "--set", "Opensearch.PASSWORD=s3cr3t", "--Token=abc"},
+			want: []string{"--DB-Password", "<redacted>", "--set", "Opensearch.PASSWORD=<redacted>", "--Token=<redacted>"},
+		},
+		{
+			name: "set-json nested secrets",
+			args: []string{"--set-json", `opensearch={"auth":{"user":"admin","password":"s3cr3t"},"nodes":[{"token":"abc","port":9200}]}`},
+			want: []string{"--set-json", `opensearch={"auth":{"password":"<redacted>","user":"admin"},"nodes":[{"port":9200,"token":"<redacted>"}]}`},
+		},
+		{
+			name: "set-json sensitive key",
+			args: []string{"--set-json=opensearch.password={\"value\":\"s3cr3t\"}", "--set-json", `ui={"port":3000}`},
+			want: []string{"--set-json=opensearch.password=<redacted>", "--set-json", `ui={"port":3000}`},
+		},
+		{
+			name: "unknown sensitive flag",
+			args: []string{"--client-secret", "abc", "rag"},
+			want: []string{"--client-secret", "<redacted>", "rag"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := redactArgs(newAuditedCmd(), tt.args); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("redactArgs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func readEntries(t *testing.T, path string) []Entry {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read the audit log: %v", err)
+	}
+
+	var entries []Entry
+	dec := json.NewDecoder(bytes.NewReader(data))
+	for dec.More() {
+		var entry Entry
+		if err := dec.Decode(&entry); err != nil {
+			t.Fatalf("failed to decode the audit log: %v", err)
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func TestRecord(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	cmd := newAuditedCmd()
+
+	if err := Record(path, cmd, []string{"--password", "s3cr3t"}, nil); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if err := Record(path, cmd, []string{"rag"}, errors.New("failed to create")); err != nil {
+		t.Fatalf("Record() error = %v", err)
+	}
+	if err := Record(path, &cobra.Command{Use: "ps"}, []string{"rag"}, nil); err != nil {
+		t.Fatalf("Record() of a read-only command error = %v", err)
+	}
+
+	entries := readEntries(t, path)
+	if len(entries) != 2 {
+		t.Fatalf("Record() wrote %d entries, want the 2 of the mutating command", len(entries))
+	}
+	if entries[0].Outcome != "success" || !reflect.DeepEqual(entries[0].Args, []string{"--password", redacted}) {
+		t.Errorf("first entry = %+v, want a success with the password redacted", entries[0])
+	}
+	if entries[1].Outcome != "failure" || entries[1].Error != "failed to create" {
+		t.Errorf("second entry = %+v, want the failure", entries[1])
+	}
+}
+
+func TestRecordFallsBackToUserLog(t *testing.T) {
+	// the default audit log cannot be created, its directory being a file
+	blocker := filepath.Join(t.TempDir(), "log")
+	if err := os.WriteFile(blocker, nil, logFilePerm); err != nil {
+		t.Fatal(err)
+	}
+	orig := defaultLogPath
+	defaultLogPath = filepath.Join(blocker, "ai-services", "audit.log")
+	t.Cleanup(func() { defaultLogPath = orig })
+
+	stateDir := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateDir)
+
+	if err := Record(defaultLogPath, newAuditedCmd(), []string{"rag"}, nil); err != nil {
+		t.Fatalf("Record() error = %v, want the entry written to the user audit log", err)
+	}
+	if entries := readEntries(t, filepath.Join(stateDir, "ai-services", "audit.log")); len(entries) != 1 {
+		t.Errorf("user audit log has %d entries, want 1", len(entries))
+	}
+
+	// an audit log given explicitly does not fall back
+	if err := Record(filepath.Join(blocker, "custom.log"), newAuditedCmd(), []string{"rag"}, nil); err == nil {
+		t.Errorf("Record() to an unwritable custom audit log expected an error")
+	}
+}
+
+func TestUserLogPath(t *testing.T) {
+	t.Setenv("XDG_STATE_HOME", "/run/state")
+	if got, err := UserLogPath(); err != nil || got != "/run/state/ai-services/audit.log" {
+		t.Errorf("UserLogPath() = %s, %v, want the one of XDG_STATE_HOME", got, err)
+	}
+
+	t.Setenv("XDG_STATE_HOME", "relative")
+	t.Setenv("HOME", "/home/user")
+	if got, err := UserLogPath(); err != nil || got != "/home/user/.local/state/ai-services/audit.log" {
+		t.Errorf("UserLogPath() = %s, %v, want the one of the home directory", got, err)
+	}
+}
@@ -0,0 +1,216 @@
+package affinity
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+const (
+	nodesGlob  = "/sys/devices/system/node/node[0-9]*"
+	nodePrefix = "node"
+
+	percent      = 100
+	rangeParts   = 2
+	meminfoParts = 4
+)
+
+// NodePlacement holds the resources of the LPAR placed on a single NUMA node.
+type NodePlacement struct {
+	Node        int   `json:"node"`
+	CPUs        []int `json:"cpus"`
+	MemoryKB    int64 `json:"memoryKB"`
+	HomeNode    bool  `json:"homeNode"`
+	CPUShare    int   `json:"cpuShare"`
+	MemoryShare int   `json:"memoryShare"`
+}
+
+// AffinityDetail is the breakdown of the LPAR affinity across its NUMA nodes.
+type AffinityDetail struct {
+	// HomeNode is the NUMA node holding most of the CPUs of the LPAR, the other nodes are remote.
+	HomeNode int `json:"homeNode"`
+	// CPUPercent and MemoryPercent are the share of CPUs and memory placed on the home node.
+	CPUPercent    int             `json:"cpuPercent"`
+	MemoryPercent int             `json:"memoryPercent"`
+	Nodes         []NodePlacement `json:"nodes"`
+}
+
+// RemoteNodes returns the NUMA nodes, other than the home node, holding any CPUs or memory of the LPAR.
+func (d AffinityDetail) RemoteNodes() []NodePlacement {
+	remote := []NodePlacement{}
+	for _, n := range d.Nodes {
+		if !n.HomeNode {
+			remote = append(remote, n)
+		}
+	}
+
+	return remote
+}
+
+// String renders the remote resources of the LPAR. Eg:- "node1: 8 CPU(s) (50%), 16384000 kB memory (50%)".
+func (d AffinityDetail) String() string {
+	remote := d.RemoteNodes()
+	if len(remote) == 0 {
+		return fmt.Sprintf("all CPUs and memory are placed on NUMA node %d", d.HomeNode)
+	}
+
+	parts := make([]string, 0, len(remote))
+	for _, n := range remote {
+		parts = append(parts, fmt.Sprintf("node%d: %d CPU(s) (%d%%), %d kB memory (%d%%)", n.Node, len(n.CPUs), n.CPUShare, n.MemoryKB, n.MemoryShare))
+	}
+
+	return fmt.Sprintf("home NUMA node %d, remote resources: %s", d.HomeNode, strings.Join(parts, "; "))
+}
+
+// ComputeLparAffinity computes the affinity of the LPAR from the NUMA placement of its CPUs and memory.
+// The affinity is the share of resources placed on the home node, the lower of the CPU and memory shares.
+func ComputeLparAffinity() (int, AffinityDetail, error) {
+	return ComputeLparAffinityFS(hostfs.OS)
+}
+
+// ComputeLparAffinityFS is ComputeLparAffinity reading the NUMA placement from the given host filesystem.
+func ComputeLparAffinityFS(fsys hostfs.FS) (int, AffinityDetail, error) {
+	nodes, err := readNodes(fsys)
+	if err != nil {
+		return 0, AffinityDetail{}, err
+	}
+
+	var totalCPUs int
+	var totalMemory int64
+	home := 0
+	for i, n := range nodes {
+		totalCPUs += len(n.CPUs)
+		totalMemory += n.MemoryKB
+		if len(n.CPUs) > len(nodes[home].CPUs) || (len(n.CPUs) == len(nodes[home].CPUs) && n.MemoryKB > nodes[home].MemoryKB) {
+			home = i
+		}
+	}
+	if totalCPUs == 0 {
+		return 0, AffinityDetail{}, errors.New("no CPUs found on any NUMA node")
+	}
+
+	for i := range nodes {
+		nodes[i].HomeNode = i == home
+		nodes[i].CPUShare = share(int64(len(nodes[i].CPUs)), int64(totalCPUs))
+		nodes[i].MemoryShare = share(nodes[i].MemoryKB, totalMemory)
+	}
+
+	detail := AffinityDetail{
+		HomeNode:      nodes[home].Node,
+		CPUPercent:    nodes[home].CPUShare,
+		MemoryPercent: nodes[home].MemoryShare,
+		Nodes:         nodes,
+	}
+
+	return min(detail.CPUPercent, detail.MemoryPercent), detail, nil
+}
+
+// share returns part as a percentage of total, a total of zero means nothing is remote.
+func share(part, total int64) int {
+	if total == 0 {
+		return percent
+	}
+
+	return int(part * percent / total)
+}
+
+// readNodes reads the CPUs and memory of each NUMA node, skipping the nodes with neither of them.
+func readNodes(fsys hostfs.FS) ([]NodePlacement, error) {
+	dirs, err := fsys.Glob(nodesGlob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list NUMA nodes: %w", err)
+	}
+	if len(dirs) == 0 {
+		return nil, errors.New("no NUMA nodes found under /sys/devices/system/node")
+	}
+
+	nodes := make([]NodePlacement, 0, len(dirs))
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), nodePrefix))
+		if err != nil {
+			continue
+		}
+
+		n, err := readNode(fsys, dir, id)
+		if err != nil {
+			return nil, err
+		}
+		if len(n.CPUs) == 0 && n.MemoryKB == 0 {
+			continue
+		}
+		nodes = append(nodes, n)
+	}
+	if len(nodes) == 0 {
+		return nil, errors.New("no CPUs or memory found on any NUMA node")
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+
+	return nodes, nil
+}
+
+func readNode(fsys hostfs.FS, dir string, id int) (NodePlacement, error) {
+	n := NodePlacement{Node: id, CPUs: []int{}}
+
+	cpulist, err := fsys.ReadFile(filepath.Join(dir, "cpulist"))
+	if err != nil {
+		return n, fmt.Errorf("failed to read CPUs of NUMA node %d: %w", id, err)
+	}
+	if n.CPUs, err = ParseCPUList(string(cpulist)); err != nil {
+		return n, fmt.Errorf("invalid CPU list of NUMA node %d: %w", id, err)
+	}
+
+	meminfo, err := fsys.ReadFile(filepath.Join(dir, "meminfo"))
+	if err != nil {
+		return n, fmt.Errorf("failed to read memory of NUMA node %d: %w", id, err)
+	}
+	if n.MemoryKB, err = parseMemTotal(string(meminfo)); err != nil {
+		return n, fmt.Errorf("invalid memory info of NUMA node %d: %w", id, err)
+	}
+
+	return n, nil
+}
+
+// ParseCPUList parses a sysfs CPU list. Eg:- "0-3,8,10-11" -> [0 1 2 3 8 10 11].
+func ParseCPUList(list string) ([]int, error) {
+	cpus := []int{}
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return cpus, nil
+	}
+
+	for _, part := range strings.Split(list, ",") {
+		bounds := strings.SplitN(part, "-", rangeParts)
+		first, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPU '%s': %w", part, err)
+		}
+		last := first
+		if len(bounds) == rangeParts {
+			if last, err = strconv.Atoi(bounds[1]); err != nil || last < first {
+				return nil, fmt.Errorf("invalid CPU range '%s'", part)
+			}
+		}
+		for cpu := first; cpu <= last; cpu++ {
+			cpus = append(cpus, cpu)
+		}
+	}
+
+	return cpus, nil
+}
+
+// parseMemTotal extracts the MemTotal of a NUMA node meminfo. Eg:- "Node 0 MemTotal:  6147400 kB" -> 6147400.
+func parseMemTotal(meminfo string) (int64, error) {
+	for _, line := range strings.Split(meminfo, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= meminfoParts && fields[2] == "MemTotal:" {
+			return strconv.ParseInt(fields[3], 10, 64)
+		}
+	}
+
+	return 0, errors.New("MemTotal not found")
+}
@@ -0,0 +1,134 @@
+package affinity
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func meminfo(node, kb string) string {
+	return "Node " + node + " MemTotal:       " + kb + " kB\nNode " + node + " MemFree:        1024 kB\n"
+}
+
+func TestComputeLparAffinity(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       hostfs.Fake
+		wantPercent int
+		wantHome    int
+		wantRemote  []int
+		wantErr     bool
+	}{
+		{
+			name: "single node",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "0-15\n",
+				"/sys/devices/system/node/node0/meminfo": meminfo("0", "6147400"),
+			},
+			wantPercent: 100,
+			wantHome:    0,
+			wantRemote:  []int{},
+		},
+		{
+			name: "cpus split across two nodes",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "0-5\n",
+				"/sys/devices/system/node/node0/meminfo": meminfo("0", "8000"),
+				"/sys/devices/system/node/node1/cpulist": "6-7\n",
+				"/sys/devices/system/node/node1/meminfo": meminfo("1", "0"),
+			},
+			wantPercent: 75,
+			wantHome:    0,
+			wantRemote:  []int{1},
+		},
+		{
+			name: "memory remote to the cpus",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "\n",
+				"/sys/devices/system/node/node0/meminfo": meminfo("0", "6000"),
+				"/sys/devices/system/node/node1/cpulist": "0-7\n",
+				"/sys/devices/system/node/node1/meminfo": meminfo("1", "2000"),
+			},
+			wantPercent: 25,
+			wantHome:    1,
+			wantRemote:  []int{0},
+		},
+		{
+			name: "empty nodes are ignored",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "0-7\n",
+				"/sys/devices/system/node/node0/meminfo": meminfo("0", "8000"),
+				"/sys/devices/system/node/node1/cpulist": "\n",
+				"/sys/devices/system/node/node1/meminfo": meminfo("1", "0"),
+			},
+			wantPercent: 100,
+			wantHome:    0,
+			wantRemote:  []int{},
+		},
+		{
+			name:    "no numa nodes",
+			files:   hostfs.Fake{},
+			wantErr: true,
+		},
+		{
+			name: "invalid cpu list",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "7-0\n",
+				"/sys/devices/system/node/node0/meminfo": meminfo("0", "8000"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			percent, detail, err := ComputeLparAffinityFS(tt.files)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ComputeLparAffinityFS() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if percent != tt.wantPercent {
+				t.Errorf("percent = %d, want %d", percent, tt.wantPercent)
+			}
+			if detail.HomeNode != tt.wantHome {
+				t.Errorf("home node = %d, want %d", detail.HomeNode, tt.wantHome)
+			}
+			remote := []int{}
+			for _, n := range detail.RemoteNodes() {
+				remote = append(remote, n.Node)
+			}
+			if !slices.Equal(remote, tt.wantRemote) {
+				t.Errorf("remote nodes = %v, want %v", remote, tt.wantRemote)
+			}
+		})
+	}
+}
+
+func TestParseCPUList(t *testing.T) {
+	tests := []struct {
+		list    string
+		want    []int
+		wantErr bool
+	}{
+		{list: "", want: []int{}},
+		{list: "0", want: []int{0}},
+		{list: "0-3,8,10-11\n", want: []int{0, 1, 2, 3, 8, 10, 11}},
+		{list: "a-b", wantErr: true},
+		{list: "3-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.list, func(t *testing.T) {
+			got, err := ParseCPUList(tt.list)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCPUList() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("ParseCPUList() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package bootstrap
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// DriftKind classifies a difference between a validation run and a baseline report.
+type DriftKind string
+
+const (
+	// DriftNewlyFailing is a check which passed in the baseline and now fails or reports a warning.
+	DriftNewlyFailing DriftKind = "newly failing"
+	// DriftNewlyPassing is a check which failed or reported a warning in the baseline and now passes.
+	DriftNewlyPassing DriftKind = "newly passing"
+	// DriftChanged is any other change of the status of a check, Eg:- from a warning to a failure or to skipped.
+	DriftChanged DriftKind = "changed"
+	// DriftRegressed is a value measured by a check which dropped, Eg:- the LPAR affinity percentage, or a version
+	// found by a check which is older, Eg:- the RHEL release.
+	DriftRegressed DriftKind = "regressed"
+)
+
+// Drift is a difference of a check between a validation run and a baseline report.
+type Drift struct {
+	Kind    DriftKind `json:"kind"`
+	Context string    `json:"context,omitempty"`
+	Check   string    `json:"check"`
+	// Measurement is the name of the regressed value or version, empty for a change of status.
+	Measurement string `json:"measurement,omitempty"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+}
+
+func (d Drift) String() string {
+	name := d.Check
+	if d.Context != "" {
+		name = d.Context + "/" + name
+	}
+	if d.Measurement != "" {
+		name += " " + d.Measurement
+	}
+
+	return fmt.Sprintf("%s: %s -> %s (%s)", name, d.From, d.To, d.Kind)
+}
+
+// ReadReportFile reads a validation report written by WriteReportFile, either as JSON or as YAML.
+func ReadReportFile(path string) (*ValidationReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read validation report %s: %w", path, err)
+	}
+
+	report := &ValidationReport{}
+	if err := yaml.Unmarshal(data, report); err != nil {
+		return nil, fmt.Errorf("failed to parse validation report %s: %w", path, err)
+	}
+
+	return report, nil
+}
+
+// CompareReports returns the drift of the checks of the current report from the ones of the baseline report,
+// ordered by context and check. Only the checks present in both reports are compared, the contexts being
+// matched by name. The measurements are compared as higher is better, Eg:- the number of Spyre cards, and the
+// versions as newer is better, Eg:- the OpenShift version, so that only their drop is a regression.
+func CompareReports(baseline, current *ValidationReport) []Drift {
+	baseChecks := checksByContext(baseline)
+
+	var drifts []Drift
+	for context, checks := range checksByContext(current) {
+		for name, check := range checks {
+			base, ok := baseChecks[context][name]
+			if !ok {
+				continue
+			}
+			drifts = append(drifts, compareChecks(context, base, check)...)
+		}
+	}
+
+	sort.Slice(drifts, func(i, j int) bool {
+		a, b := drifts[i], drifts[j]
+		if a.Context != b.Context {
+			return a.Context < b.Context
+		}
+		if a.Check != b.Check {
+			return a.Check < b.Check
+		}
+
+		return a.Measurement < b.Measurement
+	})
+
+	return drifts
+}
+
+// RenderDrift renders the drift one line per change, Eg:- "numa: passed -> failed (newly failing)".
+func RenderDrift(drifts []Drift) string {
+	var b strings.Builder
+	for _, d := range drifts {
+		fmt.Fprintf(&b, "  - %s\n", d)
+	}
+
+	return b.String()
+}
+
+func compareChecks(context string, base, check CheckResult) []Drift {
+	var drifts []Drift
+	if base.Status != check.Status {
+		drifts = append(drifts, Drift{
+			Kind:    statusDrift(base.Status, check.Status),
+			Context: context,
+			Check:   check.Name,
+			From:    string(base.Status),
+			To:      string(check.Status),
+		})
+	}
+
+	for name, was := range base.Measurements {
+		now, ok := check.Measurements[name]
+		if !ok || now >= was {
+			continue
+		}
+		drifts = append(drifts, Drift{
+			Kind:        DriftRegressed,
+			Context:     context,
+			Check:       check.Name,
+			Measurement: name,
+			From:        strconv.FormatFloat(was, 'f', -1, 64),
+			To:          strconv.FormatFloat(now, 'f', -1, 64),
+		})
+	}
+
+	for name, was := range base.Versions {
+		now, ok := check.Versions[name]
+		if !ok || compareVersions(now, was) >= 0 {
+			continue
+		}
+		drifts = append(drifts, Drift{
+			Kind:        DriftRegressed,
+			Context:     context,
+			Check:       check.Name,
+			Measurement: name,
+			From:        was,
+			To:          now,
+		})
+	}
+
+	return drifts
+}
+
+// compareVersions compares dotted versions number by number, falling back to a plain comparison for the other parts.
+// Eg:- 4.16.3 is newer than 4.9.
+func compareVersions(a, b string) int {
+	as := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bs := strings.Split(strings.TrimPrefix(b, "v"), ".")
+	for i := range min(len(as), len(bs)) {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr != nil || bErr != nil {
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+
+			continue
+		}
+		if an != bn {
+			return an - bn
+		}
+	}
+
+	return len(as) - len(bs)
+}
+
+func statusDrift(from, to CheckStatus) DriftKind {
+	failing := func(s CheckStatus) bool { return s == CheckStatusFailed || s == CheckStatusWarning }
+
+	switch {
+	case from == CheckStatusPassed && failing(to):
+		return DriftNewlyFailing
+	case failing(from) && to == CheckStatusPassed:
+		return DriftNewlyPassing
+	default:
+		return DriftChanged
+	}
+}
+
+// checksByContext indexes the checks of the report by context and name, the checks of a single context report
+// being under the empty context.
+func checksByContext(report *ValidationReport) map[string]map[string]CheckResult {
+	reports := report.Contexts
+	if len(reports) == 0 {
+		reports = []*ValidationReport{report}
+	}
+
+	byContext := make(map[string]map[string]CheckResult, len(reports))
+	for _, r := range reports {
+		checks := make(map[string]CheckResult, len(r.Checks))
+		for _, check := range r.Checks {
+			checks[check.Name] = check
+		}
+		byContext[r.Context] = checks
+	}
+
+	return byContext
+}
@@ -0,0 +1,78 @@
+package bootstrap
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCompareReports(t *testing.T) {
+	baseline := &ValidationReport{Checks: []CheckResult{
+		{Name: "root", Status: CheckStatusPassed},
+		{Name: "numa", Status: CheckStatusPassed, Measurements: map[string]float64{"lpar_affinity_percent": 100}},
+		{Name: "rhn", Status: CheckStatusFailed},
+		{Name: "spyre", Status: CheckStatusWarning, Measurements: map[string]float64{"spyre_cards": 4}},
+		{Name: "power", Status: CheckStatusPassed},
+		{Name: "rhel", Status: CheckStatusPassed, Versions: map[string]string{"rhel": "9.10"}},
+		{Name: "servicereport", Status: CheckStatusPassed, Versions: map[string]string{"servicereport": "2.2.4"}},
+	}}
+	current := &ValidationReport{Checks: []CheckResult{
+		{Name: "root", Status: CheckStatusPassed},
+		{Name: "numa", Status: CheckStatusFailed, Measurements: map[string]float64{"lpar_affinity_percent": 62.5}},
+		{Name: "rhn", Status: CheckStatusPassed},
+		{Name: "spyre", Status: CheckStatusFailed, Measurements: map[string]float64{"spyre_cards": 8}},
+		{Name: "rhaiis", Status: CheckStatusFailed},
+		{Name: "rhel", Status: CheckStatusPassed, Versions: map[string]string{"rhel": "9.6"}},
+		{Name: "servicereport", Status: CheckStatusPassed, Versions: map[string]string{"servicereport": "2.3"}},
+	}}
+
+	got := RenderDrift(CompareReports(baseline, current))
+	want := "  - numa: passed -> failed (newly failing)\n" +
+		"  - numa lpar_affinity_percent: 100 -> 62.5 (regressed)\n" +
+		"  - rhel rhel: 9.10 -> 9.6 (regressed)\n" +
+		"  - rhn: failed -> passed (newly passing)\n" +
+		"  - spyre: warning -> failed (changed)\n"
+	if got != want {
+		t.Errorf("CompareReports() =\n%s\nwant\n%s", got, want)
+	}
+
+	if drifts := CompareReports(baseline, baseline); len(drifts) != 0 {
+		t.Errorf("CompareReports() of the same report = %v, want no drift", drifts)
+	}
+}
+
+func TestCompareReportsContexts(t *testing.T) {
+	baseline := &ValidationReport{Contexts: []*ValidationReport{
+		{Context: "prod", Checks: []CheckResult{{Name: "cluster", Status: CheckStatusPassed}}},
+		{Context: "dev", Checks: []CheckResult{{Name: "cluster", Status: CheckStatusPassed}}},
+	}}
+	current := &ValidationReport{Contexts: []*ValidationReport{
+		{Context: "dev", Checks: []CheckResult{{Name: "cluster", Status: CheckStatusPassed}}},
+		{Context: "prod", Checks: []CheckResult{{Name: "cluster", Status: CheckStatusFailed}}},
+	}}
+
+	drifts := CompareReports(baseline, current)
+	if len(drifts) != 1 || drifts[0].String() != "prod/cluster: passed -> failed (newly failing)" {
+		t.Errorf("CompareReports() = %v, want the prod cluster check newly failing", drifts)
+	}
+}
+
+func TestReadReportFile(t *testing.T) {
+	report := &ValidationReport{Runtime: "podman", Checks: []CheckResult{{Name: "numa", Status: CheckStatusWarning}}}
+
+	for _, name := range []string{"report.json", "report.yaml"} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), name)
+			if err := WriteReportFile(path, report); err != nil {
+				t.Fatalf("WriteReportFile() error = %v", err)
+			}
+
+			got, err := ReadReportFile(path)
+			if err != nil {
+				t.Fatalf("ReadReportFile() error = %v", err)
+			}
+			if got.Runtime != "podman" || len(got.Checks) != 1 || got.Checks[0].Status != CheckStatusWarning {
+				t.Errorf("ReadReportFile() = %+v, want the written report", got)
+			}
+		})
+	}
+}
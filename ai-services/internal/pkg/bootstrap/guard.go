@@ -0,0 +1,42 @@
+package bootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// GuardRunningApplications fails when the selected configuration steps include disruptive ones
+// while applications are running, unless forced. No names selects all the steps.
+func GuardRunningApplications(b Bootstrap, names []string, force bool) error {
+	selected, err := steps.Select(b.Steps(), names)
+	if err != nil {
+		return err
+	}
+
+	disruptive := steps.Names(steps.Disruptive(selected))
+	if len(disruptive) == 0 {
+		return nil
+	}
+
+	apps, err := b.RunningApplications()
+	if err != nil {
+		return fmt.Errorf("failed to check for running applications: %w", err)
+	}
+	if len(apps) == 0 {
+		return nil
+	}
+
+	logger.Warningf("The configuration steps %s may disrupt the running applications:\n  - %s\n",
+		strings.Join(disruptive, ", "), strings.Join(apps, "\n  - "))
+
+	if force {
+		logger.Warningln("Proceeding as requested with --force")
+
+		return nil
+	}
+
+	return fmt.Errorf("%d application(s) are running, stop them first with 'ai-services application stop' or re-run with --force", len(apps))
+}
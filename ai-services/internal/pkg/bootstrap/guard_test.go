@@ -0,0 +1,56 @@
+package bootstrap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+type fakeBootstrap struct {
+	apps         []string
+	configureErr error
+}
+
+func (f *fakeBootstrap) Configure() error                    { return f.configureErr }
+func (f *fakeBootstrap) ConfigureSteps(names []string) error { return nil }
+func (f *fakeBootstrap) RunningApplications() ([]string, error) {
+	return f.apps, nil
+}
+func (f *fakeBootstrap) Type() types.RuntimeType { return types.RuntimeTypePodman }
+func (f *fakeBootstrap) Steps() []steps.Step {
+	return []steps.Step{{Name: "podman"}, {Name: "servicereport", Disruptive: true}}
+}
+
+func TestGuardRunningApplications(t *testing.T) {
+	tests := []struct {
+		name    string
+		apps    []string
+		names   []string
+		force   bool
+		wantErr string
+	}{
+		{name: "no running applications"},
+		{name: "only non disruptive steps", apps: []string{"rag"}, names: []string{"podman"}},
+		{name: "disruptive step with running applications", apps: []string{"chat", "rag"}, wantErr: "2 application(s) are running"},
+		{name: "forced", apps: []string{"rag"}, force: true},
+		{name: "unknown step", names: []string{"network"}, wantErr: "unknown step 'network'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := GuardRunningApplications(&fakeBootstrap{apps: tt.apps}, tt.names, tt.force)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("GuardRunningApplications() unexpected error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("GuardRunningApplications() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package bootstrap
 
-import "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+import (
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
 
 // Bootstrap defines the interface for environment bootstrapping operations.
 // Different runtimes implement this interface to provide
@@ -10,6 +13,15 @@ type Bootstrap interface {
 	// This includes installing dependencies, configuring runtime, and setting up hardware.
 	Configure() error
 
+	// ConfigureSteps runs only the configuration steps with the given names, in the order Configure runs them.
+	ConfigureSteps(names []string) error
+
+	// Steps returns all the configuration steps, in the order Configure runs them.
+	Steps() []steps.Step
+
+	// RunningApplications returns the names of the AI Services applications with running pods.
+	RunningApplications() ([]string, error)
+
 	// Type returns the runtime type this bootstrap implementation supports.
 	Type() types.RuntimeType
 }
@@ -0,0 +1,143 @@
+package bootstrap
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// metricPrefix namespaces the metrics of the validation, as per the Prometheus naming conventions.
+const metricPrefix = "ai_services_"
+
+// metric is a gauge of the textfile, along with its samples.
+type metric struct {
+	name    string
+	help    string
+	samples []string
+}
+
+func (m *metric) add(labels map[string]string, value float64) {
+	m.samples = append(m.samples, m.name+formatLabels(labels)+" "+strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// WriteMetricsFile writes the validation report to path in the Prometheus textfile format,
+// for the textfile collector of node_exporter. The file is replaced at once, so that the collector never reads it half written.
+func WriteMetricsFile(path string, report *ValidationReport) error {
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := os.WriteFile(tmp, []byte(RenderMetrics(report)), reportFilePerm); err != nil {
+		return fmt.Errorf("failed to write validation metrics to %s: %w", path, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write validation metrics to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// RenderMetrics renders the validation report as gauges in the Prometheus text exposition format:
+//   - ai_services_validation_passed: 1 when the validation passed, 0 otherwise
+//   - ai_services_validation_timestamp_seconds: the time the validation started at
+//   - ai_services_check{name="<check>"}: 1 when the check passed, 0 when it failed or reported a warning, skipped checks are left out
+//   - ai_services_<measurement>: each value measured by the checks, Eg:- ai_services_lpar_affinity_percent
+//
+// The samples of a report validating several kubeconfig contexts are labelled with their context.
+func RenderMetrics(report *ValidationReport) string {
+	passed := &metric{name: metricPrefix + "validation_passed", help: "Whether the bootstrap validation passed (1) or failed (0)."}
+	timestamp := &metric{name: metricPrefix + "validation_timestamp_seconds", help: "Unix time the bootstrap validation started at."}
+	checks := &metric{name: metricPrefix + "check", help: "Outcome of the validation check, 1 when it passed, 0 when it failed or reported a warning."}
+	measurements := map[string]*metric{}
+
+	reports := report.Contexts
+	if len(reports) == 0 {
+		reports = []*ValidationReport{report}
+	}
+	for _, r := range reports {
+		labels := map[string]string{}
+		if r.Context != "" {
+			labels["context"] = r.Context
+		}
+
+		passed.add(labels, boolValue(r.Passed))
+		timestamp.add(labels, float64(r.StartedAt.Unix()))
+		for _, check := range r.Checks {
+			if check.Status == CheckStatusSkipped {
+				continue
+			}
+			checks.add(withLabel(labels, "name", check.Name), boolValue(check.Status == CheckStatusPassed))
+
+			for name, value := range check.Measurements {
+				m, ok := measurements[name]
+				if !ok {
+					m = &metric{name: metricPrefix + name, help: fmt.Sprintf("Measured by the %s validation check.", check.Name)}
+					measurements[name] = m
+				}
+				m.add(labels, value)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(measurements))
+	for name := range measurements {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	all := []*metric{passed, timestamp, checks}
+	for _, name := range names {
+		all = append(all, measurements[name])
+	}
+
+	var b strings.Builder
+	for _, m := range all {
+		if len(m.samples) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "# HELP %s %s\n# TYPE %s gauge\n", m.name, m.help, m.name)
+		for _, sample := range m.samples {
+			b.WriteString(sample + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+func boolValue(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// withLabel returns a copy of labels with the given label added.
+func withLabel(labels map[string]string, name, value string) map[string]string {
+	out := make(map[string]string, len(labels)+1)
+	maps.Copy(out, labels)
+	out[name] = value
+
+	return out
+}
+
+// formatLabels renders the labels sorted by name, Eg:- {context="ctx1",name="root"}, nothing when there are none.
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	escaper := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf(`%s="%s"`, name, escaper.Replace(labels[name])))
+	}
+
+	return "{" + strings.Join(parts, ",") + "}"
+}
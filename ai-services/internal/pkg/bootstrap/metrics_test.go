@@ -0,0 +1,79 @@
+package bootstrap
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRenderMetrics(t *testing.T) {
+	report := &ValidationReport{
+		Passed:    false,
+		StartedAt: time.Unix(1700000000, 0),
+		Checks: []CheckResult{
+			{Name: "root", Status: CheckStatusPassed},
+			{Name: "numa", Status: CheckStatusWarning, Measurements: map[string]float64{"lpar_affinity_percent": 87}},
+			{Name: "spyre", Status: CheckStatusFailed, Measurements: map[string]float64{"spyre_cards": 0}},
+			{Name: "rhn", Status: CheckStatusSkipped},
+		},
+	}
+
+	want := `# HELP ai_services_validation_passed Whether the bootstrap validation passed (1) or failed (0).
+# TYPE ai_services_validation_passed gauge
+ai_services_validation_passed 0
+# HELP ai_services_validation_timestamp_seconds Unix time the bootstrap validation started at.
+# TYPE ai_services_validation_timestamp_seconds gauge
+ai_services_validation_timestamp_seconds 1700000000
+# HELP ai_services_check Outcome of the validation check, 1 when it passed, 0 when it failed or reported a warning.
+# TYPE ai_services_check gauge
+ai_services_check{name="root"} 1
+ai_services_check{name="numa"} 0
+ai_services_check{name="spyre"} 0
+# HELP ai_services_lpar_affinity_percent Measured by the numa validation check.
+# TYPE ai_services_lpar_affinity_percent gauge
+ai_services_lpar_affinity_percent 87
+# HELP ai_services_spyre_cards Measured by the spyre validation check.
+# TYPE ai_services_spyre_cards gauge
+ai_services_spyre_cards 0
+`
+	if got := RenderMetrics(report); got != want {
+		t.Errorf("RenderMetrics() =\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderMetricsContexts(t *testing.T) {
+	report := &ValidationReport{
+		Contexts: []*ValidationReport{
+			{Context: "ctx1", Passed: true, Checks: []CheckResult{{Name: "ocp", Status: CheckStatusPassed}}},
+			{Context: `c"2`, Checks: []CheckResult{{Name: "ocp", Status: CheckStatusFailed}}},
+		},
+	}
+
+	want := `# HELP ai_services_validation_passed Whether the bootstrap validation passed (1) or failed (0).
+# TYPE ai_services_validation_passed gauge
+ai_services_validation_passed{context="ctx1"} 1
+ai_services_validation_passed{context="c\"2"} 0
+`
+	got := RenderMetrics(report)
+	if len(got) < len(want) || got[:len(want)] != want {
+		t.Errorf("RenderMetrics() =\n%s\nwant the prefix:\n%s", got, want)
+	}
+}
+
+func TestWriteMetricsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ai_services.prom")
+	report := &ValidationReport{Passed: true, Checks: []CheckResult{{Name: "root", Status: CheckStatusPassed}}}
+
+	if err := WriteMetricsFile(path, report); err != nil {
+		t.Fatalf("WriteMetricsFile() error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read the metrics file: %v", err)
+	}
+	if string(data) != RenderMetrics(report) {
+		t.Errorf("metrics file = %q, want %q", data, RenderMetrics(report))
+	}
+}
@@ -1,24 +1,26 @@
 package openshift
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/project-ai-services/ai-services/assets"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	k8stypes "k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -27,29 +29,65 @@ const (
 	experimentalMode          = "experimentalMode"
 )
 
+// Configure performs the complete configuration of the OpenShift cluster.
 func (o *OpenshiftBootstrap) Configure() error {
+	return o.ConfigureSteps(nil)
+}
+
+// ConfigureSteps runs only the given configuration steps of the OpenShift cluster.
+func (o *OpenshiftBootstrap) ConfigureSteps(names []string) error {
+	selected, err := steps.Select(o.Steps(), names)
+	if err != nil {
+		return err
+	}
+
 	logger.Infoln("Configuring OpenShift cluster")
 	client, err := openshift.NewOpenshiftClient()
 	if err != nil {
 		return fmt.Errorf("failed to configure openshift cluster: %w", err)
 	}
+	o.client = client
+
+	if err := steps.Run(selected); err != nil {
+		return err
+	}
+
+	logger.Infoln("Cluster configured successfully")
+
+	return nil
+}
+
+// Steps returns the configuration steps of the OpenShift cluster.
+func (o *OpenshiftBootstrap) Steps() []steps.Step {
+	return []steps.Step{
+		// the machine config operator drains and reboots the nodes to roll out a changed configuration
+		{Name: "machine-config", Description: "Applies the machine configurations", Disruptive: true, Run: o.applyMachineConfig},
+		{Name: "operators", Description: "Installs the required operators and waits for them to be ready", Run: o.installOperators},
+		{Name: "operands", Description: "Configures the SpyreClusterPolicy and applies the operands of the operators", Run: o.applyOperands},
+	}
+}
 
+func (o *OpenshiftBootstrap) applyMachineConfig() error {
 	// 1. Apply machine-config
 	s := spinner.New("Applying the configurations")
-	s.Start(client.Ctx)
+	s.Start(o.client.Ctx)
 
-	if err := applyYamlsFromFolder(client, "01-machine-config"); err != nil {
+	if err := applyYamlsFromFolder(o.client, "01-machine-config"); err != nil {
 		s.Fail("failed to apply the configurations")
 
 		return fmt.Errorf("error occurred while applying the configurations: %w", err)
 	}
 	s.Stop("Configurations applied successfully")
 
+	return nil
+}
+
+func (o *OpenshiftBootstrap) installOperators() error {
 	// 2. Apply operators (namespaces, operatorgroups, subscriptions)
-	s = spinner.New("Applying operator configurations")
-	s.Start(client.Ctx)
+	s := spinner.New("Applying operator configurations")
+	s.Start(o.client.Ctx)
 
-	if err := applyYamlsFromFolder(client, "02-operators"); err != nil {
+	if err := applyYamlsFromFolder(o.client, "02-operators"); err != nil {
 		s.Fail("failed to apply operator configurations")
 
 		return fmt.Errorf("error occurred while applying operator configurations: %w", err)
@@ -57,21 +95,26 @@ func (o *OpenshiftBootstrap) Configure() error {
 	s.Stop("Operator configurations applied successfully")
 
 	// 3. Wait for all operators to be ready
-	if err := waitForAllOperators(client); err != nil {
+	return waitForAllOperators(o.client)
+}
+
+func (o *OpenshiftBootstrap) applyOperands() error {
+	// the operands cannot be reconciled without their operators, avoid applying only part of them
+	if err := ensureOperatorsReady(o.client); err != nil {
 		return err
 	}
 
 	// 4. Apply operands (CRs) - Does SpyreClusterPolicy configure + applying operand yamls
-	s = spinner.New("Applying operand configurations")
-	s.Start(client.Ctx)
+	s := spinner.New("Applying operand configurations")
+	s.Start(o.client.Ctx)
 
-	if err := configureSCP(client, s); err != nil {
+	if err := configureSCP(o.client, s); err != nil {
 		s.Fail("failed to configure spyre cluster policy")
 
 		return fmt.Errorf("error occurred while configuring spyre cluster policy: %w", err)
 	}
 
-	if err := applyYamlsFromFolder(client, "03-operands"); err != nil {
+	if err := applyYamlsFromFolder(o.client, "03-operands"); err != nil {
 		s.Fail("failed to apply operand configurations")
 
 		return fmt.Errorf("error occurred while applying operand configurations: %w", err)
@@ -79,21 +122,30 @@ func (o *OpenshiftBootstrap) Configure() error {
 	s.Stop("Operand configurations applied successfully")
 
 	// 5. Wait for all CRs to be ready
-	if err := waitForAllCRs(client); err != nil {
-		return err
-	}
+	return waitForAllCRs(o.client)
+}
 
-	logger.Infoln("Cluster configured successfully")
+// ensureOperatorsReady checks once, without waiting, that all the required operators are ready.
+func ensureOperatorsReady(client *openshift.OpenshiftClient) error {
+	for _, op := range vars.RequiredOperators() {
+		csv, err := fetchOperator(client, op.Name, op.Namespace)
+		if err != nil {
+			return fmt.Errorf("%s is not installed, please run the 'operators' step first: %w", op.Label, err)
+		}
+		if csv.Status.Phase != operatorsv1alpha1.CSVPhaseSucceeded {
+			return fmt.Errorf("%s is not ready (phase: %s), please run the 'operators' step first", op.Label, csv.Status.Phase)
+		}
+	}
 
 	return nil
 }
 
 func waitForAllOperators(client *openshift.OpenshiftClient) error {
-	for _, op := range constants.RequiredOperators {
+	for _, op := range vars.RequiredOperators() {
 		s := spinner.New(fmt.Sprintf("Waiting for %s to be ready", op.Label))
 		s.Start(client.Ctx)
 
-		err := waitForOperator(client, op.Name, op.Namespace)
+		err := waitForOperator(client, op, s)
 		if err != nil {
 			s.Fail(fmt.Sprintf("%s not ready", op.Label))
 
@@ -146,11 +198,14 @@ func waitForAllCRs(client *openshift.OpenshiftClient) error {
 }
 
 func applyYamlsFromFolder(client *openshift.OpenshiftClient, folder string) error {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
 		FS:      &assets.BootstrapFS,
 		Root:    "bootstrap/openshift/" + folder,
 		Runtime: types.RuntimeTypeOpenShift,
 	})
+	if err != nil {
+		return fmt.Errorf("error loading yamls from %s: %w", folder, err)
+	}
 
 	yamls, err := tp.LoadYamls()
 	if err != nil {
@@ -189,7 +244,7 @@ func configureSCP(client *openshift.OpenshiftClient, s *spinner.Spinner) error {
 func fetchSCPSpec(client *openshift.OpenshiftClient) (map[string]any, error) {
 	// Find Spyre operator config
 	var spyreOp constants.OperatorConfig
-	for _, op := range constants.RequiredOperators {
+	for _, op := range vars.RequiredOperators() {
 		if op.Name == "spyre-operator" {
 			spyreOp = op
 
@@ -309,28 +364,9 @@ func fetchOperator(client *openshift.OpenshiftClient, opName string, opNS string
 	return csv, nil
 }
 
-func waitForOperator(client *openshift.OpenshiftClient, opName string, opNS string) error {
-	return wait.PollUntilContextTimeout(client.Ctx, constants.OperatorPollInterval, constants.OperatorPollTimeout, true, func(ctx context.Context) (bool, error) {
-		csv, err := fetchOperator(client, opName, opNS)
-		if err != nil {
-			if apierrors.IsNotFound(err) {
-				// keep waiting until timeout
-				return false, nil
-			}
-
-			return false, err
-		}
-		// found
-		if csv.Status.Phase == operatorsv1alpha1.CSVPhaseSucceeded {
-			return true, nil
-		}
-
-		return false, nil
-	},
-	)
-}
-
 func waitForSpyreClusterPolicy(client *openshift.OpenshiftClient) error {
+	defer trace.Start("wait for SpyreClusterPolicy")()
+
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "spyre.ibm.com",
@@ -338,8 +374,8 @@ func waitForSpyreClusterPolicy(client *openshift.OpenshiftClient) error {
 		Kind:    "SpyreClusterPolicy",
 	})
 
-	return wait.PollUntilContextTimeout(client.Ctx, constants.OperatorPollInterval, constants.OperatorPollTimeout, true, func(ctx context.Context) (bool, error) {
-		if err := client.Client.Get(ctx, k8stypes.NamespacedName{Name: "spyreclusterpolicy"}, obj); err != nil {
+	return utils.WaitFor(client.Ctx, constants.OperatorPollInterval, constants.OperatorPollTimeout, func() (bool, error) {
+		if err := client.Client.Get(client.Ctx, k8stypes.NamespacedName{Name: "spyreclusterpolicy"}, obj); err != nil {
 			if apierrors.IsNotFound(err) {
 				logger.Infof("SpyreClusterPolicy not found yet, waiting...", logger.VerbosityLevelDebug)
 
@@ -368,6 +404,8 @@ func waitForSpyreClusterPolicy(client *openshift.OpenshiftClient) error {
 }
 
 func waitForRHODSResource(client *openshift.OpenshiftClient, kind, name string) error {
+	defer trace.Start("wait for " + kind + " " + name)()
+
 	obj := &unstructured.Unstructured{}
 	obj.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   strings.ToLower(kind) + ".opendatahub.io",
@@ -375,8 +413,8 @@ func waitForRHODSResource(client *openshift.OpenshiftClient, kind, name string)
 		Kind:    kind,
 	})
 
-	return wait.PollUntilContextTimeout(client.Ctx, constants.OperatorPollInterval, constants.OperatorPollTimeout, true, func(ctx context.Context) (bool, error) {
-		if err := client.Client.Get(ctx, k8stypes.NamespacedName{Name: name}, obj); err != nil {
+	return utils.WaitFor(client.Ctx, constants.OperatorPollInterval, constants.OperatorPollTimeout, func() (bool, error) {
+		if err := client.Client.Get(client.Ctx, k8stypes.NamespacedName{Name: name}, obj); err != nil {
 			if apierrors.IsNotFound(err) {
 				logger.Infof("%s not found yet, waiting...", kind, logger.VerbosityLevelDebug)
 
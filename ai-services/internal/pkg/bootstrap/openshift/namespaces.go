@@ -0,0 +1,116 @@
+package openshift
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const metadataNameLabel = "kubernetes.io/metadata.name"
+
+// relocatedNamespaces maps the default namespace of the operators overridden by vars.OperatorNamespaces to their namespace.
+func relocatedNamespaces() map[string]string {
+	relocated := map[string]string{}
+	for _, op := range constants.RequiredOperators {
+		if ns, ok := vars.OperatorNamespaces[op.Name]; ok && ns != op.Namespace {
+			relocated[op.Namespace] = ns
+		}
+	}
+
+	return relocated
+}
+
+// relocate moves the object from the default namespace of an operator to its overridden one,
+// along with the references to that namespace, Eg:- the target namespaces of an OperatorGroup.
+func relocate(object *unstructured.Unstructured, relocated map[string]string) error {
+	if ns, ok := relocated[object.GetNamespace()]; ok {
+		object.SetNamespace(ns)
+	}
+
+	switch object.GetKind() {
+	case "Namespace":
+		if ns, ok := relocated[object.GetName()]; ok {
+			object.SetName(ns)
+			if labels := object.GetLabels(); labels[metadataNameLabel] != "" {
+				labels[metadataNameLabel] = ns
+				object.SetLabels(labels)
+			}
+		}
+	case "OperatorGroup":
+		return relocateField(object, relocated, func(ns string) string { return relocated[ns] }, "spec", "targetNamespaces")
+	case "Certificate":
+		// the service DNS names embed the namespace of the service, Eg:- <service>.<namespace>.svc
+		return relocateField(object, relocated, func(dnsName string) string {
+			service, rest, _ := strings.Cut(dnsName, ".")
+			ns, suffix, _ := strings.Cut(rest, ".")
+			if to, ok := relocated[ns]; ok && strings.HasPrefix(suffix, "svc") {
+				return service + "." + to + "." + suffix
+			}
+
+			return ""
+		}, "spec", "dnsNames")
+	}
+
+	return nil
+}
+
+// relocateField replaces the values of the string slice field of the object mapped to a new value by rewrite,
+// the values it returns an empty string for are kept.
+func relocateField(object *unstructured.Unstructured, relocated map[string]string, rewrite func(string) string, fields ...string) error {
+	if len(relocated) == 0 {
+		return nil
+	}
+
+	values, found, err := unstructured.NestedStringSlice(object.Object, fields...)
+	if err != nil {
+		return fmt.Errorf("invalid %s of %s/%s: %w", strings.Join(fields, "."), object.GetKind(), object.GetName(), err)
+	}
+	if !found {
+		return nil
+	}
+
+	for i, value := range values {
+		if to := rewrite(value); to != "" {
+			values[i] = to
+		}
+	}
+
+	return unstructured.SetNestedStringSlice(object.Object, values, fields...)
+}
+
+// hasOperatorGroup reports whether the namespace already holds an OperatorGroup, as OLM refuses to install
+// the operators of a namespace holding several of them.
+func hasOperatorGroup(c *openshift.OpenshiftClient, namespace string) (bool, error) {
+	groups := &operatorsv1.OperatorGroupList{}
+	if err := c.Client.List(c.Ctx, groups, k8sClient.InNamespace(namespace)); err != nil {
+		return false, fmt.Errorf("failed to list the operator groups of namespace '%s': %w", namespace, err)
+	}
+
+	return len(groups.Items) > 0, nil
+}
+
+// skipOperatorGroup reports whether the OperatorGroup relocated to the overridden namespace of an operator is to be skipped,
+// as the namespace already holds one, Eg:- for an operator installed beforehand in a customized cluster.
+func skipOperatorGroup(c *openshift.OpenshiftClient, object *unstructured.Unstructured, relocated map[string]string) (bool, error) {
+	if object.GetKind() != "OperatorGroup" || !slices.Contains(slices.Collect(maps.Values(relocated)), object.GetNamespace()) {
+		return false, nil
+	}
+
+	exists, err := hasOperatorGroup(c, object.GetNamespace())
+	if err != nil || !exists {
+		return false, err
+	}
+	logger.Infof("Keeping the existing operator group of namespace '%s'\n", object.GetNamespace(), logger.VerbosityLevelDebug)
+
+	return true, nil
+}
@@ -0,0 +1,97 @@
+package openshift
+
+import (
+	"slices"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestRelocatedNamespaces(t *testing.T) {
+	vars.OperatorNamespaces = map[string]string{"nfd": "custom-nfd", "spyre-operator": "spyre-operator"}
+	t.Cleanup(func() { vars.OperatorNamespaces = map[string]string{} })
+
+	got := relocatedNamespaces()
+	if len(got) != 1 || got["openshift-nfd"] != "custom-nfd" {
+		t.Errorf("relocatedNamespaces() = %v, want only openshift-nfd relocated to custom-nfd", got)
+	}
+}
+
+func TestRelocate(t *testing.T) {
+	relocated := map[string]string{"spyre-operator": "ibm-spyre", "openshift-nfd": "custom-nfd"}
+
+	tests := []struct {
+		name   string
+		object map[string]any
+		check  func(t *testing.T, u *unstructured.Unstructured)
+	}{
+		{
+			name: "namespace",
+			object: map[string]any{
+				"kind": "Namespace",
+				"metadata": map[string]any{
+					"name":   "spyre-operator",
+					"labels": map[string]any{metadataNameLabel: "spyre-operator"},
+				},
+			},
+			check: func(t *testing.T, u *unstructured.Unstructured) {
+				if u.GetName() != "ibm-spyre" || u.GetLabels()[metadataNameLabel] != "ibm-spyre" {
+					t.Errorf("relocate() namespace = %s, label = %s, want ibm-spyre", u.GetName(), u.GetLabels()[metadataNameLabel])
+				}
+			},
+		},
+		{
+			name: "operator group",
+			object: map[string]any{
+				"kind":     "OperatorGroup",
+				"metadata": map[string]any{"name": "openshift-nfd", "namespace": "openshift-nfd"},
+				"spec":     map[string]any{"targetNamespaces": []any{"openshift-nfd"}},
+			},
+			check: func(t *testing.T, u *unstructured.Unstructured) {
+				targets, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "targetNamespaces")
+				if u.GetNamespace() != "custom-nfd" || !slices.Equal(targets, []string{"custom-nfd"}) {
+					t.Errorf("relocate() namespace = %s, target namespaces = %v, want custom-nfd", u.GetNamespace(), targets)
+				}
+			},
+		},
+		{
+			name: "certificate",
+			object: map[string]any{
+				"kind":     "Certificate",
+				"metadata": map[string]any{"name": "spyre-webhook-cert", "namespace": "spyre-operator"},
+				"spec":     map[string]any{"dnsNames": []any{"spyre-webhook-validator.spyre-operator.svc", "spyre.example.com"}},
+			},
+			check: func(t *testing.T, u *unstructured.Unstructured) {
+				dnsNames, _, _ := unstructured.NestedStringSlice(u.Object, "spec", "dnsNames")
+				want := []string{"spyre-webhook-validator.ibm-spyre.svc", "spyre.example.com"}
+				if !slices.Equal(dnsNames, want) {
+					t.Errorf("relocate() dns names = %v, want %v", dnsNames, want)
+				}
+			},
+		},
+		{
+			name: "object of another namespace",
+			object: map[string]any{
+				"kind":     "Subscription",
+				"metadata": map[string]any{"name": "servicemeshoperator3", "namespace": "openshift-operators"},
+			},
+			check: func(t *testing.T, u *unstructured.Unstructured) {
+				if u.GetNamespace() != "openshift-operators" {
+					t.Errorf("relocate() namespace = %s, want openshift-operators", u.GetNamespace())
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &unstructured.Unstructured{Object: tt.object}
+			if err := relocate(u, relocated); err != nil {
+				t.Fatalf("relocate() unexpected error: %v", err)
+			}
+			tt.check(t, u)
+		})
+	}
+}
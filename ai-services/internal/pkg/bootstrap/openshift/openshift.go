@@ -1,9 +1,22 @@
 package openshift
 
-import "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
 
 // OpenshiftBootstrap implements Bootstrap interface for Openshift runtime.
-type OpenshiftBootstrap struct{}
+type OpenshiftBootstrap struct {
+	// client is set at the start of the configuration and shared by all the steps.
+	client *openshift.OpenshiftClient
+}
 
 // NewOpenshiftBootstrap creates a new Podman Openshift instance.
 func NewOpenshiftBootstrap() *OpenshiftBootstrap {
@@ -14,3 +27,29 @@ func NewOpenshiftBootstrap() *OpenshiftBootstrap {
 func (o *OpenshiftBootstrap) Type() types.RuntimeType {
 	return types.RuntimeTypeOpenShift
 }
+
+// RunningApplications returns the names of the applications with running pods, in any namespace.
+func (o *OpenshiftBootstrap) RunningApplications() ([]string, error) {
+	client, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	pods := &corev1.PodList{}
+	if err := client.Client.List(client.Ctx, pods, k8sClient.HasLabels{constants.ApplicationAnnotationKey}); err != nil {
+		return nil, fmt.Errorf("failed to list application pods: %w", err)
+	}
+
+	apps := []string{}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" && !slices.Contains(apps, app) {
+			apps = append(apps, app)
+		}
+	}
+	slices.Sort(apps)
+
+	return apps, nil
+}
@@ -0,0 +1,92 @@
+package openshift
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// waitForOperator polls the CSV of the given operator with backoff until it reaches the Succeeded phase,
+// reporting every phase transition. It gives up once vars.OperatorTimeout has elapsed.
+func waitForOperator(client *openshift.OpenshiftClient, op constants.OperatorConfig, s *spinner.Spinner) error {
+	defer trace.Start("wait for operator " + op.Label)()
+
+	var csv *operatorsv1alpha1.ClusterServiceVersion
+	var phase operatorsv1alpha1.ClusterServiceVersionPhase
+
+	err := utils.WaitFor(client.Ctx, constants.OperatorPollInterval, vars.OperatorTimeout, func() (bool, error) {
+		current, err := fetchOperator(client, op.Name, op.Namespace)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// CSV does not exist yet, keep waiting until timeout
+				return false, nil
+			}
+
+			return false, err
+		}
+		csv = current
+
+		if csv.Status.Phase != phase {
+			logPhaseTransition(op, csv.Name, phase, csv.Status.Phase, s)
+			phase = csv.Status.Phase
+		}
+
+		switch csv.Status.Phase {
+		case operatorsv1alpha1.CSVPhaseSucceeded:
+			return true, nil
+		case operatorsv1alpha1.CSVPhaseFailed:
+			return false, errors.New("CSV install failed")
+		default:
+			return false, nil
+		}
+	})
+	if err == nil {
+		return nil
+	}
+
+	if errors.Is(err, utils.ErrWaitTimeout) {
+		err = fmt.Errorf("timed out after %s waiting for the CSV to succeed", vars.OperatorTimeout)
+	}
+	if csv == nil {
+		return fmt.Errorf("%w: no CSV installed for subscription '%s' in namespace '%s'", err, op.Name, op.Namespace)
+	}
+
+	return fmt.Errorf("%w: CSV '%s' is in phase '%s'%s", err, csv.Name, csv.Status.Phase, formatCSVConditions(csv))
+}
+
+func logPhaseTransition(op constants.OperatorConfig, csvName string, from, to operatorsv1alpha1.ClusterServiceVersionPhase, s *spinner.Spinner) {
+	if from == "" {
+		from = "None"
+	}
+	logger.Infof("%s: CSV %s phase %s -> %s\n", op.Label, csvName, from, to)
+	s.UpdateMessage(fmt.Sprintf("Waiting for %s to be ready (phase: %s)", op.Label, to))
+}
+
+// formatCSVConditions renders the status.conditions of a CSV to help diagnose why the install stalled.
+func formatCSVConditions(csv *operatorsv1alpha1.ClusterServiceVersion) string {
+	if len(csv.Status.Conditions) == 0 {
+		if csv.Status.Reason == "" {
+			return ""
+		}
+
+		return fmt.Sprintf(" (reason: %s, message: %s)", csv.Status.Reason, csv.Status.Message)
+	}
+
+	var b strings.Builder
+	b.WriteString("\nCSV status conditions:")
+	for _, c := range csv.Status.Conditions {
+		fmt.Fprintf(&b, "\n  - phase: %s, reason: %s, message: %s", c.Phase, c.Reason, c.Message)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,125 @@
+package openshift
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// newOperatorClient returns a client of a fake cluster holding the given OLM objects.
+func newOperatorClient(t *testing.T, objects ...k8sClient.Object) *openshift.OpenshiftClient {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := operatorsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	return &openshift.OpenshiftClient{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		Ctx:    context.Background(),
+	}
+}
+
+func newSubscription(installedCSV string) *operatorsv1alpha1.Subscription {
+	return &operatorsv1alpha1.Subscription{
+		ObjectMeta: metav1.ObjectMeta{Name: "nfd", Namespace: "openshift-nfd"},
+		Status:     operatorsv1alpha1.SubscriptionStatus{InstalledCSV: installedCSV},
+	}
+}
+
+func newCSV(phase operatorsv1alpha1.ClusterServiceVersionPhase, reason operatorsv1alpha1.ConditionReason) *operatorsv1alpha1.ClusterServiceVersion {
+	return &operatorsv1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "nfd.v4.18.0", Namespace: "openshift-nfd"},
+		Status:     operatorsv1alpha1.ClusterServiceVersionStatus{Phase: phase, Reason: reason, Message: "install strategy " + string(reason)},
+	}
+}
+
+func TestWaitForOperator(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	previous := vars.OperatorTimeout
+	vars.OperatorTimeout = 50 * time.Millisecond
+	t.Cleanup(func() { vars.OperatorTimeout = previous })
+
+	tests := []struct {
+		name    string
+		objects []k8sClient.Object
+		wantErr string
+	}{
+		{
+			name:    "succeeded",
+			objects: []k8sClient.Object{newSubscription("nfd.v4.18.0"), newCSV(operatorsv1alpha1.CSVPhaseSucceeded, "")},
+		},
+		{
+			name:    "install failed",
+			objects: []k8sClient.Object{newSubscription("nfd.v4.18.0"), newCSV(operatorsv1alpha1.CSVPhaseFailed, "InstallCheckFailed")},
+			wantErr: "CSV install failed: CSV 'nfd.v4.18.0' is in phase 'Failed' (reason: InstallCheckFailed, message: install strategy InstallCheckFailed)",
+		},
+		{
+			name:    "timed out installing",
+			objects: []k8sClient.Object{newSubscription("nfd.v4.18.0"), newCSV(operatorsv1alpha1.CSVPhaseInstalling, "InstallWaiting")},
+			wantErr: "timed out after 50ms waiting for the CSV to succeed: CSV 'nfd.v4.18.0' is in phase 'Installing' " +
+				"(reason: InstallWaiting, message: install strategy InstallWaiting)",
+		},
+		{
+			name:    "timed out without CSV",
+			objects: []k8sClient.Object{newSubscription("")},
+			wantErr: "timed out after 50ms waiting for the CSV to succeed: no CSV installed for subscription 'nfd' in namespace 'openshift-nfd'",
+		},
+		{
+			name:    "timed out without subscription",
+			wantErr: "timed out after 50ms waiting for the CSV to succeed: no CSV installed for subscription 'nfd' in namespace 'openshift-nfd'",
+		},
+	}
+
+	op := constants.OperatorConfig{Name: "nfd", Namespace: "openshift-nfd", Label: "Node Feature Discovery"}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := waitForOperator(newOperatorClient(t, tt.objects...), op, spinner.New("Waiting for "+op.Label))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("waitForOperator() unexpected error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("waitForOperator() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLogPhaseTransition(t *testing.T) {
+	var out bytes.Buffer
+	logger.SetOutput(&out)
+	t.Cleanup(func() { logger.SetOutput(os.Stdout) })
+
+	op := constants.OperatorConfig{Name: "nfd", Namespace: "openshift-nfd", Label: "Node Feature Discovery"}
+	s := spinner.New("Waiting for " + op.Label)
+	logPhaseTransition(op, "nfd.v4.18.0", "", operatorsv1alpha1.CSVPhaseInstalling, s)
+	logPhaseTransition(op, "nfd.v4.18.0", operatorsv1alpha1.CSVPhaseInstalling, operatorsv1alpha1.CSVPhaseSucceeded, s)
+
+	// the transitions are reported at the default verbosity
+	want := "Node Feature Discovery: CSV nfd.v4.18.0 phase None -> Installing\n" +
+		"Node Feature Discovery: CSV nfd.v4.18.0 phase Installing -> Succeeded\n"
+	if got := out.String(); got != want {
+		t.Errorf("logPhaseTransition() logged %q, want %q", got, want)
+	}
+}
@@ -32,7 +32,18 @@ func applyYaml(c *openshift.OpenshiftClient, yaml []byte) error {
 		}
 	}
 
+	relocated := relocatedNamespaces()
 	for _, object := range resourceList {
+		if err := relocate(object, relocated); err != nil {
+			return err
+		}
+		skip, err := skipOperatorGroup(c, object, relocated)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
 		if err := applyObject(c, object); err != nil {
 			return fmt.Errorf("error applying object %v", err.Error())
 		}
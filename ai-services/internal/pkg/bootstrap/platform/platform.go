@@ -0,0 +1,79 @@
+package platform
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+const (
+	// ArchPower is the architecture of the IBM Power hosts, the only one the AI Services images are built for.
+	ArchPower = "ppc64le"
+
+	cpuinfoPath  = "/proc/cpuinfo"
+	cpuinfoField = "cpu"
+	power11      = "power11"
+)
+
+// Info is the platform of the host, as detected from the architecture of the binary and /proc/cpuinfo.
+type Info struct {
+	// Arch is the architecture of the host, the one the CLI runs on unless a remote host is validated, Eg:- ppc64le.
+	Arch string
+	// CPU is the processor model reported by /proc/cpuinfo, empty when it cannot be read.
+	CPU string
+	// Power11 is set on an IBM Power11 (ppc64le) host.
+	Power11 bool
+}
+
+var (
+	// mu guards the cached platform info, detected once per process.
+	mu     sync.Mutex
+	cached *Info
+)
+
+// Get returns the platform info of the host, detected on the first call and cached for the rest of the process.
+func Get() Info {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if cached == nil {
+		info := Detect(hostfs.OS, hostfs.Arch())
+		cached = &info
+	}
+
+	return *cached
+}
+
+// IsPower11 reports whether the host is an IBM Power11 (ppc64le) one, as per the cached platform info.
+func IsPower11() bool {
+	return Get().Power11
+}
+
+// Reset drops the cached platform info, so that the next Get detects it again. Meant for tests.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	cached = nil
+}
+
+// Detect detects the platform from the files of the given host filesystem, without caching the result.
+func Detect(fsys hostfs.FS, arch string) Info {
+	info := Info{Arch: arch}
+
+	data, err := fsys.ReadFile(cpuinfoPath)
+	if err != nil {
+		return info
+	}
+	for line := range strings.SplitSeq(string(data), "\n") {
+		key, value, found := strings.Cut(line, ":")
+		if found && strings.TrimSpace(key) == cpuinfoField {
+			info.CPU = strings.TrimSpace(value)
+
+			break
+		}
+	}
+	info.Power11 = arch == ArchPower && strings.Contains(strings.ToLower(info.CPU), power11)
+
+	return info
+}
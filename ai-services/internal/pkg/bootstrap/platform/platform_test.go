@@ -0,0 +1,68 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name  string
+		arch  string
+		files hostfs.Fake
+		want  Info
+	}{
+		{
+			name:  "power11",
+			arch:  "ppc64le",
+			files: hostfs.Fake{"/proc/cpuinfo": "processor\t: 0\ncpu\t\t: Power11 (architected), altivec supported\nclock\t\t: 3900.000000MHz\n"},
+			want:  Info{Arch: "ppc64le", CPU: "Power11 (architected), altivec supported", Power11: true},
+		},
+		{
+			name:  "power10",
+			arch:  "ppc64le",
+			files: hostfs.Fake{"/proc/cpuinfo": "processor\t: 0\ncpu\t\t: POWER10 (architected), altivec supported\n"},
+			want:  Info{Arch: "ppc64le", CPU: "POWER10 (architected), altivec supported"},
+		},
+		{
+			name:  "missing cpuinfo",
+			arch:  "ppc64le",
+			files: hostfs.Fake{},
+			want:  Info{Arch: "ppc64le"},
+		},
+		{
+			name:  "not ppc64le",
+			arch:  "amd64",
+			files: hostfs.Fake{"/proc/cpuinfo": "cpu\t\t: Power11\n"},
+			want:  Info{Arch: "amd64", CPU: "Power11"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Detect(tt.files, tt.arch); got != tt.want {
+				t.Errorf("Detect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetCaches(t *testing.T) {
+	Reset()
+	t.Cleanup(Reset)
+
+	first := Get()
+	mu.Lock()
+	cached.CPU = "cached"
+	mu.Unlock()
+
+	if got := Get(); got.CPU != "cached" || got.Arch != first.Arch {
+		t.Errorf("Get() = %+v, want the cached info", got)
+	}
+
+	Reset()
+	if got := Get(); got != first {
+		t.Errorf("Get() after Reset() = %+v, want it detected again as %+v", got, first)
+	}
+}
@@ -2,8 +2,10 @@ package podman
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
@@ -17,10 +19,45 @@ const (
 
 // Configure performs the complete configuration of the Podman environment.
 func (p *PodmanBootstrap) Configure() error {
+	return p.ConfigureSteps(nil)
+}
+
+// ConfigureSteps runs only the given configuration steps of the Podman environment.
+func (p *PodmanBootstrap) ConfigureSteps(names []string) error {
+	selected, err := steps.Select(p.Steps(), names)
+	if err != nil {
+		return err
+	}
+
 	rootCheck := root.NewRootRule()
 	if err := rootCheck.Verify(); err != nil {
 		return err
 	}
+
+	if err := steps.Run(selected); err != nil {
+		return err
+	}
+
+	logger.Infoln("LPAR configured successfully")
+
+	return nil
+}
+
+// Steps returns the configuration steps of the Podman environment.
+func (p *PodmanBootstrap) Steps() []steps.Step {
+	return []steps.Step{
+		{Name: "podman", Description: "Installs and configures podman, if not done already", Run: configurePodman},
+		{
+			Name:        "servicereport",
+			Description: "Runs the servicereport tool to validate and repair the spyre card configuration",
+			// repairing the configuration rebinds the spyre cards to vfio, which are used by the running pods
+			Disruptive: true,
+			Run:        configureSpyreCards,
+		},
+	}
+}
+
+func configurePodman() error {
 	ctx := context.Background()
 
 	s := spinner.New("Checking podman installation")
@@ -55,8 +92,17 @@ func (p *PodmanBootstrap) Configure() error {
 		s.Stop("Podman already configured")
 	}
 
-	s = spinner.New("Checking spyre card configuration")
-	s.Start(ctx)
+	return nil
+}
+
+func configureSpyreCards() error {
+	// the servicereport tool runs in a container, so podman must be configured already
+	if err := validators.PodmanHealthCheck(); err != nil {
+		return fmt.Errorf("podman is not configured, please run the 'podman' step first: %w", err)
+	}
+
+	s := spinner.New("Checking spyre card configuration")
+	s.Start(context.Background())
 	// 2. Spyre cards – run servicereport tool to validate and repair spyre configurations
 	if err := runServiceReport(); err != nil {
 		s.Fail("failed to configure spyre card")
@@ -65,8 +111,6 @@ func (p *PodmanBootstrap) Configure() error {
 	}
 	s.Stop("Spyre cards configuration validated successfully.")
 
-	logger.Infoln("LPAR configured successfully")
-
 	return nil
 }
 
@@ -2,6 +2,7 @@ package podman
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os/exec"
 	"strconv"
@@ -10,10 +11,76 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/servicereport"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
+var (
+	// applyRecommendation runs the command of a recommended action in the servicereport container,
+	// which has the host configuration mounted.
+	applyRecommendation = func(command string) error {
+		_, err := helpers.RunServiceReportContainer(command, "configure")
+
+		return err
+	}
+	confirmRecommendation = utils.ConfirmAction
+)
+
+// handleRecommendations applies the actions recommended by servicereport with --apply-recommendations,
+// or else reports them. Only the known fixes of servicereport are applied, each of them printed and confirmed
+// unless --yes is given, the other actions are left to be reviewed and taken by hand.
+func handleRecommendations(recommendations []servicereport.Recommendation) error {
+	if len(recommendations) == 0 {
+		return nil
+	}
+	if !vars.ApplyRecommendations {
+		logger.Warningf("ServiceReport recommends the following actions, apply them with --apply-recommendations:\n%s\n",
+			servicereport.RenderRecommendations(recommendations))
+
+		return nil
+	}
+
+	var errs []error
+	for _, rec := range recommendations {
+		if rec.Command == "" {
+			logger.Warningf("Skipping the recommended action to be taken by hand: %s\n", rec.Description)
+
+			continue
+		}
+		if !rec.IsKnownFix() {
+			logger.Warningf("Skipping the recommended action which is not a known servicereport fix, review it and take it by hand: %s: %s\n",
+				rec.Description, rec.Command)
+
+			continue
+		}
+
+		logger.Infof("Recommended action: %s\n  %s\n", rec.Description, rec.Command)
+		if !vars.AutoYes {
+			confirmed, err := confirmRecommendation("Do you want to run the above command? ")
+			if err != nil {
+				return errors.Join(append(errs, err)...)
+			}
+			if !confirmed {
+				logger.Infof("Skipped the recommended action: %s\n", rec.Description)
+
+				continue
+			}
+		}
+
+		if err := applyRecommendation(rec.Command); err != nil {
+			errs = append(errs, fmt.Errorf("failed to apply the recommended action '%s': %w", rec.Description, err))
+
+			continue
+		}
+		logger.Infof("Applied the recommended action: %s\n", rec.Description)
+	}
+
+	return errors.Join(errs...)
+}
+
 func runServiceReport() error {
 	// validate spyre attachment first before running servicereport
 	spyreCheck := spyre.NewSpyreRule()
@@ -22,6 +89,11 @@ func runServiceReport() error {
 		return err
 	}
 
+	// fail early with the install guidance, rather than with the error of the container run
+	if err := servicereport.CheckTool(); err != nil {
+		return err
+	}
+
 	// Create host directories for vfio
 	cmd := `mkdir -p /etc/modules-load.d; mkdir -p /etc/udev/rules.d/`
 	_, err = exec.Command("bash", "-c", cmd).Output()
@@ -37,7 +109,11 @@ func runServiceReport() error {
 	}
 	logger.Infoln("VFIO kernel modules loaded on the host", logger.VerbosityLevelDebug)
 
-	if err := helpers.RunServiceReportContainer("servicereport -r -p spyre", "configure"); err != nil {
+	out, err := helpers.RunServiceReportContainer("servicereport -r -p spyre", "configure")
+	if recErr := handleRecommendations(servicereport.ParseRecommendations(out)); recErr != nil {
+		return recErr
+	}
+	if err != nil {
 		return err
 	}
 
@@ -0,0 +1,105 @@
+package podman
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/servicereport"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestHandleRecommendations(t *testing.T) {
+	recommendations := []servicereport.Recommendation{
+		{Description: "Load the vfio_pci module at boot", Command: "echo vfio_pci > /etc/modules-load.d/vfio-pci.conf"},
+		{Description: "Upgrade the system firmware"},
+		{Description: "Clean up", Command: "rm -rf /etc/udev/rules.d"},
+		{Description: "Reload the udev rules", Command: "udevadm trigger"},
+	}
+
+	tests := []struct {
+		name      string
+		apply     bool
+		autoYes   bool
+		confirm   func(string) (bool, error)
+		applyErr  error
+		wantRun   []string
+		wantAsked int
+		wantErr   bool
+	}{
+		{
+			name:    "only reported without --apply-recommendations",
+			confirm: func(string) (bool, error) { return true, nil },
+		},
+		{
+			name:      "known fixes applied once confirmed",
+			apply:     true,
+			confirm:   func(string) (bool, error) { return true, nil },
+			wantRun:   []string{"echo vfio_pci > /etc/modules-load.d/vfio-pci.conf", "udevadm trigger"},
+			wantAsked: 2,
+		},
+		{
+			name:      "declined fixes skipped",
+			apply:     true,
+			confirm:   func(string) (bool, error) { return false, nil },
+			wantAsked: 2,
+		},
+		{
+			name:    "no confirmation with --yes",
+			apply:   true,
+			autoYes: true,
+			confirm: func(string) (bool, error) { return false, nil },
+			wantRun: []string{"echo vfio_pci > /etc/modules-load.d/vfio-pci.conf", "udevadm trigger"},
+		},
+		{
+			name:      "failed prompt",
+			apply:     true,
+			confirm:   func(string) (bool, error) { return false, errors.New("no terminal") },
+			wantAsked: 1,
+			wantErr:   true,
+		},
+		{
+			name:     "failed fixes reported",
+			apply:    true,
+			autoYes:  true,
+			confirm:  func(string) (bool, error) { return true, nil },
+			applyErr: errors.New("exit status 1"),
+			wantRun:  []string{"echo vfio_pci > /etc/modules-load.d/vfio-pci.conf", "udevadm trigger"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var run []string
+			asked := 0
+			defer func(apply func(string) error, confirm func(string) (bool, error), applyFlag, autoYes bool) {
+				applyRecommendation, confirmRecommendation = apply, confirm
+				vars.ApplyRecommendations, vars.AutoYes = applyFlag, autoYes
+			}(applyRecommendation, confirmRecommendation, vars.ApplyRecommendations, vars.AutoYes)
+
+			applyRecommendation = func(command string) error {
+				run = append(run, command)
+
+				return tt.applyErr
+			}
+			confirmRecommendation = func(prompt string) (bool, error) {
+				asked++
+
+				return tt.confirm(prompt)
+			}
+			vars.ApplyRecommendations, vars.AutoYes = tt.apply, tt.autoYes
+
+			err := handleRecommendations(recommendations)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handleRecommendations() error = %v, want error %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(run, tt.wantRun) {
+				t.Errorf("handleRecommendations() ran %q, want %q", run, tt.wantRun)
+			}
+			if asked != tt.wantAsked {
+				t.Errorf("handleRecommendations() asked %d confirmations, want %d", asked, tt.wantAsked)
+			}
+		})
+	}
+}
@@ -1,6 +1,22 @@
 package podman
 
-import "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+import (
+	"fmt"
+	"slices"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// Pod statuses reported by podman.
+const (
+	podStatusRunning  = "Running"
+	podStatusDegraded = "Degraded"
+)
 
 // PodmanBootstrap implements Bootstrap interface for Podman runtime.
 type PodmanBootstrap struct{}
@@ -14,3 +30,37 @@ func NewPodmanBootstrap() *PodmanBootstrap {
 func (p *PodmanBootstrap) Type() types.RuntimeType {
 	return types.RuntimeTypePodman
 }
+
+// RunningApplications returns the names of the applications with running pods.
+// No application can be running while podman is not configured yet, so that is not reported as an error.
+func (p *PodmanBootstrap) RunningApplications() ([]string, error) {
+	if err := validators.PodmanHealthCheck(); err != nil {
+		logger.Infof("Podman is not configured, skipping the check for running applications: %v\n", err, logger.VerbosityLevelDebug)
+
+		return nil, nil
+	}
+
+	client, err := podman.NewPodmanClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create podman client: %w", err)
+	}
+
+	pods, err := client.ListPods(selector.Selector{}.Filters())
+	if err != nil {
+		return nil, err
+	}
+
+	apps := []string{}
+	for _, pod := range pods {
+		// degraded pods still have some of their containers running
+		if pod.Status != podStatusRunning && pod.Status != podStatusDegraded {
+			continue
+		}
+		if app := pod.Labels[constants.ApplicationAnnotationKey]; app != "" && !slices.Contains(apps, app) {
+			apps = append(apps, app)
+		}
+	}
+	slices.Sort(apps)
+
+	return apps, nil
+}
@@ -0,0 +1,165 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/steps"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/output"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+const reportFilePerm = 0o644
+
+// CheckStatus is the outcome of a single validation check.
+type CheckStatus string
+
+const (
+	CheckStatusPassed  CheckStatus = "passed"
+	CheckStatusFailed  CheckStatus = "failed"
+	CheckStatusWarning CheckStatus = "warning"
+	CheckStatusSkipped CheckStatus = "skipped"
+)
+
+// ValidationReport is the structured outcome of a validation run, meant to be archived by CI pipelines.
+type ValidationReport struct {
+	Runtime string `json:"runtime"`
+	Context string `json:"context,omitempty"`
+	// Host is the validated host on podman, the target of --target when validating a remote LPAR.
+	Host       string        `json:"host,omitempty"`
+	Passed     bool          `json:"passed"`
+	Error      string        `json:"error,omitempty"`
+	StartedAt  time.Time     `json:"startedAt"`
+	DurationMs int64         `json:"durationMs"`
+	Checks     []CheckResult `json:"checks,omitempty"`
+	// Warnings is the number of checks which reported a warning, they fail the run only with --warnings-as-errors.
+	Warnings int `json:"warnings,omitempty"`
+	// Contexts holds the per-context reports when validating several kubeconfig contexts in one run.
+	Contexts []*ValidationReport `json:"contexts,omitempty"`
+}
+
+// CheckResult is the outcome of a single validation check.
+type CheckResult struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Level       string      `json:"level"`
+	Status      CheckStatus `json:"status"`
+	Message     string      `json:"message,omitempty"`
+	Error       string      `json:"error,omitempty"`
+	Hint        string      `json:"hint,omitempty"`
+	DurationMs  int64       `json:"durationMs"`
+	// Attempts is the number of times the check was verified, more than one when it was retried as per its policy.
+	Attempts int `json:"attempts,omitempty"`
+	// Fixed is set when the check failed at first, and passed once remediated with --fix.
+	Fixed bool `json:"fixed,omitempty"`
+	// Measurements are the values measured by the check, Eg:- the LPAR affinity percentage.
+	Measurements map[string]float64 `json:"measurements,omitempty"`
+	// Versions are the versions of the software found by the check, Eg:- the RHEL release.
+	Versions map[string]string `json:"versions,omitempty"`
+}
+
+// ConfigureReport is the structured outcome of a configuration run.
+type ConfigureReport struct {
+	Runtime    string    `json:"runtime"`
+	Passed     bool      `json:"passed"`
+	Error      string    `json:"error,omitempty"`
+	StartedAt  time.Time `json:"startedAt"`
+	DurationMs int64     `json:"durationMs"`
+	// Steps lists the configuration steps of the runtime, in the order they are run.
+	Steps []string `json:"steps"`
+}
+
+// ConfigureWithReport configures the environment via the given bootstrap, recording the outcome of the run.
+func ConfigureWithReport(b Bootstrap, rt types.RuntimeType) (*ConfigureReport, error) {
+	report := &ConfigureReport{
+		Runtime:   rt.String(),
+		StartedAt: time.Now().UTC(),
+		Steps:     steps.Names(b.Steps()),
+	}
+
+	err := b.Configure()
+	report.DurationMs = time.Since(report.StartedAt).Milliseconds()
+	report.Passed = err == nil
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	return report, err
+}
+
+func newValidationReport(rt types.RuntimeType) *ValidationReport {
+	report := &ValidationReport{
+		Runtime:   rt.String(),
+		StartedAt: time.Now().UTC(),
+		Checks:    []CheckResult{},
+	}
+	if rt == types.RuntimeTypePodman {
+		report.Host = ValidatedHost()
+	}
+
+	return report
+}
+
+// ValidatedHost names the host verified by the host checks, the remote one when substituted, Eg:- root@lpar1,
+// else the local one.
+func ValidatedHost() string {
+	if remote := hostfs.CurrentRemote(); remote != nil {
+		return remote.Target()
+	}
+	if name, err := os.Hostname(); err == nil {
+		return name
+	}
+
+	return "localhost"
+}
+
+// finish records the total duration and the final outcome of the run.
+func (r *ValidationReport) finish(err error) {
+	r.DurationMs = time.Since(r.StartedAt).Milliseconds()
+	r.Passed = err == nil
+	if err != nil {
+		r.Error = err.Error()
+	}
+}
+
+func newCheckResult(rule validators.Rule, elapsed time.Duration) CheckResult {
+	return CheckResult{
+		Name:        rule.Name(),
+		Description: rule.Description(),
+		Level:       rule.Level().String(),
+		DurationMs:  elapsed.Milliseconds(),
+	}
+}
+
+func skippedCheck(rule validators.Rule) CheckResult {
+	check := newCheckResult(rule, 0)
+	check.Status = CheckStatusSkipped
+
+	return check
+}
+
+// WriteReportFile writes the given report to path, replacing any existing file.
+// The report is written as YAML for a .yaml or .yml path, and as indented JSON otherwise.
+func WriteReportFile(path string, report *ValidationReport) error {
+	format := output.FormatJSON
+	if ext := strings.ToLower(filepath.Ext(path)); ext == ".yaml" || ext == ".yml" {
+		format = output.FormatYAML
+	}
+
+	data, err := output.Marshal(report, format)
+	if err != nil {
+		return fmt.Errorf("failed to marshal validation report: %w", err)
+	}
+
+	if err := os.WriteFile(path, append(bytes.TrimSuffix(data, []byte("\n")), '\n'), reportFilePerm); err != nil {
+		return fmt.Errorf("failed to write validation report to %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package bootstrap
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestConfigureWithReport(t *testing.T) {
+	tests := []struct {
+		name         string
+		configureErr error
+		wantErr      string
+	}{
+		{name: "configured"},
+		{name: "failed step", configureErr: errors.New("failed to run servicereport"), wantErr: "failed to run servicereport"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report, err := ConfigureWithReport(&fakeBootstrap{configureErr: tt.configureErr}, types.RuntimeTypePodman)
+			if !errors.Is(err, tt.configureErr) {
+				t.Errorf("ConfigureWithReport() error = %v, want %v", err, tt.configureErr)
+			}
+			if report.Runtime != "podman" || report.StartedAt.IsZero() {
+				t.Errorf("ConfigureWithReport() = %+v, want the podman run", report)
+			}
+			if want := []string{"podman", "servicereport"}; !reflect.DeepEqual(report.Steps, want) {
+				t.Errorf("Steps = %v, want %v", report.Steps, want)
+			}
+			if report.Passed != (tt.wantErr == "") || report.Error != tt.wantErr {
+				t.Errorf("Passed = %v, Error = %q, want the outcome of the run %q", report.Passed, report.Error, tt.wantErr)
+			}
+		})
+	}
+}
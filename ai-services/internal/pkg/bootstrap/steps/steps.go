@@ -0,0 +1,74 @@
+package steps
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Step is a single named step of the bootstrap configuration.
+// Each step checks its own prerequisites, so that it can be safely re-run on its own.
+type Step struct {
+	Name        string
+	Description string
+	// Disruptive marks the steps which can disrupt the running applications, Eg:- by rebinding the spyre cards.
+	Disruptive bool
+	Run        func() error
+}
+
+// Names returns the names of the given steps.
+func Names(all []Step) []string {
+	names := make([]string, 0, len(all))
+	for _, step := range all {
+		names = append(names, step.Name)
+	}
+
+	return names
+}
+
+// Disruptive returns the disruptive steps among the given ones.
+func Disruptive(all []Step) []Step {
+	disruptive := []Step{}
+	for _, step := range all {
+		if step.Disruptive {
+			disruptive = append(disruptive, step)
+		}
+	}
+
+	return disruptive
+}
+
+// Select returns the steps matching the given names, preserving the order in which the steps are declared.
+// No names selects all the steps.
+func Select(all []Step, names []string) ([]Step, error) {
+	if len(names) == 0 {
+		return all, nil
+	}
+
+	valid := Names(all)
+	for _, name := range names {
+		if !slices.Contains(valid, name) {
+			return nil, fmt.Errorf("unknown step '%s', valid steps are: %s", name, strings.Join(valid, ", "))
+		}
+	}
+
+	selected := []Step{}
+	for _, step := range all {
+		if slices.Contains(names, step.Name) {
+			selected = append(selected, step)
+		}
+	}
+
+	return selected, nil
+}
+
+// Run runs the given steps in order, stopping at the first failure.
+func Run(selected []Step) error {
+	for _, step := range selected {
+		if err := step.Run(); err != nil {
+			return fmt.Errorf("step '%s' failed: %w", step.Name, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package steps
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func newSteps(ran *[]string) []Step {
+	step := func(name string, err error) Step {
+		return Step{Name: name, Run: func() error {
+			*ran = append(*ran, name)
+
+			return err
+		}}
+	}
+
+	return []Step{step("podman", nil), step("servicereport", errors.New("boom")), step("init", nil)}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "all steps", want: []string{"podman", "servicereport", "init"}},
+		{name: "single step", names: []string{"init"}, want: []string{"init"}},
+		{name: "declaration order is kept", names: []string{"init", "podman"}, want: []string{"podman", "init"}},
+		{name: "unknown step", names: []string{"podman", "network"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ran []string
+			selected, err := Select(newSteps(&ran), tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Select() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := Names(selected); !slices.Equal(got, tt.want) {
+				t.Errorf("Select() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisruptive(t *testing.T) {
+	var ran []string
+	all := newSteps(&ran)
+	all[1].Disruptive = true
+
+	if got, want := Names(Disruptive(all)), []string{"servicereport"}; !slices.Equal(got, want) {
+		t.Errorf("Disruptive() = %v, want %v", got, want)
+	}
+	if got := Disruptive(all[2:]); len(got) != 0 {
+		t.Errorf("Disruptive() = %v, want none", Names(got))
+	}
+}
+
+func TestRunStopsAtFirstFailure(t *testing.T) {
+	var ran []string
+	err := Run(newSteps(&ran))
+	if err == nil {
+		t.Fatal("Run() expected an error")
+	}
+	if want := []string{"podman", "servicereport"}; !slices.Equal(ran, want) {
+		t.Errorf("Run() ran %v, want %v", ran, want)
+	}
+}
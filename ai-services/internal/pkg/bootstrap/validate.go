@@ -2,13 +2,24 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/spinner"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/warn"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
@@ -16,45 +27,181 @@ import (
 type validationResult struct {
 	err        error
 	shouldStop bool
+	check      CheckResult
+	// warning is set when the check reported a concerning condition, which does not fail the validation.
+	warning error
+	// blockedBy is the failed rule, because of which the check was skipped.
+	blockedBy string
 }
 
-// Validate runs all validation checks.
-func (p *BootstrapFactory) Validate(skip map[string]bool) error {
-	ctx := context.Background()
-	rules := getRulesForRuntime()
+// checksFailedError reports the number of failed checks, while keeping their errors reachable with errors.Is/As.
+type checksFailedError struct {
+	errs []error
+	// warnings is set when the errors are warnings, failing the validation as per vars.WarningsAsErrors.
+	warnings bool
+}
 
-	var validationErrors []error
+func (e *checksFailedError) Error() string {
+	if e.warnings {
+		return fmt.Sprintf("%d validation check(s) reported warnings, treated as errors", len(e.errs))
+	}
 
-	for _, rule := range rules {
+	return fmt.Sprintf("%d validation check(s) failed", len(e.errs))
+}
+
+func (e *checksFailedError) Unwrap() []error {
+	return e.errs
+}
+
+// Validate runs all validation checks, the checks waiting for a resource stop once ctx is done.
+func (p *BootstrapFactory) Validate(ctx context.Context, skip map[string]bool) error {
+	_, err := p.ValidateWithReport(ctx, skip)
+
+	return err
+}
+
+// ValidateWithReport runs all validation checks, and returns the structured report of the run
+// along with the validation error, if any.
+func (p *BootstrapFactory) ValidateWithReport(ctx context.Context, skip map[string]bool) (*ValidationReport, error) {
+	rules := rulesForRuntime()
+	report := newValidationReport(p.runtimeType)
+
+	err := runRules(ctx, rules, skip, report)
+	report.finish(err)
+
+	return report, err
+}
+
+// runRules verifies the rules stage by stage, as planned by validators.Plan from their dependencies.
+// The rules of a stage are independent, so they are verified concurrently.
+func runRules(ctx context.Context, rules []validators.Rule, skip map[string]bool, report *ValidationReport) error {
+	stages, err := validators.Plan(rules)
+	if err != nil {
+		return err
+	}
+
+	var validationErrors, warnings []error
+	// blocked maps the rules which failed, or were skipped because of a failure, to the failed rule
+	blocked := map[string]string{}
+
+	for _, stage := range stages {
+		results := runStage(ctx, stage, skip, blocked)
+
+		for i, result := range results {
+			report.Checks = append(report.Checks, result.check)
+
+			if result.err != nil {
+				blocked[stage[i].Name()] = stage[i].Name()
+				validationErrors = append(validationErrors, result.err)
+			}
+			if result.warning != nil {
+				warnings = append(warnings, result.warning)
+			}
+			if result.blockedBy != "" {
+				blocked[stage[i].Name()] = result.blockedBy
+			}
+		}
+
+		// Handle critical failures that require immediate exit, unless asked to report all the failures
+		for _, result := range results {
+			if !result.shouldStop {
+				continue
+			}
+			if !vars.ContinueOnFailure {
+				return exitcode.MarkValidationFailed(result.err)
+			}
+			logger.Warningf("Critical check failed, continuing with the remaining checks: %v\n", result.err)
+		}
+	}
+	report.Warnings = len(warnings)
+
+	if len(validationErrors) > 0 {
+		return exitcode.MarkValidationFailed(&checksFailedError{errs: validationErrors})
+	}
+
+	if len(warnings) > 0 {
+		if vars.WarningsAsErrors {
+			return exitcode.MarkValidationFailed(&checksFailedError{errs: warnings, warnings: true})
+		}
+		exitcode.RecordWarnings()
+		logger.Resultf("All validations passed with %d warning(s)\n", len(warnings))
+
+		return nil
+	}
+
+	logger.Resultln("All validations passed")
+
+	return nil
+}
+
+// runStage verifies the rules of a stage, apart from the skipped ones and the ones whose prerequisite failed.
+// The results are returned in the order of the rules.
+func runStage(ctx context.Context, stage []validators.Rule, skip map[string]bool, blocked map[string]string) []validationResult {
+	results := make([]validationResult, len(stage))
+	pending := make([]int, 0, len(stage))
+
+	remote := hostfs.CurrentRemote()
+	for i, rule := range stage {
 		ruleName := rule.Name()
+		if remote != nil && !validators.ReadsHost(rule) {
+			logger.Infof("%s check skipped: it cannot verify the remote host %s\n", ruleName, remote.Target())
+			check := skippedCheck(rule)
+			check.Message = "skipped: only verifiable on the local host"
+			results[i] = validationResult{check: check}
+
+			continue
+		}
 		if skip[ruleName] {
 			logger.Warningf("%s check skipped; Proceeding without validation may result in deployment failure.", ruleName)
+			results[i] = validationResult{check: skippedCheck(rule)}
 
 			continue
 		}
+		if failed := failedPrerequisite(rule, blocked); failed != "" {
+			logger.Infof("%s check skipped: prerequisite %s failed\n", ruleName, failed)
+			check := skippedCheck(rule)
+			check.Message = fmt.Sprintf("skipped: prerequisite %s failed", failed)
+			results[i] = validationResult{check: check, blockedBy: failed}
 
-		result := executeRule(ctx, rule)
-
-		// Handle critical failures that require immediate exit
-		if result.shouldStop {
-			return result.err
+			continue
 		}
+		pending = append(pending, i)
+	}
 
-		// Collect non-critical errors
-		if result.err != nil {
-			validationErrors = append(validationErrors, result.err)
-		}
+	switch len(pending) {
+	case 0:
+		return results
+	case 1:
+		results[pending[0]] = executeRule(ctx, stage[pending[0]])
+
+		return results
 	}
 
-	if len(validationErrors) > 0 {
-		return fmt.Errorf("%d validation check(s) failed", len(validationErrors))
+	rules := make([]validators.Rule, 0, len(pending))
+	for _, i := range pending {
+		rules = append(rules, stage[i])
+	}
+	for j, result := range executeRules(ctx, rules) {
+		results[pending[j]] = result
 	}
 
-	logger.Infoln("All validations passed")
+	return results
+}
 
-	return nil
+// failedPrerequisite returns the name of the failed rule blocking one of the prerequisites of the given rule, if any.
+func failedPrerequisite(rule validators.Rule, blocked map[string]string) string {
+	for _, dep := range validators.DependenciesOf(rule) {
+		if failed, ok := blocked[dep]; ok {
+			return failed
+		}
+	}
+
+	return ""
 }
 
+// rulesForRuntime returns the rules verified by the validation, replaced by the tests.
+var rulesForRuntime = getRulesForRuntime
+
 // getRulesForRuntime returns the appropriate validation rules based on the runtime type.
 func getRulesForRuntime() []validators.Rule {
 	rt := vars.RuntimeFactory.GetRuntimeType()
@@ -68,38 +215,177 @@ func getRulesForRuntime() []validators.Rule {
 	}
 }
 
-// executeRule runs a single validation rule, handles errors based on validation level,
-// and returns whether execution should continue or stop immediately.
+// executeRule runs a single validation rule, remediating its failure with --fix, and reports its outcome as per
+// its validation level. The result tells whether the validation should stop, on the failure of a critical rule.
 func executeRule(ctx context.Context, rule validators.Rule) validationResult {
-	ruleName := rule.Name()
-	s := spinner.New("Validating " + ruleName + " ...")
+	s := spinner.New("Validating " + rule.Name() + " ...")
+	s.Start(ctx)
+
+	check, err := verifyRule(ctx, rule)
+	err = remediateRule(rule, &check, err)
+
+	return reportRule(s, rule, check, err)
+}
+
+// executeRules runs the given independent rules concurrently, under a single spinner.
+// The failures are then remediated with --fix one rule at a time, as the fixes change the host, Eg:- rebinding
+// the spyre cards, and the outcomes are reported in order, so that the output does not interleave.
+func executeRules(ctx context.Context, rules []validators.Rule) []validationResult {
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		names = append(names, rule.Name())
+	}
+	s := spinner.New("Validating " + strings.Join(names, ", ") + " ...")
 	s.Start(ctx)
 
-	err := rule.Verify()
+	checks := make([]CheckResult, len(rules))
+	errs := make([]error, len(rules))
+	var wg sync.WaitGroup
+	for i, rule := range rules {
+		wg.Go(func() {
+			checks[i], errs[i] = verifyRule(ctx, rule)
+		})
+	}
+	wg.Wait()
+	for i, rule := range rules {
+		errs[i] = remediateRule(rule, &checks[i], errs[i])
+	}
+	s.Clear()
+
+	results := make([]validationResult, 0, len(rules))
+	for i, rule := range rules {
+		rs := spinner.New("Validating " + rule.Name() + " ...")
+		rs.Start(ctx)
+		results = append(results, reportRule(rs, rule, checks[i], errs[i]))
+	}
+
+	return results
+}
+
+// verifyRule verifies the rule as per its retry policy, without remediating its failure nor reporting the outcome.
+func verifyRule(ctx context.Context, rule validators.Rule) (CheckResult, error) {
+	policy := validators.RetryPolicyOf(rule)
+	logger.Infof("%s: retry policy: %s\n", rule.Name(), policy, logger.VerbosityLevelDebug)
+
+	defer trace.Start("check " + rule.Name())()
+	start := time.Now()
+	var attempts int
+	var err error
+	if policy.Timeout > 0 {
+		attempts, err = waitForRule(ctx, rule, policy)
+	} else {
+		attempts, err = utils.RetryWithAttempts(policy.Attempts, policy.Interval, policy.Backoff, rule.Verify)
+	}
+	check := newCheckResult(rule, time.Since(start))
+	check.Attempts = attempts
+	check.Measurements = validators.MeasurementsOf(rule)
+	check.Versions = validators.VersionsOf(rule)
+
+	return check, err
+}
+
+// remediateRule remediates the failure of the verified rule with --fix, recording in its check whether it passed
+// once fixed. It returns the error the rule still fails with.
+func remediateRule(rule validators.Rule, check *CheckResult, verifyErr error) error {
+	if verifyErr == nil || !vars.FixChecks {
+		return verifyErr
+	}
+
+	start := time.Now()
+	fixed, err := fixRule(rule, verifyErr)
+	check.Fixed = fixed
+	check.DurationMs += time.Since(start).Milliseconds()
+	check.Measurements = validators.MeasurementsOf(rule)
+	check.Versions = validators.VersionsOf(rule)
+
+	return err
+}
+
+// waitForRule polls the rule until it passes or the timeout of its policy elapses, Eg:- with --wait-ready.
+// It returns the number of attempts, along with the error the rule last failed with once timed out.
+func waitForRule(ctx context.Context, rule validators.Rule, policy retry.Policy) (int, error) {
+	maxInterval := policy.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = policy.Interval
+	}
+
+	attempts := 0
+	var verifyErr error
+	err := utils.WaitForWithMaxInterval(ctx, policy.Interval, maxInterval, policy.Timeout, func() (bool, error) {
+		attempts++
+		verifyErr = rule.Verify()
+		var permanent *utils.PermanentError
+		if errors.As(verifyErr, &permanent) {
+			return false, permanent.Err
+		}
+		if verifyErr != nil {
+			logger.Infof("%s: not ready yet: %v\n", rule.Name(), verifyErr, logger.VerbosityLevelDebug)
+		}
+
+		return verifyErr == nil, nil
+	})
+	if errors.Is(err, utils.ErrWaitTimeout) {
+		return attempts, fmt.Errorf("not ready after %s: %w", policy.Timeout, verifyErr)
+	}
+
+	return attempts, err
+}
+
+// fixRule remediates the failure of the rule if it supports it, and verifies it again.
+// It returns whether the rule passed once fixed, along with the error it still fails with.
+func fixRule(rule validators.Rule, verifyErr error) (bool, error) {
+	fixer, ok := rule.(validators.Fixer)
+	if !ok {
+		return false, verifyErr
+	}
+
+	logger.Infof("%s: fixing: %v\n", rule.Name(), verifyErr, logger.VerbosityLevelDebug)
+	if err := fixer.Fix(); err != nil {
+		return false, fmt.Errorf("%w (fix failed: %w)", verifyErr, err)
+	}
+	if err := rule.Verify(); err != nil {
+		return false, fmt.Errorf("%w (still failing once fixed)", err)
+	}
+
+	return true, nil
+}
+
+// reportRule stops the spinner of the rule with the outcome of its verification.
+func reportRule(s *spinner.Spinner, rule validators.Rule, check CheckResult, err error) validationResult {
+	ruleName := rule.Name()
+
+	if err != nil && (rule.Level() == constants.ValidationLevelWarning || warn.Is(err)) {
+		s.Warn("Warning: " + err.Error())
+		if hint := rule.Hint(); hint != "" {
+			logger.Infof("%s %s\n", style.Hint().Render("HINT:"), hint)
+		}
+		check.Status = CheckStatusWarning
+		check.Error = err.Error()
+		check.Hint = rule.Hint()
+
+		return validationResult{check: check, warning: fmt.Errorf("%s: %w", ruleName, err)}
+	}
+
 	if err != nil {
 		s.StopWithHint(err.Error(), rule.Hint())
+		check.Status = CheckStatusFailed
+		check.Error = err.Error()
+		check.Hint = rule.Hint()
 
-		// Handle based on validation level
-		switch rule.Level() {
-		case constants.ValidationLevelCritical:
+		return validationResult{
+			err: fmt.Errorf("%s: %w", ruleName, err),
 			// Critical failures require immediate exit
-			return validationResult{
-				err:        fmt.Errorf("%s: %w", ruleName, err),
-				shouldStop: true,
-			}
-		case constants.ValidationLevelError:
-			// Error level
-			return validationResult{
-				err: fmt.Errorf("%s: %w", ruleName, err),
-			}
-		case constants.ValidationLevelWarning:
-			// Warning level
-			s.Stop("Warning: " + err.Error())
-
-			return validationResult{}
+			shouldStop: rule.Level() == constants.ValidationLevelCritical,
+			check:      check,
 		}
 	}
-	s.Stop(rule.Message())
+	if check.Fixed {
+		s.Stop(rule.Message() + " (fixed)")
+	} else {
+		s.Stop(rule.Message())
+	}
+	check.Status = CheckStatusPassed
+	check.Message = rule.Message()
 
-	return validationResult{}
+	return validationResult{check: check}
 }
@@ -0,0 +1,58 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+// ValidateContexts runs all validation checks against each of the given kubeconfig contexts.
+// A failure in one context does not stop the others, and a per-context summary is printed at the end.
+// The returned report holds the report of each context.
+func (p *BootstrapFactory) ValidateContexts(ctx context.Context, contexts []string, skip map[string]bool) (*ValidationReport, error) {
+	// switch back to the current context of the kubeconfig once done
+	defer openshift.UseContext("")
+
+	report := newValidationReport(p.runtimeType)
+	failed := 0
+	for _, name := range contexts {
+		logger.Infof("Validating context '%s'...\n", name)
+		openshift.UseContext(name)
+
+		contextReport, err := p.ValidateWithReport(ctx, skip)
+		if err != nil {
+			failed++
+		}
+		contextReport.Context = name
+		report.Contexts = append(report.Contexts, contextReport)
+		logger.Infoln("-------")
+	}
+
+	printContextSummary(report.Contexts)
+
+	var err error
+	if failed > 0 {
+		err = fmt.Errorf("%d of %d context(s) failed validation", failed, len(contexts))
+	}
+	report.finish(err)
+
+	return report, err
+}
+
+func printContextSummary(reports []*ValidationReport) {
+	printer := utils.NewTableWriter()
+	defer printer.CloseTableWriter()
+
+	printer.SetHeaders("CONTEXT", "RESULT", "DETAILS")
+	for _, r := range reports {
+		if !r.Passed {
+			printer.AppendRow(r.Context, "FAILED", r.Error)
+
+			continue
+		}
+		printer.AppendRow(r.Context, "PASSED", "All validations passed")
+	}
+}
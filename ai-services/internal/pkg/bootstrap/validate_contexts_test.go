@@ -0,0 +1,114 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"syscall"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// contextRule reaches the cluster of the kubeconfig context in use, failing with the error given for it.
+type contextRule struct {
+	errs    map[string]error
+	reached []string
+}
+
+func (r *contextRule) Verify() error {
+	name := openshift.CurrentContext()
+	r.reached = append(r.reached, name)
+
+	return r.errs[name]
+}
+
+func (r *contextRule) Message() string                  { return "Cluster authentication successful" }
+func (r *contextRule) Name() string                     { return "kubeconfig" }
+func (r *contextRule) Level() constants.ValidationLevel { return constants.ValidationLevelCritical }
+func (r *contextRule) Hint() string                     { return "" }
+func (r *contextRule) Description() string              { return "reaches the cluster of the context" }
+
+func TestValidateContexts(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	tests := []struct {
+		name       string
+		contexts   []string
+		errs       map[string]error
+		wantPassed []bool
+		wantErr    string
+	}{
+		{
+			name:       "current context",
+			contexts:   []string{"lab"},
+			wantPassed: []bool{true},
+		},
+		{
+			name:     "missing context",
+			contexts: []string{"staging", "lab"},
+			errs: map[string]error{
+				"staging": errors.New(`failed to get openshift config: context "staging" does not exist`),
+			},
+			wantPassed: []bool{false, true},
+			wantErr:    "1 of 2 context(s) failed validation",
+		},
+		{
+			name:     "unreachable cluster",
+			contexts: []string{"lab", "edge", "prod"},
+			errs: map[string]error{
+				"edge": fmt.Errorf("failed to reach the openshift API server: dial tcp 10.0.0.5:6443: %w", syscall.ECONNREFUSED),
+				"prod": fmt.Errorf("failed to reach the openshift API server: dial tcp 10.0.0.6:6443: %w", syscall.ECONNREFUSED),
+			},
+			wantPassed: []bool{true, false, false},
+			wantErr:    "2 of 3 context(s) failed validation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := &contextRule{errs: tt.errs}
+			rulesForRuntime = func() []validators.Rule { return []validators.Rule{rule} }
+			t.Cleanup(func() { rulesForRuntime = getRulesForRuntime })
+
+			report, err := NewBootstrapFactory(types.RuntimeTypeOpenShift).ValidateContexts(context.Background(), tt.contexts, nil)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("ValidateContexts() unexpected error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ValidateContexts() error = %v, want %q", err, tt.wantErr)
+			}
+			if report.Passed != (tt.wantErr == "") {
+				t.Errorf("Passed = %v, want %v", report.Passed, tt.wantErr == "")
+			}
+
+			// a failing context does not stop the validation of the next ones
+			if !reflect.DeepEqual(rule.reached, tt.contexts) {
+				t.Errorf("validated contexts = %v, want %v", rule.reached, tt.contexts)
+			}
+			if len(report.Contexts) != len(tt.contexts) {
+				t.Fatalf("ValidateContexts() reported %d context(s), want %d", len(report.Contexts), len(tt.contexts))
+			}
+			for i, contextReport := range report.Contexts {
+				if contextReport.Context != tt.contexts[i] || contextReport.Passed != tt.wantPassed[i] {
+					t.Errorf("report of context %d = %s passed %v, want %s passed %v",
+						i, contextReport.Context, contextReport.Passed, tt.contexts[i], tt.wantPassed[i])
+				}
+				if wantErr := tt.errs[tt.contexts[i]]; wantErr != nil && !strings.Contains(contextReport.Error, wantErr.Error()) {
+					t.Errorf("error of context %s = %q, want it to hold %q", contextReport.Context, contextReport.Error, wantErr)
+				}
+			}
+
+			if name := openshift.CurrentContext(); name != "" {
+				t.Errorf("CurrentContext() = %s after the validation, want the current context of the kubeconfig", name)
+			}
+		})
+	}
+}
@@ -0,0 +1,421 @@
+package bootstrap
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/warn"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// flakyRule fails until it has been verified the given number of times.
+type flakyRule struct {
+	failures int
+	calls    int
+}
+
+func (r *flakyRule) Verify() error {
+	r.calls++
+	if r.calls <= r.failures {
+		return errors.New("not ready")
+	}
+
+	return nil
+}
+
+func (r *flakyRule) Message() string                  { return "ready" }
+func (r *flakyRule) Name() string                     { return "flaky" }
+func (r *flakyRule) Level() constants.ValidationLevel { return constants.ValidationLevelError }
+func (r *flakyRule) Hint() string                     { return "" }
+func (r *flakyRule) Description() string              { return "fails a few times before passing" }
+
+// retryingRule is a flakyRule declaring its own retry policy.
+type retryingRule struct {
+	flakyRule
+	policy retry.Policy
+}
+
+func (r *retryingRule) RetryPolicy() retry.Policy { return r.policy }
+
+func TestExecuteRuleRetryPolicy(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	tests := []struct {
+		name         string
+		failures     int
+		policy       *retry.Policy
+		wantStatus   CheckStatus
+		wantAttempts int
+	}{
+		{name: "no policy fails right away", failures: 1, wantStatus: CheckStatusFailed, wantAttempts: 1},
+		{name: "passes within the attempts", failures: 2, policy: &retry.Policy{Attempts: 3, Interval: time.Millisecond}, wantStatus: CheckStatusPassed, wantAttempts: 3},
+		{name: "fails after the attempts", failures: 5, policy: &retry.Policy{Attempts: 2, Interval: time.Millisecond}, wantStatus: CheckStatusFailed, wantAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result validationResult
+			if tt.policy == nil {
+				result = executeRule(context.Background(), &flakyRule{failures: tt.failures})
+			} else {
+				result = executeRule(context.Background(), &retryingRule{flakyRule{failures: tt.failures}, *tt.policy})
+			}
+
+			if result.check.Status != tt.wantStatus {
+				t.Errorf("status = %s, want %s", result.check.Status, tt.wantStatus)
+			}
+			if result.check.Attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", result.check.Attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestExecuteRuleRetryTimeout(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	policy := retry.Policy{Attempts: 1000, Interval: time.Millisecond, MaxInterval: 4 * time.Millisecond, Timeout: 30 * time.Millisecond}
+	rule := &retryingRule{flakyRule{failures: 1000}, policy}
+
+	start := time.Now()
+	result := executeRule(context.Background(), rule)
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("executeRule() took %s, want the retries to stop once the timeout elapsed", elapsed)
+	}
+	if result.check.Status != CheckStatusFailed || rule.calls >= 1000 {
+		t.Fatalf("status = %s after %d calls, want a failure before the attempts are exhausted", result.check.Status, rule.calls)
+	}
+	if !strings.Contains(result.check.Error, "not ready after 30ms") {
+		t.Errorf("error = %q, want the timeout to be reported", result.check.Error)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := retry.Until(time.Minute)
+	intervals := []time.Duration{policy.Interval}
+	for range 3 {
+		intervals = append(intervals, policy.Backoff(intervals[len(intervals)-1]))
+	}
+
+	want := []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 30 * time.Second}
+	if !slices.Equal(intervals, want) {
+		t.Errorf("intervals = %v, want %v", intervals, want)
+	}
+	if got := retry.Readiness.Backoff(retry.Readiness.Interval); got != retry.Readiness.Interval {
+		t.Errorf("Readiness.Backoff() = %s, want a constant interval", got)
+	}
+}
+
+// staticRule returns the given error from Verify.
+type staticRule struct {
+	name  string
+	level constants.ValidationLevel
+	err   error
+}
+
+func (r *staticRule) Verify() error                    { return r.err }
+func (r *staticRule) Message() string                  { return "ok" }
+func (r *staticRule) Name() string                     { return r.name }
+func (r *staticRule) Level() constants.ValidationLevel { return r.level }
+func (r *staticRule) Hint() string                     { return "" }
+func (r *staticRule) Description() string              { return r.name }
+
+func TestRunRulesWarnings(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+	defer func(v bool) { vars.WarningsAsErrors = v }(vars.WarningsAsErrors)
+
+	rules := []validators.Rule{
+		&staticRule{name: "passed", level: constants.ValidationLevelError},
+		&staticRule{name: "warning-level", level: constants.ValidationLevelWarning, err: errors.New("not aligned")},
+		&staticRule{name: "warned", level: constants.ValidationLevelError, err: warn.Errorf("almost full")},
+	}
+	wantStatuses := []CheckStatus{CheckStatusPassed, CheckStatusWarning, CheckStatusWarning}
+
+	tests := []struct {
+		name             string
+		warningsAsErrors bool
+		wantErr          string
+	}{
+		{name: "warnings do not fail the validation"},
+		{name: "warnings as errors", warningsAsErrors: true, wantErr: "2 validation check(s) reported warnings, treated as errors"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars.WarningsAsErrors = tt.warningsAsErrors
+			report := newValidationReport(types.RuntimeTypePodman)
+
+			err := runRules(context.Background(), rules, nil, report)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("runRules() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("runRules() error = %v, want %q", err, tt.wantErr)
+			}
+
+			if report.Warnings != 2 {
+				t.Errorf("report warnings = %d, want 2", report.Warnings)
+			}
+			for i, check := range report.Checks {
+				if check.Status != wantStatuses[i] {
+					t.Errorf("check %s status = %s, want %s", check.Name, check.Status, wantStatuses[i])
+				}
+			}
+		})
+	}
+}
+
+// dependentRule is a staticRule requiring other rules to pass first.
+type dependentRule struct {
+	staticRule
+	deps []string
+}
+
+func (r *dependentRule) DependsOn() []string { return r.deps }
+
+func TestRunRulesDependencies(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	rules := []validators.Rule{
+		&dependentRule{staticRule{name: "dsc", level: constants.ValidationLevelError}, []string{"dsci"}},
+		&staticRule{name: "operators", level: constants.ValidationLevelError, err: errors.New("not installed")},
+		&dependentRule{staticRule{name: "dsci", level: constants.ValidationLevelError}, []string{"operators"}},
+		&staticRule{name: "storage", level: constants.ValidationLevelError},
+		&dependentRule{staticRule{name: "scp", level: constants.ValidationLevelError}, []string{"storage"}},
+	}
+
+	report := newValidationReport(types.RuntimeTypeOpenShift)
+	err := runRules(context.Background(), rules, nil, report)
+	if err == nil || err.Error() != "1 validation check(s) failed" {
+		t.Fatalf("runRules() error = %v, want a single failed check", err)
+	}
+
+	want := []struct {
+		name    string
+		status  CheckStatus
+		message string
+	}{
+		{name: "operators", status: CheckStatusFailed},
+		{name: "storage", status: CheckStatusPassed, message: "ok"},
+		{name: "dsci", status: CheckStatusSkipped, message: "skipped: prerequisite operators failed"},
+		{name: "scp", status: CheckStatusPassed, message: "ok"},
+		{name: "dsc", status: CheckStatusSkipped, message: "skipped: prerequisite operators failed"},
+	}
+	if len(report.Checks) != len(want) {
+		t.Fatalf("report checks = %v, want %d checks", report.Checks, len(want))
+	}
+	for i, w := range want {
+		check := report.Checks[i]
+		if check.Name != w.name || check.Status != w.status || check.Message != w.message {
+			t.Errorf("check %d = %s %s %q, want %s %s %q", i, check.Name, check.Status, check.Message, w.name, w.status, w.message)
+		}
+	}
+}
+
+func TestRunRulesContinueOnFailure(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+	defer func(v bool) { vars.ContinueOnFailure = v }(vars.ContinueOnFailure)
+
+	rules := []validators.Rule{
+		&staticRule{name: "root", level: constants.ValidationLevelCritical, err: errors.New("not root")},
+		&staticRule{name: "power", level: constants.ValidationLevelError, err: errors.New("not Power11")},
+		&dependentRule{staticRule{name: "spyre", level: constants.ValidationLevelError}, []string{"power"}},
+		&staticRule{name: "rhn", level: constants.ValidationLevelError},
+	}
+
+	tests := []struct {
+		name              string
+		continueOnFailure bool
+		wantErr           string
+		wantStatuses      []CheckStatus
+	}{
+		{
+			name:         "stops at the critical failure",
+			wantErr:      "root: not root",
+			wantStatuses: []CheckStatus{CheckStatusFailed},
+		},
+		{
+			name:              "reports all the failures",
+			continueOnFailure: true,
+			wantErr:           "2 validation check(s) failed",
+			wantStatuses:      []CheckStatus{CheckStatusFailed, CheckStatusFailed, CheckStatusPassed, CheckStatusSkipped},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars.ContinueOnFailure = tt.continueOnFailure
+			report := newValidationReport(types.RuntimeTypePodman)
+
+			err := runRules(context.Background(), rules, nil, report)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Fatalf("runRules() error = %v, want %q", err, tt.wantErr)
+			}
+
+			if len(report.Checks) != len(tt.wantStatuses) {
+				t.Fatalf("report checks = %v, want %d checks", report.Checks, len(tt.wantStatuses))
+			}
+			for i, check := range report.Checks {
+				if check.Status != tt.wantStatuses[i] {
+					t.Errorf("check %s status = %s, want %s", check.Name, check.Status, tt.wantStatuses[i])
+				}
+			}
+		})
+	}
+}
+
+// fixableRule is a staticRule which passes once fixed, unless its fix fails.
+type fixableRule struct {
+	staticRule
+	fixErr error
+}
+
+func (r *fixableRule) Fix() error {
+	if r.fixErr == nil {
+		r.err = nil
+	}
+
+	return r.fixErr
+}
+
+func TestRunRulesFix(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+	defer func(v bool) { vars.FixChecks = v }(vars.FixChecks)
+
+	tests := []struct {
+		name       string
+		fix        bool
+		fixErr     error
+		wantStatus CheckStatus
+		wantFixed  bool
+	}{
+		{name: "without --fix", wantStatus: CheckStatusFailed},
+		{name: "fixed", fix: true, wantStatus: CheckStatusPassed, wantFixed: true},
+		{name: "fix failed", fix: true, fixErr: errors.New("modprobe failed"), wantStatus: CheckStatusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars.FixChecks = tt.fix
+			rule := &fixableRule{staticRule{name: "vfio", level: constants.ValidationLevelError, err: errors.New("not loaded")}, tt.fixErr}
+			report := newValidationReport(types.RuntimeTypePodman)
+
+			err := runRules(context.Background(), []validators.Rule{rule}, nil, report)
+			if (err != nil) != (tt.wantStatus == CheckStatusFailed) {
+				t.Fatalf("runRules() error = %v, want status %s", err, tt.wantStatus)
+			}
+
+			check := report.Checks[0]
+			if check.Status != tt.wantStatus || check.Fixed != tt.wantFixed {
+				t.Errorf("check = %s fixed %t, want %s fixed %t", check.Status, check.Fixed, tt.wantStatus, tt.wantFixed)
+			}
+		})
+	}
+}
+
+// serialFixRule is a fixableRule recording the number of fixes running along with its own.
+type serialFixRule struct {
+	fixableRule
+	running    *atomic.Int32
+	concurrent *atomic.Bool
+}
+
+func (r *serialFixRule) Fix() error {
+	if r.running.Add(1) > 1 {
+		r.concurrent.Store(true)
+	}
+	defer r.running.Add(-1)
+	time.Sleep(10 * time.Millisecond)
+
+	return r.fixableRule.Fix()
+}
+
+func TestRunRulesFixOneAtATime(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+	defer func(v bool) { vars.FixChecks = v }(vars.FixChecks)
+	vars.FixChecks = true
+
+	var running atomic.Int32
+	var concurrent atomic.Bool
+	var rules []validators.Rule
+	for _, name := range []string{"vfio", "numa", "selinux"} {
+		rule := fixableRule{staticRule: staticRule{name: name, level: constants.ValidationLevelError, err: errors.New("not configured")}}
+		rules = append(rules, &serialFixRule{rule, &running, &concurrent})
+	}
+	report := newValidationReport(types.RuntimeTypePodman)
+
+	// the rules are independent, so they are verified concurrently
+	if err := runRules(context.Background(), rules, nil, report); err != nil {
+		t.Fatalf("runRules() error = %v", err)
+	}
+	if concurrent.Load() {
+		t.Error("runRules() ran several fixes concurrently, want them run one at a time")
+	}
+	for _, check := range report.Checks {
+		if check.Status != CheckStatusPassed || !check.Fixed {
+			t.Errorf("check %s = %s fixed %t, want passed once fixed", check.Name, check.Status, check.Fixed)
+		}
+	}
+}
+
+// remoteHost is a remote host read from an in-memory filesystem.
+type remoteHost struct {
+	hostfs.Fake
+}
+
+func (remoteHost) Target() string { return "root@lpar1" }
+func (remoteHost) Arch() string   { return "ppc64le" }
+
+// hostRule reads the host through hostfs, so that it verifies a remote host as well.
+type hostRule struct {
+	staticRule
+}
+
+func (r *hostRule) ReadsHost() {}
+
+func TestRunRulesRemoteHost(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	restore := hostfs.Substitute(remoteHost{})
+	defer restore()
+
+	rules := []validators.Rule{
+		&hostRule{staticRule{name: "power", level: constants.ValidationLevelError}},
+		&staticRule{name: "root", level: constants.ValidationLevelError, err: errors.New("not root")},
+	}
+
+	report := newValidationReport(types.RuntimeTypePodman)
+	if err := runRules(context.Background(), rules, nil, report); err != nil {
+		t.Fatalf("runRules() error = %v, want the local check skipped", err)
+	}
+	if report.Host != "root@lpar1" {
+		t.Errorf("report host = %q, want root@lpar1", report.Host)
+	}
+
+	statuses := map[string]CheckStatus{}
+	for _, check := range report.Checks {
+		statuses[check.Name] = check.Status
+	}
+	if statuses["power"] != CheckStatusPassed || statuses["root"] != CheckStatusSkipped {
+		t.Errorf("check statuses = %v, want power passed and root skipped", statuses)
+	}
+}
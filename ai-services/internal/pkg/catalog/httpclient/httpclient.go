@@ -14,18 +14,31 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
 // checkResponseError validates the HTTP response status code and returns an
 // error if it's outside the 2xx range. For error responses, it attempts to
 // extract a descriptive message from the JSON body before falling back to
 // the raw status code. For successful responses, the body is left untouched
-// for the caller to process.
+// for the caller to process. A rate limited response carries the delay the
+// server asked to wait before retrying, see utils.RetryAfterOf.
 func checkResponseError(resp *http.Response) error {
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		return nil
 	}
 
+	err := responseError(resp)
+	if utils.IsThrottled(resp) {
+		return utils.WithRetryAfter(err, utils.RetryAfter(resp))
+	}
+
+	return err
+}
+
+// responseError returns the error of the response, with the message of its JSON body if any.
+func responseError(resp *http.Response) error {
 	// Only read body for error responses (typically small)
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -70,7 +83,7 @@ type HTTPClient struct {
 func New(serverURL string) *HTTPClient {
 	return &HTTPClient{
 		serverURL:  serverURL,
-		httpClient: &http.Client{Timeout: defaultTimeout},
+		httpClient: utils.NewHTTPClient(defaultTimeout),
 	}
 }
 
@@ -0,0 +1,205 @@
+package checksum
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// algorithmSHA256 is the only supported algorithm, as the prefix of the checksums, Eg:- sha256:<hex>.
+const algorithmSHA256 = "sha256"
+
+// ErrMismatch is matched with errors.Is by the error of a content not matching its expected checksum.
+var ErrMismatch = errors.New("checksum mismatch")
+
+// Parse validates the checksum given as sha256:<hex>, returning its lowercase hex digest.
+func Parse(checksum string) (string, error) {
+	algorithm, digest, found := strings.Cut(checksum, ":")
+	if !found || algorithm != algorithmSHA256 {
+		return "", fmt.Errorf("invalid checksum '%s', expected %s:<hex digest>", checksum, algorithmSHA256)
+	}
+
+	digest = strings.ToLower(digest)
+	if decoded, err := hex.DecodeString(digest); err != nil || len(decoded) != sha256.Size {
+		return "", fmt.Errorf("invalid checksum '%s', expected %s:<hex digest> of %d hex characters", checksum, algorithmSHA256, 2*sha256.Size)
+	}
+
+	return digest, nil
+}
+
+// Of returns the checksum of data, as sha256:<hex>.
+func Of(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return algorithmSHA256 + ":" + hex.EncodeToString(sum[:])
+}
+
+// Verify fails with ErrMismatch when data does not have the expected checksum, given as sha256:<hex>.
+func Verify(data []byte, expected string) error {
+	digest, err := Parse(expected)
+	if err != nil {
+		return err
+	}
+
+	if actual := Of(data); actual != algorithmSHA256+":"+digest {
+		return fmt.Errorf("%w: expected %s:%s, got %s", ErrMismatch, algorithmSHA256, digest, actual)
+	}
+
+	return nil
+}
+
+// SidecarSuffix is the suffix of the sidecar file holding the checksum of the file it is named after,
+// Eg:- rag.zip.sha256 for rag.zip, as written by sha256sum.
+const SidecarSuffix = ".sha256"
+
+// ParseSidecar parses the content of a sidecar file, either the bare hex digest or the sha256sum output line
+// of the file, returning its checksum as sha256:<hex>.
+func ParseSidecar(data []byte) (string, error) {
+	fields := strings.Fields(string(data))
+	if len(fields) == 0 {
+		return "", errors.New("empty checksum file")
+	}
+
+	digest, err := Parse(algorithmSHA256 + ":" + fields[0])
+	if err != nil {
+		return "", fmt.Errorf("invalid checksum file: %w", err)
+	}
+
+	return algorithmSHA256 + ":" + digest, nil
+}
+
+// OfDir returns the checksum of the directory tree, as sha256:<hex>. It is the checksum of the sha256sum output lines
+// of its files sorted by path, leaving out the sidecar files and the hidden ones, Eg:- the download cache of a model:
+//
+//	find . -type f ! -name '*.sha256' ! -path '*/.*' | cut -c3- | LC_ALL=C sort | xargs sha256sum | sha256sum
+func OfDir(dir string) (string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p != dir && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+		if d.Type().IsRegular() && !strings.HasSuffix(p, SidecarSuffix) {
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return err
+			}
+			files = append(files, filepath.ToSlash(rel))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	slices.Sort(files)
+
+	h := sha256.New()
+	for _, name := range files {
+		digest, err := fileDigest(filepath.Join(dir, name))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s  %s\n", digest, name)
+	}
+
+	return algorithmSHA256 + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyDirChecksum fails with ErrMismatch when the directory tree does not have the expected checksum, given as
+// sha256:<hex> and computed as per OfDir.
+func VerifyDirChecksum(dir, expected string) error {
+	digest, err := Parse(expected)
+	if err != nil {
+		return err
+	}
+
+	actual, err := OfDir(dir)
+	if err != nil {
+		return err
+	}
+	if actual != algorithmSHA256+":"+digest {
+		return fmt.Errorf("%s: %w: expected %s:%s, got %s", dir, ErrMismatch, algorithmSHA256, digest, actual)
+	}
+
+	return nil
+}
+
+// fileDigest returns the hex digest of the file, hashed as it is read.
+func fileDigest(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyFile fails with ErrMismatch when the file does not have the expected checksum, given as sha256:<hex>.
+// The file is hashed as it is read, as it can be too large to be held in memory, Eg:- the weights of a model.
+func VerifyFile(name, expected string) error {
+	digest, err := Parse(expected)
+	if err != nil {
+		return err
+	}
+
+	actual, err := fileDigest(name)
+	if err != nil {
+		return err
+	}
+	if actual != digest {
+		return fmt.Errorf("%s: %w: expected %s:%s, got %s:%s", name, ErrMismatch, algorithmSHA256, digest, algorithmSHA256, actual)
+	}
+
+	return nil
+}
+
+// VerifyDir verifies every file of the directory tree shipped along with a sidecar file, returning how many were verified.
+// The files without a sidecar are left unverified.
+func VerifyDir(dir string) (int, error) {
+	verified := 0
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(p, SidecarSuffix) {
+			return nil
+		}
+
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		expected, err := ParseSidecar(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+		if err := VerifyFile(strings.TrimSuffix(p, SidecarSuffix), expected); err != nil {
+			return err
+		}
+		verified++
+
+		return nil
+	})
+
+	return verified, err
+}
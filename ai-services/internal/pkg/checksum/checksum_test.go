@@ -0,0 +1,117 @@
+package checksum
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// helloSHA256 is the sha256 digest of "hello".
+const helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestVerify(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		wantErr  string
+		mismatch bool
+	}{
+		{name: "matching", expected: "sha256:" + helloSHA256},
+		{name: "matching uppercase", expected: "sha256:" + strings.ToUpper(helloSHA256)},
+		{name: "mismatching", expected: "sha256:" + strings.Repeat("0", 64), wantErr: "checksum mismatch", mismatch: true},
+		{name: "missing algorithm", expected: helloSHA256, wantErr: "invalid checksum"},
+		{name: "unsupported algorithm", expected: "md5:5d41402abc4b2a76b9719d911017c592", wantErr: "invalid checksum"},
+		{name: "truncated digest", expected: "sha256:2cf24dba", wantErr: "of 64 hex characters"},
+		{name: "not hex", expected: "sha256:" + strings.Repeat("z", 64), wantErr: "invalid checksum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Verify([]byte("hello"), tt.expected)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Verify() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Verify() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrMismatch) != tt.mismatch {
+				t.Errorf("errors.Is(err, ErrMismatch) = %v, want %v", !tt.mismatch, tt.mismatch)
+			}
+		})
+	}
+}
+
+func TestVerifyDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("config.json", "hello")
+	write("config.json.sha256", helloSHA256+"  config.json\n")
+	write("shards/model-00001.safetensors", "hello")
+	write("shards/model-00001.safetensors.sha256", helloSHA256)
+	write("README.md", "no sidecar")
+
+	verified, err := VerifyDir(dir)
+	if err != nil || verified != 2 {
+		t.Fatalf("VerifyDir() = %d, %v, want the 2 files with a sidecar verified", verified, err)
+	}
+
+	write("shards/model-00001.safetensors", "tampered")
+	if _, err := VerifyDir(dir); !errors.Is(err, ErrMismatch) {
+		t.Errorf("VerifyDir() error = %v, want a checksum mismatch", err)
+	}
+
+	write("shards/model-00001.safetensors.sha256", "")
+	if _, err := VerifyDir(dir); err == nil || !strings.Contains(err.Error(), "empty checksum file") {
+		t.Errorf("VerifyDir() error = %v, want the empty sidecar to be rejected", err)
+	}
+}
+
+func TestOfDir(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		p := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("config.json", "hello\n")
+	write("sub/model.safetensors", "w\n")
+	// the sidecars and the hidden files, Eg:- the download cache, are left out
+	write("config.json.sha256", "abc\n")
+	write(".cache/meta", "x\n")
+
+	// as computed by: find . -type f ! -name '*.sha256' ! -path '*/.*' | cut -c3- | LC_ALL=C sort | xargs sha256sum | sha256sum
+	want := "sha256:c62ebb84c87688f54b7792ef24b03057619b68732c78130560517b9a78499929"
+	if got, err := OfDir(dir); err != nil || got != want {
+		t.Fatalf("OfDir() = %s, %v, want %s", got, err, want)
+	}
+	if err := VerifyDirChecksum(dir, want); err != nil {
+		t.Errorf("VerifyDirChecksum() error = %v, want nil", err)
+	}
+
+	write("sub/model.safetensors", "tampered\n")
+	if err := VerifyDirChecksum(dir, want); !errors.Is(err, ErrMismatch) {
+		t.Errorf("VerifyDirChecksum() error = %v, want a checksum mismatch", err)
+	}
+}
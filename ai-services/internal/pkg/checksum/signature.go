@@ -0,0 +1,43 @@
+package checksum
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+)
+
+// SignatureSuffix is the suffix of the sidecar file holding the cosign signature of the file it is named after,
+// Eg:- rag.zip.sig for rag.zip, as written by cosign sign-blob.
+const SignatureSuffix = ".sig"
+
+// ErrInvalidSignature is matched with errors.Is by the error of a content not signed by the expected key.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// VerifySignature verifies the cosign signature of data, as written by "cosign sign-blob --key", against the PEM public key.
+// The signature is base64 encoded by cosign, a raw one is accepted too.
+func VerifySignature(data, sig, publicKeyPEM []byte) error {
+	publicKey, err := cryptoutils.UnmarshalPEMToPublicKey(publicKeyPEM)
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	verifier, err := signature.LoadVerifier(publicKey, crypto.SHA256)
+	if err != nil {
+		return fmt.Errorf("unsupported public key: %w", err)
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(sig))); err == nil {
+		sig = decoded
+	}
+	if err := verifier.VerifySignature(bytes.NewReader(sig), bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidSignature, err)
+	}
+
+	return nil
+}
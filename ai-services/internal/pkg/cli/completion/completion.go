@@ -0,0 +1,195 @@
+package completion
+
+import (
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/application/common"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+)
+
+// runtimeType returns the runtime selected via the --runtime flag, or else the one detected from the environment.
+// The root PersistentPreRunE is not executed while completing, so vars.RuntimeFactory cannot be used here.
+func runtimeType(cmd *cobra.Command) types.RuntimeType {
+	if f := cmd.Flag("runtime"); f != nil && f.Changed {
+		if rt := types.RuntimeType(f.Value.String()); rt.Valid() {
+			return rt
+		}
+	}
+
+	return runtime.DefaultRuntimeType()
+}
+
+// TemplateNames completes the application template names offered for the selected runtime.
+func TemplateNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: runtimeType(cmd)})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	apps, err := tp.ListApplications(false)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	sort.Strings(apps)
+
+	return apps, cobra.ShellCompDirectiveNoFileComp
+}
+
+// TemplateVersions completes the versions of the application template selected via the --template flag.
+func TemplateVersions(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	f := cmd.Flag("template")
+	if f == nil || f.Value.String() == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: runtimeType(cmd)})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	app, err := tp.ResolveApplication(f.Value.String())
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	versions, err := tp.ListVersions(app)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	return append([]string{templates.LatestVersion}, versions...), cobra.ShellCompDirectiveNoFileComp
+}
+
+// TemplateCategories completes the categories of the application templates offered for the selected runtime.
+func TemplateCategories(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{Runtime: runtimeType(cmd)})
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	apps, err := tp.ListApplications(false)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	categories := []string{}
+	for _, app := range apps {
+		md, err := tp.LoadMetadata(app, false)
+		if err != nil || md.Category == "" || slices.Contains(categories, md.Category) {
+			continue
+		}
+		categories = append(categories, md.Category)
+	}
+	sort.Strings(categories)
+
+	return categories, cobra.ShellCompDirectiveNoFileComp
+}
+
+// TemplateNameArg completes a single template name positional argument.
+func TemplateNameArg(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return TemplateNames(cmd, args, toComplete)
+}
+
+// ValidationChecks completes the validation check names registered for the selected runtime.
+// Supports comma separated values, completing only the last entry.
+func ValidationChecks(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	registry := validators.PodmanRegistry
+	if runtimeType(cmd) == types.RuntimeTypeOpenShift {
+		registry = validators.OpenshiftRegistry
+	}
+
+	prefix := ""
+	if idx := strings.LastIndex(toComplete, ","); idx >= 0 {
+		prefix = toComplete[:idx+1]
+	}
+
+	rules := registry.Rules()
+	checks := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		checks = append(checks, prefix+rule.Name()+"\t"+rule.Description())
+	}
+
+	return checks, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// ValidationProfiles completes the names of the validation profiles.
+func ValidationProfiles(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	profiles := make([]string, 0, len(validators.Profiles))
+	for _, p := range validators.Profiles {
+		profiles = append(profiles, p.Name+"\t"+p.Description)
+	}
+
+	return profiles, cobra.ShellCompDirectiveNoFileComp
+}
+
+// ConfigureSteps completes the bootstrap configuration step names of the selected runtime.
+// Supports comma separated values, completing only the last entry.
+func ConfigureSteps(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	bootstrapInstance, err := bootstrap.NewBootstrapFactory(runtimeType(cmd)).Create()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	prefix := ""
+	if idx := strings.LastIndex(toComplete, ","); idx >= 0 {
+		prefix = toComplete[:idx+1]
+	}
+
+	all := bootstrapInstance.Steps()
+	names := make([]string, 0, len(all))
+	for _, step := range all {
+		names = append(names, prefix+step.Name+"\t"+step.Description)
+	}
+
+	return names, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+}
+
+// ApplicationNames completes the names of the applications deployed via the selected runtime.
+// Only the first positional argument is completed.
+func ApplicationNames(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	// applications on openshift live in their own namespace, and cannot be listed from a single one
+	if runtimeType(cmd) != types.RuntimeTypePodman {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	rt, err := runtime.CreateRuntime(types.RuntimeTypePodman, "")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	pods, err := common.FetchFilteredPods(rt, "")
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+
+	seen := map[string]bool{}
+	apps := []string{}
+	for _, pod := range pods {
+		app := pod.Labels[constants.ApplicationAnnotationKey]
+		if app == "" || seen[app] {
+			continue
+		}
+		seen[app] = true
+		apps = append(apps, app)
+	}
+	sort.Strings(apps)
+
+	return apps, cobra.ShellCompDirectiveNoFileComp
+}
@@ -3,42 +3,75 @@ package application
 // CreateFlags contains all flag names for the 'application create' command.
 type CreateFlags struct {
 	// Common flags - valid for all runtimes
-	SkipValidation string
-	Template       string
-	Params         string
-	Values         string
+	SkipValidation  string
+	Template        string
+	TemplateVersion string
+	TemplateURL     string
+	Checksum        string
+	PublicKey       string
+	Insecure        string
+	Params          string
+	ParamsEnv       string
+	SetJSON         string
+	Set             string
+	SetString       string
+	Values          string
+	Label           string
+	Annotation      string
+	DumpManifests   string
 
 	// Podman-specific flags
 	SkipImageDownload string
 	SkipModelDownload string
+	WaitForModel      string
 	ImagePullPolicy   string
+	FromManifest      string
 
 	// OpenShift-specific flags
-	Timeout string
+	Timeout      string
+	ApplyTimeout string
+	DryRun       string
 }
 
 // Create holds the flag constants for the 'application create' command.
 var Create = CreateFlags{
 	// Common flags - valid for all runtimes
-	SkipValidation: "skip-validation",
-	Template:       "template",
-	Params:         "params",
-	Values:         "values",
+	SkipValidation:  "skip-validation",
+	Template:        "template",
+	TemplateVersion: "template-version",
+	TemplateURL:     "template-url",
+	Checksum:        "checksum",
+	PublicKey:       "public-key",
+	Insecure:        "insecure",
+	Params:          "params",
+	ParamsEnv:       "params-env",
+	SetJSON:         "set-json",
+	Set:             "set",
+	SetString:       "set-string",
+	Values:          "values",
+	Label:           "label",
+	Annotation:      "annotation",
+	DumpManifests:   "dump-manifests",
 
 	// Podman-specific flags
 	SkipImageDownload: "skip-image-download",
 	SkipModelDownload: "skip-model-download",
+	WaitForModel:      "wait-for-model",
 	ImagePullPolicy:   "image-pull-policy",
+	FromManifest:      "from-manifest",
 
 	// OpenShift-specific flags
-	Timeout: "timeout",
+	Timeout:      "timeout",
+	ApplyTimeout: "apply-timeout",
+	DryRun:       "dry-run",
 }
 
 // DeleteFlags contains all flag names for the 'application delete' command.
 type DeleteFlags struct {
 	// Common flags - valid for all runtimes
-	SkipCleanup string
-	AutoYes     string
+	SkipCleanup    string
+	AutoYes        string
+	IgnoreNotFound string
 
 	// OpenShift-specific flags
 	Timeout string
@@ -47,8 +80,9 @@ type DeleteFlags struct {
 // Delete holds the flag constants for the 'application delete' command.
 var Delete = DeleteFlags{
 	// Common flags
-	SkipCleanup: "skip-cleanup",
-	AutoYes:     "yes",
+	SkipCleanup:    "skip-cleanup",
+	AutoYes:        "yes",
+	IgnoreNotFound: "ignore-not-found",
 
 	// OpenShift-specific flags
 	Timeout: "timeout",
@@ -59,12 +93,27 @@ type LogsFlags struct {
 	// Common flags - valid for all runtimes
 	Pod       string
 	Container string
+	Since     string
 }
 
 // Logs holds the flag constants for the 'application logs' command.
 var Logs = LogsFlags{
 	Pod:       "pod",
 	Container: "container",
+	Since:     "since",
+}
+
+// ExecFlags contains all flag names for the 'application exec' command.
+type ExecFlags struct {
+	// Common flags - valid for all runtimes
+	Pod       string
+	Container string
+}
+
+// Exec holds the flag constants for the 'application exec' command.
+var Exec = ExecFlags{
+	Pod:       "pod",
+	Container: "container",
 }
 
 // PsFlags contains all flag names for the 'application ps' command.
@@ -78,4 +127,90 @@ var Ps = PsFlags{
 	Output: "output",
 }
 
+// HealthFlags contains all flag names for the 'application health' command.
+type HealthFlags struct {
+	// Common flags - valid for all runtimes
+	Output    string
+	Watch     string
+	Interval  string
+	WaitReady string
+}
+
+// Health holds the flag constants for the 'application health' command.
+var Health = HealthFlags{
+	Output:    "output",
+	Watch:     "watch",
+	Interval:  "interval",
+	WaitReady: "wait-ready",
+}
+
+// TemplatesFlags contains all flag names for the 'application templates' command.
+type TemplatesFlags struct {
+	// Common flags - valid for all runtimes
+	Category string
+	Output   string
+}
+
+// Templates holds the flag constants for the 'application templates' command.
+var Templates = TemplatesFlags{
+	Category: "category",
+	Output:   "output",
+}
+
+// TemplatesShowFlags contains all flag names for the 'application templates show' command.
+type TemplatesShowFlags struct {
+	// Common flags - valid for all runtimes
+	Output string
+}
+
+// TemplatesShow holds the flag constants for the 'application templates show' command.
+var TemplatesShow = TemplatesShowFlags{
+	Output: "output",
+}
+
+// TemplatesVerifyImagesFlags contains all flag names for the 'application templates verify-images' command.
+type TemplatesVerifyImagesFlags struct {
+	// Common flags - valid for all runtimes
+	RegistryPrefix string
+	Output         string
+}
+
+// TemplatesVerifyImages holds the flag constants for the 'application templates verify-images' command.
+var TemplatesVerifyImages = TemplatesVerifyImagesFlags{
+	RegistryPrefix: "registry-prefix",
+	Output:         "output",
+}
+
+// RollbackFlags contains all flag names for the 'application rollback' command.
+type RollbackFlags struct {
+	// Common flags - valid for all runtimes
+	AutoYes string
+
+	// OpenShift-specific flags
+	Timeout string
+}
+
+// Rollback holds the flag constants for the 'application rollback' command.
+var Rollback = RollbackFlags{
+	AutoYes: "yes",
+	Timeout: "timeout",
+}
+
+// DiffFlags contains all flag names for the 'application diff' command.
+type DiffFlags struct {
+	// Common flags - valid for all runtimes
+	Template  string
+	Params    string
+	ParamsEnv string
+	Values    string
+}
+
+// Diff holds the flag constants for the 'application diff' command.
+var Diff = DiffFlags{
+	Template:  "template",
+	Params:    "params",
+	ParamsEnv: "params-env",
+	Values:    "values",
+}
+
 // Made with Bob
@@ -0,0 +1,143 @@
+package errhints
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Known kinds of errors, which get an actionable hint printed along with the error by the CLI.
+var (
+	ErrNotRoot             = errors.New("current user is not root")
+	ErrUnsupportedPower    = errors.New("system is not running on IBM Power11")
+	ErrRegistryUnreachable = errors.New("image registry is unreachable")
+	// ErrServiceReportUnavailable is a servicereport tool missing from the tool image, or older than required.
+	ErrServiceReportUnavailable = errors.New("servicereport tool is unavailable")
+	// ErrArchMismatch is a podman host which cannot run the images, as they are built for another architecture.
+	ErrArchMismatch = errors.New("host architecture does not match the images")
+	// ErrLockHeld is a mutating operation started while another one holds the lock of the host.
+	ErrLockHeld = errors.New("another ai-services operation is in progress")
+)
+
+type hint struct {
+	matches func(err error) bool
+	text    string
+}
+
+// hints are checked in order, the first one matching the error is printed.
+var hints = []hint{
+	{
+		matches: isKind(ErrNotRoot),
+		text:    "Run this command with root privileges using 'sudo' or as the root user.",
+	},
+	{
+		matches: isKind(ErrUnsupportedPower),
+		text: "AI Services requires an IBM Power11 (ppc64le) LPAR. If this is a test system, " +
+			"the check can be skipped with 'ai-services bootstrap validate --skip-validation power'.",
+	},
+	{
+		matches: isKind(ErrRegistryUnreachable),
+		text: "The image registry cannot be reached from this host. Check the network and proxy settings, " +
+			"or configure a mirror of the registry in /etc/containers/registries.conf.d/ for disconnected environments.",
+	},
+	{
+		matches: apierrors.IsForbidden,
+		text: "The current OpenShift user lacks the permissions for this operation. Log in as a user with the " +
+			"required RBAC roles (e.g. cluster-admin for 'bootstrap configure'), and check them with 'oc auth can-i'.",
+	},
+	{
+		matches: isKind(ErrServiceReportUnavailable),
+		text: "The servicereport tool is provided by the servicereport package of the IBM Power Tools repository " +
+			"(dnf install servicereport). Pull the latest tool image, which ships it, or run with a tool image having it installed. " +
+			"An older version can be accepted with --min-servicereport-version.",
+	},
+	{
+		matches: isKind(ErrArchMismatch),
+		text: "The AI Services images are only built for IBM Power (ppc64le). Run the CLI on an IBM Power LPAR, " +
+			"or point CONTAINER_HOST to the podman service of one.",
+	},
+	{
+		matches: isKind(ErrLockHeld),
+		text: "Wait for the other operation to complete and retry. If it is hung, stop it, or take over its lock " +
+			"with --force-unlock once it is known to no longer change anything.",
+	},
+}
+
+func isKind(kind error) func(error) bool {
+	return func(err error) bool {
+		return errors.Is(err, kind)
+	}
+}
+
+// Hint returns the actionable hint for the given error, or an empty string when its kind is unknown.
+func Hint(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	for _, h := range hints {
+		if h.matches(err) {
+			return h.text
+		}
+	}
+
+	return ""
+}
+
+// markedError keeps the message of the error, while also matching its kind with errors.Is.
+type markedError struct {
+	err  error
+	kind error
+}
+
+func (e *markedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *markedError) Unwrap() []error {
+	return []error{e.err, e.kind}
+}
+
+// Mark marks err as being of the given kind, without changing its message.
+func Mark(err, kind error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &markedError{err: err, kind: kind}
+}
+
+// networkFailures are the messages of the dial failures, for the errors whose chain got lost on the way,
+// Eg:- the ones returned by the podman service.
+var networkFailures = []string{
+	"no such host",
+	"connection refused",
+	"i/o timeout",
+	"network is unreachable",
+	"no route to host",
+	"TLS handshake timeout",
+}
+
+// IsNetworkError reports whether the error is caused by a failure to reach a remote host.
+func IsNetworkError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || (errors.As(err, &opErr) && opErr.Op == "dial") {
+		return true
+	}
+
+	msg := err.Error()
+	for _, f := range networkFailures {
+		if strings.Contains(msg, f) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,72 @@
+package errhints
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestHint(t *testing.T) {
+	notRoot := Mark(errors.New("current user is not root (EUID: 1000)"), ErrNotRoot)
+	forbidden := apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "", errors.New("no RBAC policy matched"))
+
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{name: "marked error", err: notRoot, want: hints[0].text},
+		{name: "wrapped marked error", err: fmt.Errorf("bootstrap validation failed: root: %w", notRoot), want: hints[0].text},
+		{name: "joined errors", err: errors.Join(errors.New("numa: low affinity"), Mark(errors.New("unsupported architecture"), ErrUnsupportedPower)), want: hints[1].text},
+		{name: "registry unreachable", err: fmt.Errorf("failed to download image: %w", Mark(errors.New("dial tcp: i/o timeout"), ErrRegistryUnreachable)), want: hints[2].text},
+		{name: "rbac forbidden", err: fmt.Errorf("failed to list namespaces: %w", forbidden), want: hints[3].text},
+		{name: "servicereport unavailable", err: fmt.Errorf("servicereport: %w", Mark(errors.New("servicereport tool is not found"), ErrServiceReportUnavailable)), want: hints[4].text},
+		{name: "architecture mismatch", err: Mark(errors.New("podman host architecture amd64 does not match"), ErrArchMismatch), want: hints[5].text},
+		{name: "lock held", err: Mark(errors.New("another ai-services operation is in progress: pid 42"), ErrLockHeld), want: hints[6].text},
+		{name: "unknown error", err: errors.New("something else failed"), want: ""},
+		{name: "no error", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Hint(tt.err); got != tt.want {
+				t.Errorf("Hint() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkKeepsMessage(t *testing.T) {
+	err := Mark(errors.New("current user is not root (EUID: 1000)"), ErrNotRoot)
+	if err.Error() != "current user is not root (EUID: 1000)" {
+		t.Errorf("Mark() message = %q", err.Error())
+	}
+	if Mark(nil, ErrNotRoot) != nil {
+		t.Error("Mark(nil) should return nil")
+	}
+}
+
+func TestIsNetworkError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "dns error", err: fmt.Errorf("pull: %w", &net.DNSError{Err: "no such host", Name: "icr.io"}), want: true},
+		{name: "dial error", err: &net.OpError{Op: "dial", Err: errors.New("refused")}, want: true},
+		{name: "message of a remote error", err: errors.New("initializing source docker://icr.io/x: dial tcp 1.2.3.4:443: i/o timeout"), want: true},
+		{name: "manifest unknown", err: errors.New("manifest unknown"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNetworkError(tt.err); got != tt.want {
+				t.Errorf("IsNetworkError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
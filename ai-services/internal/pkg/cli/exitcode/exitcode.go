@@ -0,0 +1,73 @@
+package exitcode
+
+import (
+	"errors"
+	"sync/atomic"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+)
+
+// Exit codes of the CLI, distinct per class of failure so that the scripts can branch on them.
+const (
+	Success = 0
+	// Error is the exit code of the failures not belonging to a more specific class.
+	Error = 1
+	// ValidationFailed is the exit code when the validation checks failed, including the warnings treated as errors.
+	ValidationFailed = 2
+	// Usage is the exit code of the invalid commands, arguments and flags.
+	Usage = 3
+	// Timeout is the exit code when the overall deadline of the command is exceeded, the same as timeout(1).
+	Timeout = 124
+
+	// MaxCode is the highest exit code which can be configured, the higher ones being reserved by the shells.
+	MaxCode = 125
+)
+
+// Kinds of errors mapped to their exit code.
+var (
+	ErrValidationFailed = errors.New("validation failed")
+	ErrUsage            = errors.New("invalid usage")
+	ErrTimeout          = errors.New("timed out")
+)
+
+// warned is set when a validation passed with warnings.
+var warned atomic.Bool
+
+// MarkUsage marks err as a usage error, without changing its message.
+func MarkUsage(err error) error {
+	return errhints.Mark(err, ErrUsage)
+}
+
+// MarkValidationFailed marks err as a validation failure, without changing its message.
+func MarkValidationFailed(err error) error {
+	return errhints.Mark(err, ErrValidationFailed)
+}
+
+// MarkTimeout marks err as exceeding the overall deadline of the command, without changing its message.
+func MarkTimeout(err error) error {
+	return errhints.Mark(err, ErrTimeout)
+}
+
+// RecordWarnings records that the validation passed with warnings, which exits with the code given to Of.
+func RecordWarnings() {
+	warned.Store(true)
+}
+
+// Of returns the exit code for the error returned by the command.
+// A successful run exits with warnCode when the validation passed with warnings.
+func Of(err error, warnCode int) int {
+	switch {
+	case err == nil && warned.Load():
+		return warnCode
+	case err == nil:
+		return Success
+	case errors.Is(err, ErrTimeout):
+		return Timeout
+	case errors.Is(err, ErrUsage):
+		return Usage
+	case errors.Is(err, ErrValidationFailed):
+		return ValidationFailed
+	default:
+		return Error
+	}
+}
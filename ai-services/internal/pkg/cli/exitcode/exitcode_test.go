@@ -0,0 +1,44 @@
+package exitcode
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestOf(t *testing.T) {
+	defer warned.Store(false)
+
+	tests := []struct {
+		name     string
+		err      error
+		warned   bool
+		warnCode int
+		want     int
+	}{
+		{name: "success", want: Success},
+		{name: "success with warnings, default code", warned: true, want: Success},
+		{name: "success with warnings", warned: true, warnCode: 4, want: 4},
+		{name: "generic error", err: errors.New("failed to pull image"), want: Error},
+		{name: "usage error", err: MarkUsage(errors.New("unknown flag: --foo")), want: Usage},
+		{name: "wrapped validation failure", err: fmt.Errorf("bootstrap validation failed: %w", MarkValidationFailed(errors.New("2 validation check(s) failed"))), want: ValidationFailed},
+		{name: "timeout", err: MarkTimeout(fmt.Errorf("command timed out after 1m: %w", errors.New("context deadline exceeded"))), want: Timeout},
+		{name: "warning code does not apply to errors", err: errors.New("failed"), warned: true, warnCode: 4, want: Error},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warned.Store(tt.warned)
+			if got := Of(tt.err, tt.warnCode); got != tt.want {
+				t.Errorf("Of() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkKeepsMessage(t *testing.T) {
+	err := MarkUsage(errors.New("accepts 1 arg(s), received 0"))
+	if err.Error() != "accepts 1 arg(s), received 0" {
+		t.Errorf("Error() = %q, want the original message", err.Error())
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
 	runtimeTypes "github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 )
 
@@ -85,7 +86,7 @@ func (v *FlagValidator) Validate(cmd *cobra.Command) error {
 	}
 
 	if len(errors) > 0 {
-		return fmt.Errorf("flag validation failed:\n  - %s", strings.Join(errors, "\n  - "))
+		return exitcode.MarkUsage(fmt.Errorf("flag validation failed:\n  - %s", strings.Join(errors, "\n  - ")))
 	}
 
 	return nil
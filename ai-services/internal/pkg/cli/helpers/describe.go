@@ -0,0 +1,198 @@
+package helpers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// TemplateDetails holds the full details of a single application template.
+type TemplateDetails struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Category    string              `json:"category,omitempty"`
+	Version     string              `json:"version,omitempty"`
+	Versions    []string            `json:"versions,omitempty"`
+	Runtime     string              `json:"runtime"`
+	Hidden      bool                `json:"hidden,omitempty"`
+	SMTLevel    *int                `json:"smtLevel,omitempty"`
+	Parameters  []TemplateParameter `json:"parameters"`
+	Images      []string            `json:"images"`
+	SpyreCards  SpyreCardDetails    `json:"spyreCards"`
+}
+
+// TemplateParameter describes a single supported parameter of an application template.
+type TemplateParameter struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Type        string `json:"type"`
+	Default     any    `json:"default,omitempty"`
+	Required    bool   `json:"required"`
+}
+
+// SpyreCardDetails holds the number of spyre cards required by an application template.
+type SpyreCardDetails struct {
+	Total int `json:"total"`
+	// Minimum is the minimum number of spyre cards the application works with, none when zero.
+	Minimum int `json:"minimum,omitempty"`
+	// Containers maps "<pod>/<container>" to the spyre cards requested by it.
+	Containers map[string]int `json:"containers,omitempty"`
+}
+
+// DescribeTemplate gathers the metadata, parameters, container images and spyre card requirements
+// of the given application template.
+func DescribeTemplate(tp templates.Template, rt types.RuntimeType, name string) (*TemplateDetails, error) {
+	appMetadata, err := tp.LoadMetadata(name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load application metadata: %w", err)
+	}
+
+	details := &TemplateDetails{
+		Name:        name,
+		Description: appMetadata.Description,
+		Category:    appMetadata.Category,
+		Hidden:      appMetadata.Hidden,
+		SMTLevel:    appMetadata.SMTLevel,
+		Runtime:     rt.String(),
+		Images:      []string{},
+		SpyreCards:  SpyreCardDetails{Minimum: appMetadata.MinSpyreCards, Containers: map[string]int{}},
+	}
+
+	versions, err := tp.ListVersions(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the template versions: %w", err)
+	}
+	details.Versions = versions
+
+	params, err := describeParameters(tp, name, appMetadata.ParamTypes)
+	if err != nil {
+		return nil, err
+	}
+	details.Parameters = params
+
+	// pod templates and their runtime metadata only exist for podman, openshift ships helm charts instead
+	if rt != types.RuntimeTypePodman {
+		return details, nil
+	}
+
+	runtimeMetadata, err := tp.LoadMetadata(name, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load runtime metadata: %w", err)
+	}
+	details.Version = runtimeMetadata.Version
+
+	if err := describePodTemplates(tp, name, details); err != nil {
+		return nil, err
+	}
+
+	return details, nil
+}
+
+// RequiredParameters returns the parameters of the given application template marked as required, sorted by name.
+func RequiredParameters(tp templates.Template, name string) ([]TemplateParameter, error) {
+	appMetadata, err := tp.LoadMetadata(name, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load application metadata: %w", err)
+	}
+
+	params, err := describeParameters(tp, name, appMetadata.ParamTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	required := []TemplateParameter{}
+	for _, param := range params {
+		if param.Required {
+			required = append(required, param)
+		}
+	}
+
+	return required, nil
+}
+
+func describeParameters(tp templates.Template, name string, paramTypes map[string]templates.ParamType) ([]TemplateParameter, error) {
+	descriptions, err := tp.ListApplicationTemplateValues(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list application template values: %w", err)
+	}
+
+	required, err := tp.ListRequiredApplicationTemplateValues(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list required application template values: %w", err)
+	}
+
+	values, err := tp.LoadValues(name, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load application template values: %w", err)
+	}
+
+	keys := utils.ExtractMapKeys(descriptions)
+	for key := range required {
+		if _, ok := descriptions[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	params := make([]TemplateParameter, 0, len(keys))
+	for _, key := range keys {
+		defaultValue, _ := utils.GetNestedValue(values, key)
+		paramType := paramTypes[key]
+		if paramType == "" {
+			paramType = templates.ParamTypeString
+		}
+		params = append(params, TemplateParameter{
+			Name:        key,
+			Description: descriptions[key],
+			Type:        string(paramType),
+			Default:     defaultValue,
+			Required:    required[key],
+		})
+	}
+
+	return params, nil
+}
+
+func describePodTemplates(tp templates.Template, name string, details *TemplateDetails) error {
+	tmpls, err := tp.LoadAllTemplates(name)
+	if err != nil {
+		return fmt.Errorf("error loading templates for %s: %w", name, err)
+	}
+
+	images := []string{}
+	for _, tmpl := range tmpls {
+		// template name is used as a placeholder application name for rendering
+		ps, err := tp.LoadPodTemplateWithValues(name, tmpl.Name(), name, nil, nil)
+		if err != nil {
+			return fmt.Errorf("error loading pod template: %w", err)
+		}
+
+		for _, container := range ps.Spec.Containers {
+			images = append(images, container.Image)
+		}
+
+		for key, val := range ps.Annotations {
+			matches := vars.SpyreCardAnnotationRegex.FindStringSubmatch(key)
+			if matches == nil {
+				continue
+			}
+
+			count, err := strconv.Atoi(val)
+			if err != nil {
+				return fmt.Errorf("invalid spyre card count '%s' in annotation '%s': %w", val, key, err)
+			}
+			details.SpyreCards.Containers[ps.Name+"/"+matches[1]] = count
+			details.SpyreCards.Total += count
+		}
+	}
+
+	details.Images = utils.UniqueSlice(images)
+	sort.Strings(details.Images)
+
+	return nil
+}
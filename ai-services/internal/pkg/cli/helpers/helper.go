@@ -1,7 +1,11 @@
 package helpers
 
 import (
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -11,72 +15,62 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
-	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/selector"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 const (
-	inspectPollInterval = 10 * time.Second
+	// inspectPollInterval is the initial interval between two inspections of the containers,
+	// growing with backoff up to inspectMaxPollInterval.
+	inspectPollInterval    = 2 * time.Second
+	inspectMaxPollInterval = 10 * time.Second
 )
 
-func WaitForContainerReadiness(runtime runtime.Runtime, containerNameOrId string, timeout time.Duration) error {
-	var containerStatus *types.Container
-	var err error
+// WaitForContainerReadiness waits until the container is healthy within the specified timeout, or the context is done.
+func WaitForContainerReadiness(ctx context.Context, runtime runtime.Runtime, containerNameOrId string, timeout time.Duration) error {
+	defer trace.Start("wait for the readiness of container " + containerNameOrId)()
 
-	deadline := time.Now().Add(timeout)
-
-	for {
+	err := utils.WaitForWithMaxInterval(ctx, inspectPollInterval, inspectMaxPollInterval, timeout, func() (bool, error) {
 		// fetch the container status
-		containerStatus, err = runtime.InspectContainer(containerNameOrId)
+		containerStatus, err := runtime.InspectContainer(containerNameOrId)
 		if err != nil {
-			return fmt.Errorf("failed to check container status: %w", err)
+			return false, fmt.Errorf("failed to check container status: %w", err)
 		}
 
 		healthStatus := containerStatus.Health
 
-		if healthStatus == "" {
-			return nil
-		}
-
-		if healthStatus == string(constants.Ready) {
-			return nil
-		}
-
-		// if deadline exceeds, stop the container readiness check
-		if time.Now().After(deadline) {
-			return fmt.Errorf("operation timed out waiting for container readiness")
-		}
-
-		// every 10 seconds inspect the container
-		time.Sleep(inspectPollInterval)
+		return healthStatus == "" || healthStatus == string(constants.Ready), nil
+	})
+	if errors.Is(err, utils.ErrWaitTimeout) {
+		return fmt.Errorf("operation timed out waiting for container readiness: %w", err)
 	}
+
+	return err
 }
 
-// WaitForContainersCreation waits until all the containers in the provided podID are created within the specified timeout.
-func WaitForContainersCreation(runtime runtime.Runtime, podID string, expectedContainerCount int, timeout time.Duration) error {
-	deadline := time.Now().Add(timeout)
+// WaitForContainersCreation waits until all the containers in the provided podID are created within the specified timeout,
+// or the context is done.
+func WaitForContainersCreation(ctx context.Context, runtime runtime.Runtime, podID string, expectedContainerCount int, timeout time.Duration) error {
+	defer trace.Start("wait for the containers of pod " + podID)()
 
-	for {
+	err := utils.WaitForWithMaxInterval(ctx, inspectPollInterval, inspectMaxPollInterval, timeout, func() (bool, error) {
 		// fetch the pod info
 		pInfo, err := runtime.InspectPod(podID)
 		if err != nil {
-			return fmt.Errorf("failed to do pod inspect for podID: %s with error: %w", podID, err)
+			return false, fmt.Errorf("failed to do pod inspect for podID: %s with error: %w", podID, err)
 		}
 
 		// if the expected count is reached, then all the containers are created
 		// Note: Adding +1 to the expectedContainerCount as there is an additional 'infra' container added to all pods by podman
-		if len(pInfo.Containers) == expectedContainerCount+1 {
-			return nil
-		}
-
-		// if deadline exceeds, stop the container creation check
-		if time.Now().After(deadline) {
-			return fmt.Errorf("operation timed out waiting for container creation")
-		}
-
-		// every 10 seconds inspect the pod
-		time.Sleep(inspectPollInterval)
+		return len(pInfo.Containers) == expectedContainerCount+1, nil
+	})
+	if errors.Is(err, utils.ErrWaitTimeout) {
+		return fmt.Errorf("operation timed out waiting for container creation: %w", err)
 	}
+
+	return err
 }
 
 func FetchContainerStartPeriod(runtime runtime.Runtime, containerNameOrId string) (time.Duration, error) {
@@ -151,7 +145,9 @@ func FindFreeSpyreCards() ([]string, error) {
 	return free_spyre_dev_id_list, nil
 }
 
-func RunServiceReportContainer(runCmd string, mode string) error {
+// RunServiceReportContainer runs the command in the servicereport container of the given mode, streaming its output
+// and returning it, Eg:- to parse the actions recommended by servicereport.
+func RunServiceReportContainer(runCmd string, mode string) (string, error) {
 	var svc_tool_cmd *exec.Cmd
 	switch mode {
 	case "configure":
@@ -186,17 +182,18 @@ func RunServiceReportContainer(runCmd string, mode string) error {
 			"bash", "-c", runCmd,
 		)
 	default:
-		return fmt.Errorf("invalid mode passed. Allowed options are configure, validate")
+		return "", fmt.Errorf("invalid mode passed. Allowed options are configure, validate")
 	}
 
-	svc_tool_cmd.Stdout = os.Stdout
+	var out bytes.Buffer
+	svc_tool_cmd.Stdout = io.MultiWriter(os.Stdout, &out)
 	svc_tool_cmd.Stderr = os.Stderr
 
 	if err := svc_tool_cmd.Run(); err != nil {
-		return fmt.Errorf("failed to run servicereport tool to validate Spyre cards configuration: %v", err)
+		return out.String(), fmt.Errorf("failed to run servicereport tool to validate Spyre cards configuration: %v", err)
 	}
 
-	return nil
+	return out.String(), nil
 }
 
 func ParseSkipChecks(skipChecks []string) map[string]bool {
@@ -218,9 +215,7 @@ func ParseSkipChecks(skipChecks []string) map[string]bool {
 func CheckExistingPodsForApplication(runtime runtime.Runtime, appName string) ([]string, error) {
 	//nolint:prealloc // as capacity is unknown and depends on runtime.ListPods response
 	var podsToSkip []string
-	pods, err := runtime.ListPods(map[string][]string{
-		"label": {fmt.Sprintf("ai-services.io/application=%s", appName)},
-	})
+	pods, err := runtime.ListPods(selector.ForApplication(appName).Filters())
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -4,17 +4,25 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/checksum"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"golang.org/x/sync/errgroup"
 )
 
 func ListModels(template, appName string) ([]string, error) {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error loading templates: %w", err)
+	}
+
 	tmpls, err := tp.LoadAllTemplates(template)
 	if err != nil {
 		return nil, fmt.Errorf("error loading templates for %s: %w", template, err)
@@ -43,7 +51,47 @@ func ListModels(template, appName string) ([]string, error) {
 	return modelList, nil
 }
 
+// DownloadModel downloads the given model into targetDir, attached to the terminal.
 func DownloadModel(model, targetDir string) error {
+	return downloadModel(model, targetDir, 0, true, "")
+}
+
+// DownloadModels downloads the given models into targetDir, using at most concurrency parallel download streams in total.
+// The streams are shared between the models downloaded in parallel, so a single model still downloads its
+// shards in parallel, whereas many models are downloaded side by side with fewer streams each.
+// The models given a checksum in checksums, as sha256:<hex> computed by checksum.OfDir, fail on a mismatch.
+func DownloadModels(models []string, targetDir string, concurrency int, checksums map[string]string) error {
+	if concurrency < 1 {
+		return fmt.Errorf("invalid concurrency %d, must be at least 1", concurrency)
+	}
+	if len(models) == 0 {
+		return nil
+	}
+
+	parallel := min(concurrency, len(models))
+	workers := concurrency / parallel
+	// the terminal can only be attached to a single download
+	interactive := parallel == 1
+
+	var g errgroup.Group
+	g.SetLimit(parallel)
+	for _, model := range models {
+		g.Go(func() error {
+			if err := downloadModel(model, targetDir, workers, interactive, checksums[model]); err != nil {
+				return fmt.Errorf("model %s: %w", model, err)
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait()
+}
+
+// downloadModel runs the download of a single model via the tool image.
+// workers bounds the parallel download streams of the model, 0 leaves the hf default.
+// The model directory is verified against expected, as sha256:<hex>, unless empty.
+func downloadModel(model, targetDir string, workers int, interactive bool, expected string) error {
 	// check for target model directory, if not present create it
 	if _, err := os.Stat(targetDir); os.IsNotExist(err) {
 		err := os.MkdirAll(targetDir, os.ModePerm)
@@ -54,9 +102,11 @@ func DownloadModel(model, targetDir string) error {
 	logger.Infof("Downloading model %s to %s\n", model, targetDir)
 	command := "podman"
 	// All arguments must be passed as a slice of strings
-	args := []string{
-		"run",
-		"-ti",
+	args := []string{"run"}
+	if interactive {
+		args = append(args, "-ti")
+	}
+	args = append(args,
 		"-v",
 		fmt.Sprintf("%s:/models:Z", targetDir),
 		vars.ToolImage,
@@ -65,16 +115,35 @@ func DownloadModel(model, targetDir string) error {
 		model,
 		"--local-dir",
 		fmt.Sprintf("/models/%s", model),
+	)
+	if workers > 0 {
+		args = append(args, "--max-workers", strconv.Itoa(workers))
 	}
 	cmd := exec.Command(command, args...)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
-	cmd.Stdin = os.Stdin
+	if interactive {
+		cmd.Stdin = os.Stdin
+	}
 	err := cmd.Run()
 	if err != nil {
 		return fmt.Errorf("failed to execute command: %w", err)
 	}
-	logger.Infoln("Model downloaded successfully")
+	// the mirrors ship a .sha256 sidecar along with the model files to protect them against tampering or corruption
+	verified, err := checksum.VerifyDir(filepath.Join(targetDir, model))
+	if err != nil {
+		return fmt.Errorf("failed to verify the downloaded model: %w", err)
+	}
+	if verified > 0 {
+		logger.Infof("Verified the checksum of %d files of model %s\n", verified, model, logger.VerbosityLevelDebug)
+	}
+	if expected != "" {
+		if err := checksum.VerifyDirChecksum(filepath.Join(targetDir, model), expected); err != nil {
+			return fmt.Errorf("failed to verify the downloaded model: %w", err)
+		}
+		logger.Infof("Verified the checksum of model %s\n", model, logger.VerbosityLevelDebug)
+	}
+	logger.Infof("Model %s downloaded successfully\n", model)
 
 	return nil
 }
@@ -173,9 +173,12 @@ func fetchDataSpecificInfo(data any, format string, defaultValue *string) (strin
 }
 
 func renderStepsMarkdown(runtime runtime.Runtime, appTemplate string, params map[string]string, mdFile, title string) error {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{
 		Runtime: runtime.Type(),
 	})
+	if err != nil {
+		return fmt.Errorf("failed to load templates: %w", err)
+	}
 
 	tmpls, err := tp.LoadMdFiles(appTemplate)
 	if err != nil {
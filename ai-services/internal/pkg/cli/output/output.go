@@ -0,0 +1,175 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"go.yaml.in/yaml/v3"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/deprecation"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// Format is the format of the structured output selected with --output.
+type Format string
+
+const (
+	// FormatText is the human readable output, selected when --output is not set.
+	FormatText Format = ""
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+)
+
+const yamlIndent = 2
+
+// Parse parses the value of an --output flag, case insensitively.
+func Parse(value string) (Format, error) {
+	f := Format(strings.ToLower(value))
+	if f == FormatText || f.Structured() {
+		return f, nil
+	}
+
+	return "", fmt.Errorf("unsupported output format '%s', supported formats: %s, %s", value, FormatJSON, FormatYAML)
+}
+
+// Structured reports whether the format is a structured one, meant to be parsed by tools.
+func (f Format) Structured() bool {
+	return f == FormatJSON || f == FormatYAML
+}
+
+// Marshal serializes v in the given structured format.
+// Both formats honor the json tags, and keep the fields in the order they are declared in.
+func Marshal(v any, f Format) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	switch f {
+	case FormatJSON:
+		return data, nil
+	case FormatYAML:
+		return jsonToYAML(data)
+	default:
+		return nil, fmt.Errorf("unsupported output format '%s'", f)
+	}
+}
+
+// Print writes v in the given structured format to the result output, with the lipgloss styling disabled
+// so that no escape sequences end up in the document.
+// The deprecation warnings of the run are added to the document under a deprecations array, which does not collide
+// with the warnings of the document itself, Eg:- the ones of a bootstrap result. The other documents than objects,
+// Eg:- the arrays of the list commands, are wrapped under an items key next to it.
+func Print(v any, f Format) error {
+	lipgloss.SetColorProfile(termenv.Ascii)
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the %s output: %w", f, err)
+	}
+	warnings := deprecation.Warnings()
+	if len(warnings) > 0 {
+		var added bool
+		if data, added, err = addWarnings(data, warnings); err != nil {
+			return fmt.Errorf("failed to add the warnings to the %s output: %w", f, err)
+		}
+		if !added {
+			if err := printWarnings(warnings); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err = Marshal(json.RawMessage(data), f)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the %s output: %w", f, err)
+	}
+	logger.Resultln(strings.TrimSuffix(string(data), "\n"))
+
+	return nil
+}
+
+const (
+	// warningsKey is the key of the deprecation warnings array of a structured output.
+	warningsKey = "deprecations"
+	// itemsKey is the key of the document wrapped along with the deprecation warnings when it is not an object.
+	itemsKey = "items"
+)
+
+// addWarnings appends the warnings array to the JSON document, returning whether it could, that is unless it is an
+// object with a deprecations key of its own. The keys of the document keep their order, and the document is wrapped
+// under an items key when it is not an object, Eg:- [...] -> {"items":[...],"deprecations":[...]}.
+func addWarnings(data []byte, warnings []deprecation.Warning) ([]byte, bool, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		object = map[string]json.RawMessage{itemsKey: data}
+		if data, err = json.Marshal(object); err != nil {
+			return nil, false, err
+		}
+	}
+	if _, ok := object[warningsKey]; ok {
+		return data, false, nil
+	}
+
+	array, err := json.Marshal(warnings)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return nil, false, err
+	}
+	doc := bytes.TrimSuffix(buf.Bytes(), []byte("}"))
+	if len(object) > 0 {
+		doc = append(doc, ',')
+	}
+	doc = fmt.Appendf(doc, "%q:%s}", warningsKey, array)
+
+	return doc, true, nil
+}
+
+// printWarnings writes the warnings to the error output as a single line JSON document.
+func printWarnings(warnings []deprecation.Warning) error {
+	data, err := json.Marshal(map[string][]deprecation.Warning{warningsKey: warnings})
+	if err != nil {
+		return fmt.Errorf("failed to marshal the warnings: %w", err)
+	}
+	_, err = fmt.Fprintln(os.Stderr, string(data))
+
+	return err
+}
+
+// jsonToYAML converts the JSON document to YAML, through a yaml node so that the key order is kept.
+func jsonToYAML(data []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	resetStyle(&node)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(yamlIndent)
+	if err := enc.Encode(&node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// resetStyle drops the flow and quoting styles of the JSON syntax, the encoder quotes the strings only when needed.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
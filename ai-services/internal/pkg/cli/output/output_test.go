@@ -0,0 +1,147 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/deprecation"
+)
+
+type sample struct {
+	Name    string            `json:"name"`
+	Passed  bool              `json:"passed"`
+	Version string            `json:"version"`
+	Empty   string            `json:"empty,omitempty"`
+	Labels  map[string]string `json:"labels"`
+	Checks  []string          `json:"checks"`
+}
+
+func TestMarshal(t *testing.T) {
+	v := sample{
+		Name:    "rag",
+		Passed:  true,
+		Version: "1.0",
+		Labels:  map[string]string{"zone": "b", "arch": "ppc64le"},
+		Checks:  []string{"root", "true"},
+	}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{
+			format: FormatJSON,
+			want: `{
+  "name": "rag",
+  "passed": true,
+  "version": "1.0",
+  "labels": {
+    "arch": "ppc64le",
+    "zone": "b"
+  },
+  "checks": [
+    "root",
+    "true"
+  ]
+}`,
+		},
+		{
+			format: FormatYAML,
+			want: `name: rag
+passed: true
+version: "1.0"
+labels:
+  arch: ppc64le
+  zone: b
+checks:
+  - root
+  - "true"
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			got, err := Marshal(v, tt.format)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Format
+		wantErr bool
+	}{
+		{value: "", want: FormatText},
+		{value: "json", want: FormatJSON},
+		{value: "YAML", want: FormatYAML},
+		{value: "wide", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddWarnings(t *testing.T) {
+	warnings := []deprecation.Warning{{Type: "deprecation", Kind: deprecation.KindTemplate, Name: "legacy-rag", Replacement: "rag", Message: "deprecated"}}
+
+	tests := []struct {
+		name      string
+		doc       string
+		want      string
+		wantAdded bool
+	}{
+		{
+			name:      "object",
+			doc:       `{"name":"rag","passed":true}`,
+			want:      `{"name":"rag","passed":true,"deprecations":[{"type":"deprecation","kind":"template","name":"legacy-rag","replacement":"rag","message":"deprecated"}]}`,
+			wantAdded: true,
+		},
+		{
+			name:      "empty object",
+			doc:       `{}`,
+			want:      `{"deprecations":[{"type":"deprecation","kind":"template","name":"legacy-rag","replacement":"rag","message":"deprecated"}]}`,
+			wantAdded: true,
+		},
+		{
+			name:      "array",
+			doc:       `[{"name":"rag"}]`,
+			want:      `{"items":[{"name":"rag"}],"deprecations":[{"type":"deprecation","kind":"template","name":"legacy-rag","replacement":"rag","message":"deprecated"}]}`,
+			wantAdded: true,
+		},
+		{
+			name:      "warnings of its own",
+			doc:       `{"warnings":["re-login"]}`,
+			want:      `{"warnings":["re-login"],"deprecations":[{"type":"deprecation","kind":"template","name":"legacy-rag","replacement":"rag","message":"deprecated"}]}`,
+			wantAdded: true,
+		},
+		{name: "deprecations of its own", doc: `{"deprecations":["v1"]}`, want: `{"deprecations":["v1"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, added, err := addWarnings([]byte(tt.doc), warnings)
+			if err != nil {
+				t.Fatalf("addWarnings() error = %v", err)
+			}
+			if added != tt.wantAdded || string(got) != tt.want {
+				t.Errorf("addWarnings() = %s, %v, want %s, %v", got, added, tt.want, tt.wantAdded)
+			}
+		})
+	}
+}
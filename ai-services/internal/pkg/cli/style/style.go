@@ -0,0 +1,124 @@
+// Package style holds the named styles of the CLI output, rendered with the colors of the selected theme.
+package style
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Theme is a set of colors the named styles are rendered with.
+type Theme string
+
+const (
+	// ThemeDefault is the default theme, Eg:- the success messages in green.
+	ThemeDefault Theme = "default"
+	// ThemeHighContrast is the theme of bright and bold colors, readable on both dark and light terminals.
+	ThemeHighContrast Theme = "high-contrast"
+	// ThemeMonochrome is the theme without any color, the styles telling apart only by their weight and decoration.
+	ThemeMonochrome Theme = "monochrome"
+)
+
+// Themes are the supported themes.
+var Themes = []Theme{ThemeDefault, ThemeHighContrast, ThemeMonochrome}
+
+type palette struct {
+	success lipgloss.Style
+	warn    lipgloss.Style
+	err     lipgloss.Style
+	hint    lipgloss.Style
+	heading lipgloss.Style
+}
+
+var palettes = map[Theme]palette{
+	ThemeDefault: {
+		success: lipgloss.NewStyle().Foreground(lipgloss.Color("#32BD27")),
+		warn:    lipgloss.NewStyle().Foreground(lipgloss.Color("3")),
+		err:     lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+		hint:    lipgloss.NewStyle().Foreground(lipgloss.Color("4")),
+		heading: lipgloss.NewStyle().Bold(true),
+	},
+	ThemeHighContrast: {
+		success: lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Bold(true),
+		warn:    lipgloss.NewStyle().Foreground(lipgloss.Color("11")).Bold(true),
+		err:     lipgloss.NewStyle().Foreground(lipgloss.Color("9")).Bold(true),
+		hint:    lipgloss.NewStyle().Foreground(lipgloss.Color("14")).Underline(true),
+		heading: lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Bold(true),
+	},
+	ThemeMonochrome: {
+		success: lipgloss.NewStyle(),
+		warn:    lipgloss.NewStyle().Bold(true),
+		err:     lipgloss.NewStyle().Bold(true),
+		hint:    lipgloss.NewStyle().Underline(true),
+		heading: lipgloss.NewStyle().Bold(true),
+	},
+}
+
+// current is the theme of the run, set once from the flags before the command runs.
+var current atomic.Value
+
+func init() {
+	current.Store(ThemeDefault)
+}
+
+// Parse returns the theme of the given name, the default one for an empty name.
+func Parse(name string) (Theme, error) {
+	if name == "" {
+		return ThemeDefault, nil
+	}
+
+	theme := Theme(strings.ToLower(name))
+	if _, ok := palettes[theme]; !ok {
+		names := make([]string, 0, len(Themes))
+		for _, t := range Themes {
+			names = append(names, string(t))
+		}
+
+		return "", fmt.Errorf("unsupported theme '%s', supported themes: %s", name, strings.Join(names, ", "))
+	}
+
+	return theme, nil
+}
+
+// SetTheme selects the theme the named styles are rendered with.
+func SetTheme(theme Theme) {
+	current.Store(theme)
+}
+
+// Current returns the selected theme.
+func Current() Theme {
+	theme, _ := current.Load().(Theme)
+
+	return theme
+}
+
+func selected() palette {
+	return palettes[Current()]
+}
+
+// Success is the style of the successful outcomes, Eg:- the checkmarks of the passed validations.
+func Success() lipgloss.Style {
+	return selected().success
+}
+
+// Warn is the style of the conditions which are concerning but not a failure.
+func Warn() lipgloss.Style {
+	return selected().warn
+}
+
+// Error is the style of the failures.
+func Error() lipgloss.Style {
+	return selected().err
+}
+
+// Hint is the style of the actionable hints, Eg:- the links to the documentation.
+func Hint() lipgloss.Style {
+	return selected().hint
+}
+
+// Heading is the style of the headings, Eg:- the headers of the tables.
+func Heading() lipgloss.Style {
+	return selected().heading
+}
@@ -0,0 +1,56 @@
+package style
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Theme
+		wantErr bool
+	}{
+		{name: "", want: ThemeDefault},
+		{name: "default", want: ThemeDefault},
+		{name: "High-Contrast", want: ThemeHighContrast},
+		{name: "monochrome", want: ThemeMonochrome},
+		{name: "solarized", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Parse(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestThemesHavePalette(t *testing.T) {
+	for _, theme := range Themes {
+		if _, ok := palettes[theme]; !ok {
+			t.Errorf("theme %q has no palette", theme)
+		}
+	}
+}
+
+func TestSetTheme(t *testing.T) {
+	t.Cleanup(func() { SetTheme(ThemeDefault) })
+
+	if got := Success().GetForeground(); got != lipgloss.Color("#32BD27") {
+		t.Errorf("default Success() foreground = %v, want #32BD27", got)
+	}
+
+	SetTheme(ThemeMonochrome)
+	for name, s := range map[string]lipgloss.Style{"success": Success(), "warn": Warn(), "error": Error(), "hint": Hint(), "heading": Heading()} {
+		if _, ok := s.GetForeground().(lipgloss.NoColor); !ok {
+			t.Errorf("monochrome %s style has the foreground %v, want none", name, s.GetForeground())
+		}
+	}
+}
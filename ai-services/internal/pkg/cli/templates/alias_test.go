@@ -0,0 +1,122 @@
+package templates
+
+import (
+	"bytes"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/deprecation"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// captureLogs redirects the logger output to a buffer for the duration of the test.
+func captureLogs(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	logger.SetOutput(&buf)
+	logger.SetErrorOutput(&buf)
+	t.Cleanup(func() {
+		logger.SetOutput(os.Stdout)
+		logger.SetErrorOutput(os.Stderr)
+	})
+
+	return &buf
+}
+
+func TestResolveApplication(t *testing.T) {
+	tp := newTestProvider(t)
+
+	tests := []struct {
+		name     string
+		input    string
+		want     string
+		wantWarn bool
+	}{
+		{name: "canonical name", input: "typed", want: "typed"},
+		{name: "deprecated alias", input: "legacy-typed", want: "typed", wantWarn: true},
+		{name: "unknown name", input: "missing", want: "missing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := captureLogs(t)
+
+			got, err := tp.ResolveApplication(tt.input)
+			if err != nil {
+				t.Fatalf("ResolveApplication() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveApplication() = %q, want %q", got, tt.want)
+			}
+
+			warned := strings.Contains(logs.String(), "deprecated")
+			if warned != tt.wantWarn {
+				t.Errorf("deprecation warning logged = %v, want %v, logs: %q", warned, tt.wantWarn, logs.String())
+			}
+		})
+	}
+}
+
+func TestDeployViaAlias(t *testing.T) {
+	tp := newTestProvider(t)
+	logs := captureLogs(t)
+
+	app, err := tp.ResolveApplication("legacy-typed")
+	if err != nil {
+		t.Fatalf("ResolveApplication() error = %v", err)
+	}
+	if !strings.Contains(logs.String(), "'legacy-typed' is deprecated, please use 'typed' instead") {
+		t.Errorf("expected a deprecation warning, got logs: %q", logs.String())
+	}
+	if !slices.ContainsFunc(deprecation.Warnings(), func(w deprecation.Warning) bool {
+		return w.Kind == deprecation.KindTemplate && w.Name == "legacy-typed" && w.Replacement == "typed"
+	}) {
+		t.Errorf("expected the deprecation to be recorded, got %+v", deprecation.Warnings())
+	}
+
+	out := renderAppDeployment(t, tp, app, map[string]string{"app.replicas": "2", "app.name": "demo"})
+	if !strings.Contains(out, "name: demo\n") || !strings.Contains(out, "replicas: 2\n") {
+		t.Errorf("unexpected deployment rendered via alias:\n%s", out)
+	}
+}
+
+func TestListApplicationsHidesAliases(t *testing.T) {
+	tp := newTestProvider(t)
+
+	apps, err := tp.ListApplications(true)
+	if err != nil {
+		t.Fatalf("ListApplications() error = %v", err)
+	}
+	if slices.Contains(apps, "legacy-typed") || !slices.Contains(apps, "typed") {
+		t.Errorf("ListApplications() = %v, want only canonical names", apps)
+	}
+}
+
+func TestVerifyAliases(t *testing.T) {
+	tests := []struct {
+		name      string
+		metadataA string
+		metadataB string
+		wantErr   bool
+	}{
+		{name: "distinct aliases", metadataA: "aliases: [old-a]\n", metadataB: "aliases: [old-b]\n"},
+		{name: "alias shadows a template", metadataA: "aliases: [b]\n", metadataB: "name: b\n", wantErr: true},
+		{name: "alias claimed twice", metadataA: "aliases: [old]\n", metadataB: "aliases: [old]\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"apps/a/metadata.yaml": {Data: []byte(tt.metadataA)},
+				"apps/b/metadata.yaml": {Data: []byte(tt.metadataB)},
+			}
+			if err := verifyAliases(fsys, "apps", []string{"a", "b"}); (err != nil) != tt.wantErr {
+				t.Errorf("verifyAliases() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCopyBuildContext(t *testing.T) {
+	tp := newTestProvider(t)
+	dest := t.TempDir()
+
+	if err := tp.CopyBuildContext("typed", "build/ui", dest); err != nil {
+		t.Fatalf("CopyBuildContext() error = %v", err)
+	}
+
+	for _, file := range []string{"Containerfile", filepath.Join("static", "index.html")} {
+		if _, err := os.Stat(filepath.Join(dest, file)); err != nil {
+			t.Errorf("%s was not copied: %v", file, err)
+		}
+	}
+
+	if err := tp.CopyBuildContext("typed", "build/missing", dest); err == nil {
+		t.Error("CopyBuildContext() of a missing build context succeeded, want an error")
+	}
+}
@@ -6,13 +6,17 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"maps"
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"text/template"
 
 	"github.com/project-ai-services/ai-services/assets"
+	"github.com/project-ai-services/ai-services/internal/pkg/deprecation"
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
 	"github.com/project-ai-services/ai-services/internal/pkg/models"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
@@ -27,15 +31,21 @@ import (
 
 const (
 	/*
-		Templates Pattern :- "applications/<AppName>/metadata.yaml"
-		After splitting, the application name is located at second part.
-		So we ensure the path contains enough segments which is appName index + 1.
+		Templates Pattern :- "<root>/<AppName>/metadata.yaml"
+		After trimming the root and splitting, the application name is located at first part.
+		So we ensure the path relative to the root contains exactly the app name and the metadata file.
 	*/
-	minPathPartsForAppName = 3
+	appMetadataPathParts = 2
+
+	// defaultRoot is the directory of the built-in application templates.
+	defaultRoot = "applications"
+
+	buildContextDirPerm  = 0o755
+	buildContextFilePerm = 0o644
 )
 
 type embedTemplateProvider struct {
-	fs      *embed.FS
+	fs      templateFS
 	root    string
 	runtime types.RuntimeType
 }
@@ -56,10 +66,10 @@ func (e *embedTemplateProvider) ListApplications(hidden bool) ([]string, error)
 			return nil
 		}
 
-		// Templates Pattern :- "applications/<AppName>/metadata.yaml" (Top level metadata file)
-		parts := strings.Split(filepath.ToSlash(path), "/")
-		if len(parts) == minPathPartsForAppName && filepath.Base(path) == "metadata.yaml" {
-			appName := parts[1]
+		// Templates Pattern :- "<root>/<AppName>/metadata.yaml" (Top level metadata file)
+		parts := strings.Split(strings.TrimPrefix(filepath.ToSlash(path), e.root+"/"), "/")
+		if len(parts) == appMetadataPathParts && filepath.Base(path) == "metadata.yaml" {
+			appName := parts[0]
 			md, err := e.LoadMetadata(appName, false)
 			if err != nil {
 				return err
@@ -80,17 +90,41 @@ func (e *embedTemplateProvider) ListApplications(hidden bool) ([]string, error)
 	return apps, nil
 }
 
-// ListApplicationTemplateValues lists all available template value keys for a single application.
-func (e *embedTemplateProvider) ListApplicationTemplateValues(app string) (map[string]string, error) {
-	valuesPath := fmt.Sprintf("%s/%s/%s/values.yaml", e.root, app, e.Runtime())
-	valuesData, err := e.fs.ReadFile(valuesPath)
+// ResolveApplication resolves a deprecated alias to its application template name.
+func (e *embedTemplateProvider) ResolveApplication(name string) (string, error) {
+	apps, err := e.ListApplications(true)
 	if err != nil {
-		return nil, fmt.Errorf("read values.yaml: %w", err)
+		return "", err
+	}
+	if slices.Contains(apps, name) {
+		return name, nil
 	}
 
-	var root yaml.Node
-	if err := yaml.Unmarshal(valuesData, &root); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal yaml.Node: %w", err)
+	for _, app := range apps {
+		md, err := e.LoadMetadata(app, false)
+		if err != nil {
+			return "", err
+		}
+		if slices.Contains(md.Aliases, name) {
+			deprecation.Report(deprecation.Warning{
+				Kind:        deprecation.KindTemplate,
+				Name:        name,
+				Replacement: app,
+				Message:     fmt.Sprintf("Application template '%s' is deprecated, please use '%s' instead", name, app),
+			})
+
+			return app, nil
+		}
+	}
+
+	return name, nil
+}
+
+// ListApplicationTemplateValues lists all available template value keys for a single application.
+func (e *embedTemplateProvider) ListApplicationTemplateValues(app string) (map[string]string, error) {
+	root, err := e.loadValuesNode(app)
+	if err != nil {
+		return nil, err
 	}
 
 	parametersWithDescription := make(map[string]string)
@@ -102,10 +136,41 @@ func (e *embedTemplateProvider) ListApplicationTemplateValues(app string) (map[s
 	return parametersWithDescription, nil
 }
 
+// ListRequiredApplicationTemplateValues lists all the template value keys marked as required for a single application.
+func (e *embedTemplateProvider) ListRequiredApplicationTemplateValues(app string) (map[string]bool, error) {
+	root, err := e.loadValuesNode(app)
+	if err != nil {
+		return nil, err
+	}
+
+	required := make(map[string]bool)
+
+	if len(root.Content) > 0 {
+		utils.CollectRequiredParams("", root.Content[0], required)
+	}
+
+	return required, nil
+}
+
+func (e *embedTemplateProvider) loadValuesNode(app string) (*yaml.Node, error) {
+	valuesPath := fmt.Sprintf("%s/%s/values.yaml", e.appDir(app), e.Runtime())
+	valuesData, err := e.fs.ReadFile(valuesPath)
+	if err != nil {
+		return nil, fmt.Errorf("read values.yaml: %w", err)
+	}
+
+	var root yaml.Node
+	if err := yaml.Unmarshal(valuesData, &root); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal yaml.Node: %w", err)
+	}
+
+	return &root, nil
+}
+
 // LoadAllTemplates loads all templates for a given application.
 func (e *embedTemplateProvider) LoadAllTemplates(app string) (map[string]*template.Template, error) {
 	tmpls := make(map[string]*template.Template)
-	completePath := fmt.Sprintf("%s/%s/%s/templates", e.root, app, e.Runtime())
+	completePath := fmt.Sprintf("%s/%s/templates", e.appDir(app), e.Runtime())
 	err := fs.WalkDir(e.fs, completePath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -130,7 +195,7 @@ func (e *embedTemplateProvider) LoadAllTemplates(app string) (map[string]*templa
 
 // LoadPodTemplate loads and renders a pod template with the given parameters.
 func (e *embedTemplateProvider) LoadPodTemplate(app, file string, params any) (*models.PodSpec, error) {
-	path := fmt.Sprintf("%s/%s/%s/templates/%s", e.root, app, e.Runtime(), file)
+	path := fmt.Sprintf("%s/%s/templates/%s", e.appDir(app), e.Runtime(), file)
 	data, err := e.fs.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("read metadata: %w", err)
@@ -153,6 +218,53 @@ func (e *embedTemplateProvider) LoadPodTemplate(app, file string, params any) (*
 	return &spec, nil
 }
 
+// LoadHookManifest loads and renders a hook manifest with the given parameters.
+func (e *embedTemplateProvider) LoadHookManifest(app, file string, params any) ([]byte, error) {
+	p := path.Join(e.appDir(app), e.Runtime(), file)
+	data, err := e.fs.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("read hook manifest: %w", err)
+	}
+
+	tmpl, err := template.New("hookManifest").Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("parse hook manifest %s: %w", file, err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, params); err != nil {
+		return nil, fmt.Errorf("failed to execute hook manifest %s: %w", p, err)
+	}
+
+	return rendered.Bytes(), nil
+}
+
+// CopyBuildContext copies a build context directory of an application to dest on the host, as needed by the image builds.
+func (e *embedTemplateProvider) CopyBuildContext(app, dir, dest string) error {
+	root := path.Join(e.appDir(app), e.Runtime(), dir)
+	if info, err := fs.Stat(e.fs, root); err != nil || !info.IsDir() {
+		return fmt.Errorf("build context '%s' not found in application template '%s'", dir, app)
+	}
+
+	return fs.WalkDir(e.fs, root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(strings.TrimPrefix(p, root)))
+		if d.IsDir() {
+			return os.MkdirAll(target, buildContextDirPerm)
+		}
+
+		data, err := e.fs.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		return os.WriteFile(target, data, buildContextFilePerm)
+	})
+}
+
 func (e *embedTemplateProvider) LoadPodTemplateWithValues(app, file, appName string, valuesFileOverrides []string, cliOverrides map[string]string) (*models.PodSpec, error) {
 	values, err := e.LoadValues(app, valuesFileOverrides, cliOverrides)
 	if err != nil {
@@ -164,6 +276,7 @@ func (e *embedTemplateProvider) LoadPodTemplateWithValues(app, file, appName str
 		"AppName":         appName,
 		"AppTemplateName": "",
 		"Version":         "",
+		"Facts":           facts.Get(),
 	}
 
 	return e.LoadPodTemplate(app, file, params)
@@ -171,7 +284,7 @@ func (e *embedTemplateProvider) LoadPodTemplateWithValues(app, file, appName str
 
 func (e *embedTemplateProvider) LoadValues(app string, valuesFileOverrides []string, cliOverrides map[string]string) (map[string]interface{}, error) {
 	// Load the default values.yaml
-	valuesPath := fmt.Sprintf("%s/%s/%s/values.yaml", e.root, app, e.Runtime())
+	valuesPath := fmt.Sprintf("%s/%s/values.yaml", e.appDir(app), e.Runtime())
 	valuesData, err := e.fs.ReadFile(valuesPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read values.yaml: %w", err)
@@ -196,14 +309,43 @@ func (e *embedTemplateProvider) LoadValues(app string, valuesFileOverrides []str
 		}
 	}
 
+	params, forcedStrings, err := splitStringParams(cliOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	// validate CLI Overrides before applying since we are adding them directly
-	if err := utils.ValidateParams(cliOverrides, values); err != nil {
+	if err := utils.ValidateParams(params, values); err != nil {
 		return nil, err
 	}
 
-	// Load user provided CLI overides
-	for key, val := range cliOverrides {
-		utils.SetNestedValue(values, key, val)
+	if len(params) == 0 {
+		return values, nil
+	}
+
+	// CLI overrides are plain strings, so coerce them to the parameter types declared in the metadata
+	appMetadata, err := e.LoadMetadata(app, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load metadata: %w", err)
+	}
+
+	// Load user provided CLI overides, the ones forced to a string keep their value as is.
+	// They are set in order, for the items of a list to be appended one after the other.
+	keys := slices.Collect(maps.Keys(params))
+	utils.SortParamPaths(keys)
+	for _, key := range keys {
+		val := params[key]
+		paramType := appMetadata.ParamTypes[key]
+		if forcedStrings[key] {
+			paramType = ParamTypeString
+		}
+		typed, err := coerceParam(key, val, paramType)
+		if err != nil {
+			return nil, err
+		}
+		if err := utils.SetParamValue(values, key, typed); err != nil {
+			return nil, err
+		}
 	}
 
 	return values, nil
@@ -212,9 +354,10 @@ func (e *embedTemplateProvider) LoadValues(app string, valuesFileOverrides []str
 // LoadMetadata loads the metadata for a given application template.
 // if runtime is empty then it loads the app Metadata.
 // if set it loads the runtime specific metadata.
+// Hidden templates are resolved as well, since they are only left out of the listing.
 func (e *embedTemplateProvider) LoadMetadata(app string, isRuntime bool) (*AppMetadata, error) {
 	// construct metadata.yaml path
-	p := path.Join(e.root, app)
+	p := e.appDir(app)
 	if isRuntime {
 		p = path.Join(p, e.Runtime())
 	}
@@ -236,7 +379,7 @@ func (e *embedTemplateProvider) LoadMetadata(app string, isRuntime bool) (*AppMe
 // LoadMdFiles loads all md files for a given application.
 func (e *embedTemplateProvider) LoadMdFiles(app string) (map[string]*template.Template, error) {
 	tmpls := make(map[string]*template.Template)
-	completePath := fmt.Sprintf("%s/%s/%s/steps", e.root, app, e.Runtime())
+	completePath := fmt.Sprintf("%s/%s/steps", e.appDir(app), e.Runtime())
 	err := fs.WalkDir(e.fs, completePath, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return err
@@ -260,7 +403,7 @@ func (e *embedTemplateProvider) LoadMdFiles(app string) (map[string]*template.Te
 }
 
 func (e *embedTemplateProvider) LoadVarsFile(app string, params map[string]string) (*Vars, error) {
-	path := fmt.Sprintf("%s/%s/%s/steps/vars_file.yaml", e.root, app, e.Runtime())
+	path := fmt.Sprintf("%s/%s/steps/vars_file.yaml", e.appDir(app), e.Runtime())
 
 	data, err := e.fs.ReadFile(path)
 	if err != nil {
@@ -294,7 +437,7 @@ func (e *embedTemplateProvider) LoadChart(app string) (chart.Charter, error) {
 	}
 
 	// construct chart path
-	chartPath := path.Join(e.root, app, e.Runtime())
+	chartPath := path.Join(e.appDir(app), e.Runtime())
 
 	var files []*archive.BufferedFile
 	err := fs.WalkDir(e.fs, chartPath, func(p string, d fs.DirEntry, err error) error {
@@ -331,18 +474,21 @@ type EmbedOptions struct {
 }
 
 // NewEmbedTemplateProvider creates a new instance of embedTemplateProvider.
-func NewEmbedTemplateProvider(options EmbedOptions) Template {
+// When serving the built-in application templates, it verifies the integrity of the
+// embedded template set and returns an error if the build shipped a broken one.
+// The application templates fetched by FetchRemoteApplication are served along with the built-in ones.
+func NewEmbedTemplateProvider(options EmbedOptions) (Template, error) {
 	t := &embedTemplateProvider{}
 	if options.FS != nil {
 		t.fs = options.FS
 	} else {
-		t.fs = &assets.ApplicationFS
+		t.fs = defaultTemplateFS()
 	}
 
 	if options.Root != "" {
 		t.root = options.Root
 	} else {
-		t.root = "applications"
+		t.root = defaultRoot
 	}
 
 	// Use Podman runtime if not set by default
@@ -351,7 +497,13 @@ func NewEmbedTemplateProvider(options EmbedOptions) Template {
 		t.runtime = options.Runtime
 	}
 
-	return t
+	if options.FS == nil && options.Root == "" {
+		if err := verifyEmbeddedApplications(&assets.ApplicationFS, t.root); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
 }
 
 func (e *embedTemplateProvider) LoadYamls() ([][]byte, error) {
@@ -0,0 +1,52 @@
+package templates
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/testutil"
+)
+
+const pciDevicesDir = "/sys/bus/pci/devices"
+
+func TestRenderBranchingOnSpyreCardCount(t *testing.T) {
+	tests := []struct {
+		cards int
+		want  string
+	}{
+		{cards: 0, want: "1"},
+		{cards: 2, want: "1"},
+		{cards: 8, want: "8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d cards", tt.cards), func(t *testing.T) {
+			testutil.FakeHost(t, hostWithSpyreCards(tt.cards))
+			tp := newTestProvider(t)
+
+			pod, err := tp.LoadPodTemplateWithValues("sized", "vllm.yaml.tmpl", "demo", nil, nil)
+			if err != nil {
+				t.Fatalf("LoadPodTemplateWithValues() error = %v", err)
+			}
+			if got := pod.Spec.Containers[0].Env[0].Value; got != tt.want {
+				t.Errorf("TENSOR_PARALLEL_SIZE = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// hostWithSpyreCards returns the fixture Power11 host with the given number of Spyre cards attached.
+func hostWithSpyreCards(cards int) hostfs.Fake {
+	host := maps.Clone(testutil.Power11Host)
+	maps.DeleteFunc(host, func(path, _ string) bool { return strings.HasPrefix(path, pciDevicesDir) })
+	for i := range cards {
+		dev := fmt.Sprintf("%s/%04x:70:00.0", pciDevicesDir, 0x0182+i)
+		host[dev+"/vendor"] = "0x1014\n"
+		host[dev+"/device"] = "0x06a7\n"
+	}
+
+	return host
+}
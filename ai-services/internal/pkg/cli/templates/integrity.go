@@ -0,0 +1,247 @@
+package templates
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"sync"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+
+	"go.yaml.in/yaml/v3"
+)
+
+var (
+	integrityOnce sync.Once
+	integrityErr  error
+)
+
+// requiredRuntimeFiles lists the files every runtime directory of an application template must ship.
+var requiredRuntimeFiles = map[types.RuntimeType][]string{
+	types.RuntimeTypePodman:    {"metadata.yaml", "values.yaml", "templates"},
+	types.RuntimeTypeOpenShift: {"Chart.yaml", "values.yaml", "templates"},
+}
+
+// verifyEmbeddedApplications verifies the embedded application templates only once,
+// since the embedded FS cannot change during the lifetime of the process.
+func verifyEmbeddedApplications(efs *embed.FS, root string) error {
+	integrityOnce.Do(func() {
+		integrityErr = verifyApplications(efs, root)
+	})
+
+	return integrityErr
+}
+
+// verifyApplications checks that root contains at least one application template,
+// and that each template contains its metadata and the expected runtime directories.
+func verifyApplications(fsys fs.FS, root string) error {
+	entries, err := fs.ReadDir(fsys, root)
+	if err != nil {
+		return fmt.Errorf("embedded application templates are missing: %w", err)
+	}
+
+	var errs []error
+	apps := []string{}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		apps = append(apps, entry.Name())
+		if err := verifyApplication(fsys, path.Join(root, entry.Name())); err != nil {
+			errs = append(errs, fmt.Errorf("template '%s': %w", entry.Name(), err))
+		}
+	}
+
+	if len(apps) == 0 {
+		return fmt.Errorf("no application templates found in embedded directory '%s'", root)
+	}
+
+	if err := verifyAliases(fsys, root, apps); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("embedded application templates are broken, the build shipped an invalid template set:\n%w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func verifyApplication(fsys fs.FS, appDir string) error {
+	if _, err := fs.Stat(fsys, path.Join(appDir, "metadata.yaml")); err != nil {
+		return errors.New("metadata.yaml is missing")
+	}
+
+	runtimes := 0
+	for rt, files := range requiredRuntimeFiles {
+		rtDir := path.Join(appDir, rt.String())
+		if _, err := fs.Stat(fsys, rtDir); err != nil {
+			continue
+		}
+		runtimes++
+
+		for _, f := range files {
+			if _, err := fs.Stat(fsys, path.Join(rtDir, f)); err != nil {
+				return fmt.Errorf("%s/%s is missing", rt, f)
+			}
+		}
+
+		if rt == types.RuntimeTypePodman {
+			if err := verifyHooks(fsys, rtDir); err != nil {
+				return fmt.Errorf("%s/metadata.yaml: %w", rt, err)
+			}
+			if err := verifyBuilds(fsys, rtDir); err != nil {
+				return fmt.Errorf("%s/metadata.yaml: %w", rt, err)
+			}
+		}
+	}
+
+	if runtimes == 0 {
+		return fmt.Errorf("no runtime directory found (expected one of: %s, %s)", types.RuntimeTypePodman, types.RuntimeTypeOpenShift)
+	}
+
+	return verifyVersions(fsys, appDir)
+}
+
+// verifyVersions checks that each earlier version shipped with an application template is a valid template itself,
+// whose runtime directories carry the version it is stored under.
+func verifyVersions(fsys fs.FS, appDir string) error {
+	entries, err := fs.ReadDir(fsys, path.Join(appDir, versionsDir))
+	if errors.Is(err, fs.ErrNotExist) {
+		// versions are optional
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read the versions: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		versionDir := path.Join(appDir, versionsDir, entry.Name())
+		if err := verifyApplication(fsys, versionDir); err != nil {
+			return fmt.Errorf("version '%s': %w", entry.Name(), err)
+		}
+
+		for rt := range requiredRuntimeFiles {
+			rtDir := path.Join(versionDir, rt.String())
+			if _, err := fs.Stat(fsys, rtDir); err != nil {
+				continue
+			}
+			version, err := runtimeVersion(fsys, rtDir, rt)
+			if err != nil {
+				return fmt.Errorf("version '%s': %s: %w", entry.Name(), rt, err)
+			}
+			if version != entry.Name() {
+				return fmt.Errorf("version '%s': %s declares version '%s'", entry.Name(), rt, version)
+			}
+		}
+	}
+
+	return nil
+}
+
+// verifyHooks checks that each deploy hook declared in the runtime metadata is well formed,
+// and that the manifests it refers to are shipped.
+func verifyHooks(fsys fs.FS, rtDir string) error {
+	data, err := fs.ReadFile(fsys, path.Join(rtDir, "metadata.yaml"))
+	if err != nil {
+		return err
+	}
+
+	var md AppMetadata
+	if err := yaml.Unmarshal(data, &md); err != nil {
+		return fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	hooks := append(append([]Hook{}, md.Hooks.PreDeploy...), md.Hooks.PostDeploy...)
+	for _, hook := range hooks {
+		switch {
+		case hook.Name == "":
+			return errors.New("hook name is missing")
+		case (len(hook.Command) > 0) == (hook.Manifest != ""):
+			return fmt.Errorf("hook '%s' must set exactly one of command or manifest", hook.Name)
+		case hook.Manifest != "":
+			if _, err := fs.Stat(fsys, path.Join(rtDir, hook.Manifest)); err != nil {
+				return fmt.Errorf("manifest '%s' of hook '%s' is missing", hook.Manifest, hook.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// containerfiles are the names of the build instructions file looked up by podman build in the build context.
+var containerfiles = []string{"Containerfile", "Dockerfile"}
+
+// verifyBuilds checks that each image build declared in the runtime metadata has a tag,
+// and that its build context is shipped along with a Containerfile.
+func verifyBuilds(fsys fs.FS, rtDir string) error {
+	data, err := fs.ReadFile(fsys, path.Join(rtDir, "metadata.yaml"))
+	if err != nil {
+		return err
+	}
+
+	var md AppMetadata
+	if err := yaml.Unmarshal(data, &md); err != nil {
+		return fmt.Errorf("invalid metadata: %w", err)
+	}
+
+	for _, build := range md.Builds {
+		if build.Tag == "" || build.Context == "" {
+			return errors.New("build must set both context and tag")
+		}
+
+		found := slices.ContainsFunc(containerfiles, func(name string) bool {
+			_, err := fs.Stat(fsys, path.Join(rtDir, build.Context, name))
+
+			return err == nil
+		})
+		if !found {
+			return fmt.Errorf("build context '%s' of image '%s' has no Containerfile", build.Context, build.Tag)
+		}
+	}
+
+	return nil
+}
+
+// verifyAliases checks that every alias resolves to a single application template,
+// so that it neither shadows a template name nor is claimed by two templates.
+func verifyAliases(fsys fs.FS, root string, apps []string) error {
+	owners := map[string]string{}
+	for _, app := range apps {
+		owners[app] = app
+	}
+
+	var errs []error
+	for _, app := range apps {
+		data, err := fs.ReadFile(fsys, path.Join(root, app, "metadata.yaml"))
+		if err != nil {
+			// reported by verifyApplication already
+			continue
+		}
+
+		var md AppMetadata
+		if err := yaml.Unmarshal(data, &md); err != nil {
+			errs = append(errs, fmt.Errorf("template '%s': invalid metadata: %w", app, err))
+
+			continue
+		}
+
+		for _, alias := range md.Aliases {
+			if owner, ok := owners[alias]; ok {
+				errs = append(errs, fmt.Errorf("template '%s': alias '%s' is already used by template '%s'", app, alias, owner))
+
+				continue
+			}
+			owners[alias] = app
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,194 @@
+package templates
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestVerifyHooks(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata string
+		wantErr  bool
+	}{
+		{
+			name:     "no hooks",
+			metadata: "name: app\n",
+		},
+		{
+			name: "command and manifest hooks",
+			metadata: `hooks:
+  preDeploy:
+    - name: volume
+      manifest: hooks/volume.yaml.tmpl
+  postDeploy:
+    - name: notify
+      command: [echo, "{{ .AppName }}"]
+`,
+		},
+		{
+			name: "missing manifest",
+			metadata: `hooks:
+  preDeploy:
+    - name: rbac
+      manifest: hooks/rbac.yaml.tmpl
+`,
+			wantErr: true,
+		},
+		{
+			name: "both command and manifest",
+			metadata: `hooks:
+  preDeploy:
+    - name: volume
+      command: [echo]
+      manifest: hooks/volume.yaml.tmpl
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing name",
+			metadata: `hooks:
+  postDeploy:
+    - command: [echo]
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"app/podman/metadata.yaml":          {Data: []byte(tt.metadata)},
+				"app/podman/hooks/volume.yaml.tmpl": {Data: []byte("kind: PersistentVolumeClaim\n")},
+			}
+			if err := verifyHooks(fsys, "app/podman"); (err != nil) != tt.wantErr {
+				t.Errorf("verifyHooks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyBuilds(t *testing.T) {
+	tests := []struct {
+		name     string
+		metadata string
+		wantErr  bool
+	}{
+		{
+			name:     "no builds",
+			metadata: "name: app\n",
+		},
+		{
+			name: "build context with a Containerfile",
+			metadata: `builds:
+  - context: build/ui
+    tag: localhost/app-ui:latest
+`,
+		},
+		{
+			name: "missing build context",
+			metadata: `builds:
+  - context: build/api
+    tag: localhost/app-api:latest
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing tag",
+			metadata: `builds:
+  - context: build/ui
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"app/podman/metadata.yaml":          {Data: []byte(tt.metadata)},
+				"app/podman/build/ui/Containerfile": {Data: []byte("FROM registry.access.redhat.com/ubi9/ubi-minimal\n")},
+			}
+			if err := verifyBuilds(fsys, "app/podman"); (err != nil) != tt.wantErr {
+				t.Errorf("verifyBuilds() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVerifyApplications(t *testing.T) {
+	valid := fstest.MapFS{
+		"applications/app/metadata.yaml":               {Data: []byte("name: app\n")},
+		"applications/app/podman/metadata.yaml":        {Data: []byte("name: app\n")},
+		"applications/app/podman/values.yaml":          {Data: []byte("ui:\n  port: 3000\n")},
+		"applications/app/podman/templates/pod.yaml":   {Data: []byte("kind: Pod\n")},
+		"applications/chart/metadata.yaml":             {Data: []byte("name: chart\n")},
+		"applications/chart/openshift/Chart.yaml":      {Data: []byte("name: chart\n")},
+		"applications/chart/openshift/values.yaml":     {Data: []byte("ui:\n  port: 3000\n")},
+		"applications/chart/openshift/templates/a.yml": {Data: []byte("kind: Deployment\n")},
+	}
+	with := func(files fstest.MapFS) fstest.MapFS {
+		fsys := fstest.MapFS{}
+		for name, file := range valid {
+			fsys[name] = file
+		}
+		for name, file := range files {
+			if file == nil {
+				delete(fsys, name)
+
+				continue
+			}
+			fsys[name] = file
+		}
+
+		return fsys
+	}
+
+	tests := []struct {
+		name    string
+		fsys    fstest.MapFS
+		wantErr bool
+	}{
+		{
+			name: "podman and openshift templates",
+			fsys: valid,
+		},
+		{
+			name:    "missing root",
+			fsys:    fstest.MapFS{"other/metadata.yaml": {Data: []byte("name: other\n")}},
+			wantErr: true,
+		},
+		{
+			name:    "no templates",
+			fsys:    fstest.MapFS{"applications/README.md": {Data: []byte("# Applications\n")}},
+			wantErr: true,
+		},
+		{
+			name:    "missing metadata",
+			fsys:    with(fstest.MapFS{"applications/app/metadata.yaml": nil}),
+			wantErr: true,
+		},
+		{
+			name:    "missing runtime file",
+			fsys:    with(fstest.MapFS{"applications/chart/openshift/Chart.yaml": nil}),
+			wantErr: true,
+		},
+		{
+			name:    "no runtime directory",
+			fsys:    with(fstest.MapFS{"applications/empty/metadata.yaml": {Data: []byte("name: empty\n")}}),
+			wantErr: true,
+		},
+		{
+			name:    "alias shadowing a template",
+			fsys:    with(fstest.MapFS{"applications/chart/metadata.yaml": {Data: []byte("name: chart\naliases: [app]\n")}}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := verifyApplications(tt.fsys, "applications"); (err != nil) != tt.wantErr {
+				t.Errorf("verifyApplications() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
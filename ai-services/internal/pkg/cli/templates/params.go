@@ -0,0 +1,100 @@
+package templates
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// stringParamPrefix marks the keys of the CLI overrides whose value stays a string whatever the declared type
+// of the parameter, Eg:- "string:app.version" for --set-string app.version=1.20.
+const stringParamPrefix = "string:"
+
+// StringParam returns the key of a CLI override forcing the value of the parameter to a string,
+// so that a numeric-looking value like a version or a zip code is not coerced to a number.
+func StringParam(key string) string {
+	return stringParamPrefix + key
+}
+
+// ParamKey returns the key of the parameter set by the CLI override, without the marker of StringParam.
+func ParamKey(override string) string {
+	return strings.TrimPrefix(override, stringParamPrefix)
+}
+
+// splitStringParams returns the CLI overrides by the key of their parameter, along with the keys forced to a string.
+func splitStringParams(cliOverrides map[string]string) (map[string]string, map[string]bool, error) {
+	params := make(map[string]string, len(cliOverrides))
+	forced := map[string]bool{}
+	for override, val := range cliOverrides {
+		key := ParamKey(override)
+		if _, ok := params[key]; ok {
+			return nil, nil, fmt.Errorf("parameter '%s' is set both as a string and as a typed value", key)
+		}
+		params[key] = val
+		if key != override {
+			forced[key] = true
+		}
+	}
+
+	return params, forced, nil
+}
+
+// coerceParam converts the string value of a parameter to its declared type,
+// so that numbers and booleans render unquoted in the templates.
+func coerceParam(key, val string, paramType ParamType) (any, error) {
+	switch paramType {
+	case "", ParamTypeString:
+		return val, nil
+	case ParamTypeInt:
+		i, err := strconv.Atoi(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for parameter '%s': expected an int", val, key)
+		}
+
+		return i, nil
+	case ParamTypeFloat:
+		f, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for parameter '%s': expected a float", val, key)
+		}
+
+		return f, nil
+	case ParamTypeBool:
+		b, err := strconv.ParseBool(val)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for parameter '%s': expected a bool", val, key)
+		}
+
+		return b, nil
+	case ParamTypeObject:
+		obj := map[string]any{}
+		if err := decodeJSONParam(val, &obj); err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for parameter '%s': expected a JSON object", val, key)
+		}
+
+		return obj, nil
+	case ParamTypeArray:
+		arr := []any{}
+		if err := decodeJSONParam(val, &arr); err != nil {
+			return nil, fmt.Errorf("invalid value '%s' for parameter '%s': expected a JSON array", val, key)
+		}
+
+		return arr, nil
+	default:
+		return nil, fmt.Errorf("unsupported type '%s' declared for parameter '%s'", paramType, key)
+	}
+}
+
+// decodeJSONParam decodes the JSON value the same way as the values files,
+// Eg:- the whole numbers decode to ints instead of floats.
+func decodeJSONParam(val string, out any) error {
+	if !json.Valid([]byte(val)) {
+		return errors.New("invalid JSON")
+	}
+
+	return yaml.Unmarshal([]byte(val), out)
+}
@@ -0,0 +1,213 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.yaml.in/yaml/v3"
+)
+
+//go:embed testdata
+var testFS embed.FS
+
+func newTestProvider(t *testing.T) Template {
+	t.Helper()
+
+	tp, err := NewEmbedTemplateProvider(EmbedOptions{FS: &testFS, Root: "testdata/applications"})
+	if err != nil {
+		t.Fatalf("NewEmbedTemplateProvider() error = %v", err)
+	}
+
+	return tp
+}
+
+func renderDeployment(t *testing.T, tp Template, params map[string]string) string {
+	t.Helper()
+
+	return renderAppDeployment(t, tp, "typed", params)
+}
+
+func renderAppDeployment(t *testing.T, tp Template, app string, params map[string]string) string {
+	t.Helper()
+
+	values, err := tp.LoadValues(app, nil, params)
+	if err != nil {
+		t.Fatalf("LoadValues() error = %v", err)
+	}
+
+	tmpls, err := tp.LoadAllTemplates(app)
+	if err != nil {
+		t.Fatalf("LoadAllTemplates() error = %v", err)
+	}
+
+	var rendered bytes.Buffer
+	if err := tmpls["deploy.yaml.tmpl"].Execute(&rendered, map[string]any{"Values": values}); err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	return rendered.String()
+}
+
+func TestLoadValuesRendersTypedParams(t *testing.T) {
+	tp := newTestProvider(t)
+
+	out := renderDeployment(t, tp, map[string]string{"app.replicas": "3", "app.debug": "false", "app.name": "demo"})
+
+	if !strings.Contains(out, "replicas: 3\n") {
+		t.Errorf("expected unquoted replica count, got:\n%s", out)
+	}
+	if !strings.Contains(out, `value: "INFO"`) {
+		t.Errorf("expected app.debug=false to be rendered as a bool, got:\n%s", out)
+	}
+
+	var manifest struct {
+		Metadata struct {
+			Name string `yaml:"name"`
+		} `yaml:"metadata"`
+		Spec struct {
+			Replicas any `yaml:"replicas"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(out), &manifest); err != nil {
+		t.Fatalf("rendered output is not valid YAML: %v\n%s", err, out)
+	}
+	if replicas, ok := manifest.Spec.Replicas.(int); !ok || replicas != 3 {
+		t.Errorf("spec.replicas = %#v, want int 3", manifest.Spec.Replicas)
+	}
+	if manifest.Metadata.Name != "demo" {
+		t.Errorf("metadata.name = %q, want %q", manifest.Metadata.Name, "demo")
+	}
+}
+
+func TestLoadValuesRejectsInvalidTypedParams(t *testing.T) {
+	tp := newTestProvider(t)
+
+	tests := []struct {
+		name   string
+		params map[string]string
+	}{
+		{name: "invalid int", params: map[string]string{"app.replicas": "three"}},
+		{name: "invalid bool", params: map[string]string{"app.debug": "maybe"}},
+		{name: "array for an object", params: map[string]string{"app.resources": `["cpu"]`}},
+		{name: "invalid JSON object", params: map[string]string{"app.resources": `{"limits": `}},
+		{name: "object for an array", params: map[string]string{"app.args": `{"port": 8080}`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tp.LoadValues("typed", nil, tt.params); err == nil {
+				t.Errorf("LoadValues() expected an error for %v", tt.params)
+			}
+		})
+	}
+}
+
+func TestLoadValuesRendersStructuredParams(t *testing.T) {
+	tp := newTestProvider(t)
+
+	out := renderDeployment(t, tp, map[string]string{
+		"app.resources": `{"limits": {"cpu": "2", "memory": "4Gi"}}`,
+		"app.args":      `["--port", "8080"]`,
+	})
+
+	var manifest struct {
+		Spec struct {
+			Template struct {
+				Spec struct {
+					Containers []struct {
+						Args      []string `yaml:"args"`
+						Resources struct {
+							Limits map[string]string `yaml:"limits"`
+						} `yaml:"resources"`
+					} `yaml:"containers"`
+				} `yaml:"spec"`
+			} `yaml:"template"`
+		} `yaml:"spec"`
+	}
+	if err := yaml.Unmarshal([]byte(out), &manifest); err != nil {
+		t.Fatalf("rendered output is not valid YAML: %v\n%s", err, out)
+	}
+
+	container := manifest.Spec.Template.Spec.Containers[0]
+	if want := map[string]string{"cpu": "2", "memory": "4Gi"}; !reflect.DeepEqual(container.Resources.Limits, want) {
+		t.Errorf("resources.limits = %v, want %v", container.Resources.Limits, want)
+	}
+	if want := []string{"--port", "8080"}; !reflect.DeepEqual(container.Args, want) {
+		t.Errorf("args = %v, want %v", container.Args, want)
+	}
+}
+
+func TestLoadValuesRendersStringParams(t *testing.T) {
+	tp := newTestProvider(t)
+
+	tests := []struct {
+		name  string
+		value string
+	}{
+		{name: "integer", value: "3"},
+		{name: "version", value: "1.20"},
+		{name: "zip code", value: "02134"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := renderDeployment(t, tp, map[string]string{StringParam("app.replicas"): tt.value})
+
+			if want := "replicas: \"" + tt.value + "\"\n"; !strings.Contains(out, want) {
+				t.Errorf("expected the quoted scalar %q, got:\n%s", want, out)
+			}
+
+			var manifest struct {
+				Spec struct {
+					Replicas any `yaml:"replicas"`
+				} `yaml:"spec"`
+			}
+			if err := yaml.Unmarshal([]byte(out), &manifest); err != nil {
+				t.Fatalf("rendered output is not valid YAML: %v\n%s", err, out)
+			}
+			if replicas, ok := manifest.Spec.Replicas.(string); !ok || replicas != tt.value {
+				t.Errorf("spec.replicas = %#v, want string %q", manifest.Spec.Replicas, tt.value)
+			}
+		})
+	}
+}
+
+func TestLoadValuesRejectsStringParamSetTwice(t *testing.T) {
+	tp := newTestProvider(t)
+
+	params := map[string]string{"app.replicas": "3", StringParam("app.replicas"): "3"}
+	if _, err := tp.LoadValues("typed", nil, params); err == nil {
+		t.Errorf("LoadValues() expected an error for %v", params)
+	}
+
+	params = map[string]string{StringParam("app.unknown"): "1.20"}
+	if _, err := tp.LoadValues("typed", nil, params); err == nil {
+		t.Errorf("LoadValues() expected an error for the unsupported parameter of %v", params)
+	}
+}
+
+func TestLoadValuesIndexedParams(t *testing.T) {
+	tp := newTestProvider(t)
+
+	values, err := tp.LoadValues("typed", nil, map[string]string{"app.args[0]": "--verbose", "app.args[1]": "--trace"})
+	if err != nil {
+		t.Fatalf("LoadValues() error = %v", err)
+	}
+	app := values["app"].(map[string]any)
+	if want := []any{"--verbose", "--trace"}; !reflect.DeepEqual(app["args"], want) {
+		t.Errorf("app.args = %#v, want %#v", app["args"], want)
+	}
+
+	for _, params := range []map[string]string{
+		{"app.args[1]": "--trace"},
+		{"app.name[0]": "demo"},
+		{"app.replicas.count": "3"},
+	} {
+		if _, err := tp.LoadValues("typed", nil, params); err == nil {
+			t.Errorf("LoadValues() expected an error for %v", params)
+		}
+	}
+}
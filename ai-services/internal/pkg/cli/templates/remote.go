@@ -0,0 +1,369 @@
+package templates
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/project-ai-services/ai-services/assets"
+	"github.com/project-ai-services/ai-services/internal/pkg/checksum"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	// MaxBundleSize caps the size of a downloaded template bundle.
+	MaxBundleSize = 20 << 20
+	// MaxBundleContentSize caps the total uncompressed size of the files of a template bundle, against zip bombs.
+	MaxBundleContentSize = 100 << 20
+
+	// maxSidecarSize caps the size of the checksum and signature files served along with a bundle.
+	maxSidecarSize = 64 << 10
+
+	remoteFetchTimeout = 2 * time.Minute
+)
+
+// RemoteOptions tells how a remote template bundle is fetched.
+type RemoteOptions struct {
+	// Checksum is the expected checksum of the bundle, Eg:- sha256:<hex>.
+	// When empty, the one of the .sha256 sidecar served along with the bundle is verified, if any.
+	Checksum string
+	// PublicKey is the PEM cosign public key verifying the signature of the .sig sidecar served along with the bundle,
+	// the signature is not verified when empty.
+	PublicKey []byte
+	// Insecure allows fetching the bundle over plain HTTP.
+	Insecure bool
+}
+
+var (
+	// remoteMu guards the application templates fetched from a remote URL, served along with the embedded ones.
+	remoteMu   sync.Mutex
+	remoteApps = map[string]fs.FS{}
+)
+
+// FetchRemoteApplication downloads the template bundle from the URL and makes its application template available
+// to the template providers, the same as an embedded one. It returns the name of the application template.
+//
+// The bundle is a zip archive holding a single top level directory named after the application template,
+// laid out as the embedded ones, Eg:- rag/metadata.yaml, rag/podman/...
+func FetchRemoteApplication(ctx context.Context, rawURL string, opts RemoteOptions) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid template URL '%s': %w", rawURL, err)
+	}
+	switch {
+	case u.Scheme == "https":
+	case u.Scheme == "http" && opts.Insecure:
+		logger.Warningf("Fetching the application template over plain HTTP from %s, its content can be tampered with in transit\n", u.Host)
+	case u.Scheme == "http":
+		return "", fmt.Errorf("template URL '%s' is not HTTPS, set --insecure to fetch it over plain HTTP", rawURL)
+	default:
+		return "", fmt.Errorf("template URL '%s' must use the https scheme", rawURL)
+	}
+
+	if opts.Checksum != "" {
+		if _, err := checksum.Parse(opts.Checksum); err != nil {
+			return "", err
+		}
+	}
+
+	data, err := download(ctx, u, "template bundle", MaxBundleSize, opts.Insecure)
+	if err != nil {
+		return "", err
+	}
+	if err := verifyBundle(ctx, u, data, opts); err != nil {
+		return "", err
+	}
+
+	name, bundle, err := openBundle(data)
+	if err != nil {
+		return "", fmt.Errorf("invalid template bundle %s: %w", rawURL, err)
+	}
+	if err := registerRemoteApplication(name, bundle); err != nil {
+		return "", err
+	}
+	logger.Infof("Fetched application template '%s' from %s\n", name, rawURL, logger.VerbosityLevelDebug)
+
+	return name, nil
+}
+
+// maxRedirects is the number of redirects followed by a download, as many as the default HTTP client follows.
+const maxRedirects = 10
+
+var (
+	// errNotServed is matched with errors.Is by the error of a file not found on the server.
+	errNotServed = errors.New("not served")
+	// errInsecureRedirect is matched with errors.Is by the error of a redirect to plain HTTP, refused unless insecure.
+	errInsecureRedirect = errors.New("redirected to plain HTTP")
+)
+
+// download downloads the file, described by what in the errors, retrying the failures which may be transient as per
+// --retry-count, Eg:- a rate limited server after the delay it asked for. The redirects to plain HTTP are only
+// followed when insecure.
+func download(ctx context.Context, u *url.URL, what string, limit int64, insecure bool) ([]byte, error) {
+	var data []byte
+	err := utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
+		var err error
+		data, err = downloadOnce(ctx, u, what, limit, insecure)
+
+		return err
+	})
+
+	return data, err
+}
+
+// downloadOnce downloads the file, failing once it exceeds limit rather than reading it whole.
+// The failures which cannot be transient are marked permanent.
+func downloadOnce(ctx context.Context, u *url.URL, what string, limit int64, insecure bool) ([]byte, error) {
+	defer trace.Start("fetch " + u.String())()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, utils.Permanent(fmt.Errorf("failed to build the request of the %s: %w", what, err))
+	}
+
+	client := utils.NewHTTPClient(remoteFetchTimeout)
+	client.CheckRedirect = checkRedirect(insecure)
+	resp, err := client.Do(req)
+	if errors.Is(err, errInsecureRedirect) {
+		return nil, utils.Permanent(fmt.Errorf("failed to fetch the %s: %w", what, err))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the %s: %w", what, err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, utils.Permanent(fmt.Errorf("failed to fetch the %s %s: %w: %s", what, u, errNotServed, resp.Status))
+	case utils.IsThrottled(resp):
+		return nil, utils.WithRetryAfter(fmt.Errorf("failed to fetch the %s %s: %s", what, u, resp.Status), utils.RetryAfter(resp))
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return nil, fmt.Errorf("failed to fetch the %s %s: %s", what, u, resp.Status)
+	case resp.StatusCode != http.StatusOK:
+		return nil, utils.Permanent(fmt.Errorf("failed to fetch the %s %s: %s", what, u, resp.Status))
+	case resp.ContentLength > limit:
+		return nil, utils.Permanent(fmt.Errorf("%s %s is %d bytes, over the limit of %d bytes", what, u, resp.ContentLength, limit))
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the %s: %w", what, err)
+	}
+	if int64(len(data)) > limit {
+		return nil, utils.Permanent(fmt.Errorf("%s %s is over the limit of %d bytes", what, u, limit))
+	}
+
+	return data, nil
+}
+
+// checkRedirect refuses the redirects to another scheme than HTTPS unless insecure, as the scheme of the URL
+// is only checked before the first request, Eg:- an HTTPS URL redirected to plain HTTP.
+func checkRedirect(insecure bool) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if req.URL.Scheme != "https" && !insecure {
+			return fmt.Errorf("%w: %s, set --insecure to follow it", errInsecureRedirect, req.URL.Redacted())
+		}
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+
+		return nil
+	}
+}
+
+// verifyBundle verifies the checksum of the bundle, the given one or else the one of the .sha256 sidecar served along with it,
+// then its cosign signature served as the .sig sidecar when a public key is given.
+func verifyBundle(ctx context.Context, u *url.URL, data []byte, opts RemoteOptions) error {
+	expected := opts.Checksum
+	if expected == "" {
+		sidecar, err := download(ctx, sidecarURL(u, checksum.SidecarSuffix), "checksum file", maxSidecarSize, opts.Insecure)
+		switch {
+		case errors.Is(err, errNotServed):
+			logger.Warningf("No checksum is given nor served along with the template bundle, its %s is not verified\n", checksum.Of(data))
+		case err != nil:
+			return err
+		default:
+			if expected, err = checksum.ParseSidecar(sidecar); err != nil {
+				return fmt.Errorf("checksum file %s: %w", sidecarURL(u, checksum.SidecarSuffix), err)
+			}
+		}
+	}
+	if expected != "" {
+		if err := checksum.Verify(data, expected); err != nil {
+			return fmt.Errorf("template bundle %s: %w", u, err)
+		}
+	}
+
+	if len(opts.PublicKey) == 0 {
+		return nil
+	}
+	sig, err := download(ctx, sidecarURL(u, checksum.SignatureSuffix), "signature", maxSidecarSize, opts.Insecure)
+	if err != nil {
+		return err
+	}
+	if err := checksum.VerifySignature(data, sig, opts.PublicKey); err != nil {
+		return fmt.Errorf("template bundle %s: %w", u, err)
+	}
+
+	return nil
+}
+
+// sidecarURL returns the URL of the sidecar file of the bundle, named after it with the suffix.
+func sidecarURL(u *url.URL, suffix string) *url.URL {
+	sidecar := *u
+	sidecar.Path += suffix
+	sidecar.RawPath = ""
+
+	return &sidecar
+}
+
+// openBundle opens the zip archive of the bundle, returning the name of its application template
+// along with the FS rooted at its directory, once verified the same as the embedded templates.
+func openBundle(data []byte) (string, fs.FS, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", nil, fmt.Errorf("not a zip archive: %w", err)
+	}
+
+	var total uint64
+	names := map[string]struct{}{}
+	for _, f := range zr.File {
+		// zip.Reader.Open refuses the unsafe paths, they are rejected upfront rather than silently left out
+		if !fs.ValidPath(strings.TrimSuffix(f.Name, "/")) {
+			return "", nil, fmt.Errorf("unsafe path '%s'", f.Name)
+		}
+		total += f.UncompressedSize64
+		if total > MaxBundleContentSize {
+			return "", nil, fmt.Errorf("content is over the limit of %d bytes", MaxBundleContentSize)
+		}
+		top, _, _ := strings.Cut(f.Name, "/")
+		names[top] = struct{}{}
+	}
+	if len(names) != 1 {
+		return "", nil, fmt.Errorf("expected a single top level directory named after the application template, found %d entries", len(names))
+	}
+
+	var name string
+	for n := range names {
+		name = n
+	}
+	if err := verifyApplication(zr, name); err != nil {
+		return "", nil, fmt.Errorf("template '%s': %w", name, err)
+	}
+
+	bundle, err := fs.Sub(zr, name)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return name, bundle, nil
+}
+
+// registerRemoteApplication serves the application template along with the embedded ones,
+// which it must not shadow.
+func registerRemoteApplication(name string, bundle fs.FS) error {
+	if _, err := fs.Stat(&assets.ApplicationFS, path.Join(defaultRoot, name)); err == nil {
+		return fmt.Errorf("remote application template '%s' clashes with the built-in template of the same name", name)
+	}
+
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+	remoteApps[name] = bundle
+
+	return nil
+}
+
+// templateFS is the FS the application templates are read from.
+type templateFS interface {
+	fs.ReadFileFS
+	fs.ReadDirFS
+}
+
+// overlayFS serves the application templates fetched from a remote URL along with the ones of the base FS.
+type overlayFS struct {
+	base   templateFS
+	root   string
+	remote map[string]fs.FS
+}
+
+// defaultTemplateFS returns the FS of the built-in application templates, overlaid with the remote ones if any.
+func defaultTemplateFS() templateFS {
+	remoteMu.Lock()
+	defer remoteMu.Unlock()
+
+	if len(remoteApps) == 0 {
+		return &assets.ApplicationFS
+	}
+
+	return &overlayFS{base: &assets.ApplicationFS, root: defaultRoot, remote: maps.Clone(remoteApps)}
+}
+
+// route returns the FS serving the named file, along with its name within it.
+func (o *overlayFS) route(name string) (fs.FS, string) {
+	rel, ok := strings.CutPrefix(name, o.root+"/")
+	if !ok {
+		return o.base, name
+	}
+
+	app, rest, _ := strings.Cut(rel, "/")
+	bundle, ok := o.remote[app]
+	if !ok {
+		return o.base, name
+	}
+	if rest == "" {
+		rest = "."
+	}
+
+	return bundle, rest
+}
+
+func (o *overlayFS) Open(name string) (fs.File, error) {
+	fsys, n := o.route(name)
+
+	return fsys.Open(n)
+}
+
+func (o *overlayFS) ReadFile(name string) ([]byte, error) {
+	fsys, n := o.route(name)
+
+	return fs.ReadFile(fsys, n)
+}
+
+// ReadDir lists the remote application templates along with the embedded ones in the root directory.
+func (o *overlayFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if name != o.root {
+		fsys, n := o.route(name)
+
+		return fs.ReadDir(fsys, n)
+	}
+
+	entries, err := o.base.ReadDir(name)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	for _, bundle := range o.remote {
+		info, err := fs.Stat(bundle, ".")
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fs.FileInfoToDirEntry(info))
+	}
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int { return strings.Compare(a.Name(), b.Name()) })
+
+	return entries, nil
+}
@@ -0,0 +1,348 @@
+package templates
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/checksum"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+)
+
+// bundleFiles is a minimal podman application template, laid out as in a remote bundle.
+var bundleFiles = map[string]string{
+	"remote-rag/metadata.yaml":                        "name: remote-rag\ndescription: Remote RAG\n",
+	"remote-rag/podman/metadata.yaml":                 "name: remote-rag\nversion: 0.1.0\npodTemplateExecutions:\n  - [pod.yaml.tmpl]\n",
+	"remote-rag/podman/values.yaml":                   "# @description Number of replicas.\nreplicas: 1\n",
+	"remote-rag/podman/templates/pod.yaml.tmpl":       "kind: Pod\n",
+	"remote-rag/podman/steps/next.md":                 "Done\n",
+	"remote-rag/podman/templates/configmap.yaml.tmpl": "kind: ConfigMap\n",
+}
+
+func zipBundle(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buf.Bytes()
+}
+
+// serveBundle serves the bundle as bundle.zip along with its sidecars, keyed by suffix, and returns its URL.
+func serveBundle(t *testing.T, data []byte, sidecars map[string][]byte) string {
+	t.Helper()
+
+	files := map[string][]byte{"/bundle.zip": data}
+	for suffix, content := range sidecars {
+		files["/bundle.zip"+suffix] = content
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+		_, _ = w.Write(content)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() {
+		remoteMu.Lock()
+		defer remoteMu.Unlock()
+		clear(remoteApps)
+	})
+
+	return srv.URL + "/bundle.zip"
+}
+
+func validBundleFiles() map[string]string {
+	return maps.Clone(bundleFiles)
+}
+
+func TestFetchRemoteApplication(t *testing.T) {
+	data := zipBundle(t, validBundleFiles())
+	bundleURL := serveBundle(t, data, nil)
+
+	name, err := FetchRemoteApplication(context.Background(), bundleURL,
+		RemoteOptions{Checksum: checksum.Of(data), Insecure: true})
+	if err != nil {
+		t.Fatalf("FetchRemoteApplication() error = %v", err)
+	}
+	if name != "remote-rag" {
+		t.Fatalf("FetchRemoteApplication() = %q, want remote-rag", name)
+	}
+
+	tp, err := NewEmbedTemplateProvider(EmbedOptions{})
+	if err != nil {
+		t.Fatalf("NewEmbedTemplateProvider() error = %v", err)
+	}
+	apps, err := tp.ListApplications(false)
+	if err != nil {
+		t.Fatalf("ListApplications() error = %v", err)
+	}
+	if !slices.Contains(apps, "remote-rag") || len(apps) < 2 {
+		t.Errorf("ListApplications() = %v, want the remote template along with the built-in ones", apps)
+	}
+
+	md, err := tp.LoadMetadata("remote-rag", true)
+	if err != nil || md.Version != "0.1.0" {
+		t.Fatalf("LoadMetadata() = %+v, %v, want the version of the remote template", md, err)
+	}
+	tmpls, err := tp.LoadAllTemplates("remote-rag")
+	if err != nil {
+		t.Fatalf("LoadAllTemplates() error = %v", err)
+	}
+	if len(tmpls) != 2 {
+		t.Errorf("LoadAllTemplates() = %d templates, want 2", len(tmpls))
+	}
+}
+
+func TestFetchRemoteApplicationRejected(t *testing.T) {
+	data := zipBundle(t, validBundleFiles())
+	bundleURL := serveBundle(t, data, nil)
+
+	unsafe := zipBundle(t, map[string]string{"remote-rag/../escape.yaml": ""})
+	unsafeURL := serveBundle(t, unsafe, nil)
+
+	twoApps := validBundleFiles()
+	twoApps["other/metadata.yaml"] = "name: other\n"
+	twoAppsURL := serveBundle(t, zipBundle(t, twoApps), nil)
+
+	incomplete := validBundleFiles()
+	delete(incomplete, "remote-rag/podman/values.yaml")
+	incompleteURL := serveBundle(t, zipBundle(t, incomplete), nil)
+
+	builtin := map[string]string{}
+	for name, content := range validBundleFiles() {
+		builtin["rag/"+strings.TrimPrefix(name, "remote-rag/")] = content
+	}
+	builtinURL := serveBundle(t, zipBundle(t, builtin), nil)
+
+	tests := []struct {
+		name    string
+		url     string
+		opts    RemoteOptions
+		wantErr string
+	}{
+		{name: "plain http", url: bundleURL, wantErr: "is not HTTPS, set --insecure"},
+		{name: "unsupported scheme", url: "file:///tmp/rag.zip", wantErr: "must use the https scheme"},
+		{name: "invalid checksum", url: bundleURL, opts: RemoteOptions{Checksum: "md5:abc", Insecure: true}, wantErr: "invalid checksum"},
+		{name: "checksum mismatch", url: bundleURL, opts: RemoteOptions{Checksum: checksum.Of([]byte("other")), Insecure: true}, wantErr: "checksum mismatch"},
+		{name: "unsafe path", url: unsafeURL, opts: RemoteOptions{Insecure: true}, wantErr: "unsafe path"},
+		{name: "several directories", url: twoAppsURL, opts: RemoteOptions{Insecure: true}, wantErr: "single top level directory"},
+		{name: "incomplete template", url: incompleteURL, opts: RemoteOptions{Insecure: true}, wantErr: "podman/values.yaml is missing"},
+		{name: "built-in name", url: builtinURL, opts: RemoteOptions{Insecure: true}, wantErr: "clashes with the built-in template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FetchRemoteApplication(context.Background(), tt.url, tt.opts)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("FetchRemoteApplication() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFetchRemoteApplicationStatus(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	_, err := FetchRemoteApplication(context.Background(), srv.URL+"/rag.zip", RemoteOptions{Insecure: true})
+	if err == nil || !strings.Contains(err.Error(), "404 Not Found") {
+		t.Errorf("FetchRemoteApplication() error = %v, want the status of the response", err)
+	}
+}
+
+func TestFetchRemoteApplicationThrottled(t *testing.T) {
+	count, interval := vars.RetryCount, vars.RetryInterval
+	vars.RetryCount, vars.RetryInterval = 1, 0
+	t.Cleanup(func() { vars.RetryCount, vars.RetryInterval = count, interval })
+
+	data := zipBundle(t, validBundleFiles())
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rag.zip" {
+			http.NotFound(w, r)
+
+			return
+		}
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer srv.Close()
+	t.Cleanup(func() {
+		remoteMu.Lock()
+		defer remoteMu.Unlock()
+		clear(remoteApps)
+	})
+
+	if _, err := FetchRemoteApplication(context.Background(), srv.URL+"/rag.zip", RemoteOptions{Insecure: true}); err != nil || calls != 2 {
+		t.Fatalf("FetchRemoteApplication() = %v after %d calls, want success after a retry", err, calls)
+	}
+
+	vars.RetryCount = 0
+	limited := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer limited.Close()
+
+	_, err := FetchRemoteApplication(context.Background(), limited.URL+"/rag.zip", RemoteOptions{Insecure: true})
+	if after, ok := utils.RetryAfterOf(err); !ok || after != 30*time.Second {
+		t.Errorf("FetchRemoteApplication() error = %v, want it to carry the 30s delay asked by the server", err)
+	}
+}
+
+func TestFetchRemoteApplicationTooLarge(t *testing.T) {
+	bundleURL := serveBundle(t, bytes.Repeat([]byte{0}, MaxBundleSize+1), nil)
+
+	_, err := FetchRemoteApplication(context.Background(), bundleURL, RemoteOptions{Insecure: true})
+	if err == nil || !strings.Contains(err.Error(), "over the limit") {
+		t.Errorf("FetchRemoteApplication() error = %v, want the size limit to be enforced", err)
+	}
+}
+
+func TestFetchRemoteApplicationRedirect(t *testing.T) {
+	utils.SetInsecureSkipTLSVerify(true)
+	t.Cleanup(func() { utils.SetInsecureSkipTLSVerify(false) })
+
+	bundleURL := serveBundle(t, zipBundle(t, validBundleFiles()), nil)
+	calls := 0
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rag.zip" {
+			http.NotFound(w, r)
+
+			return
+		}
+		calls++
+		http.Redirect(w, r, bundleURL, http.StatusFound)
+	}))
+	defer srv.Close()
+
+	_, err := FetchRemoteApplication(context.Background(), srv.URL+"/rag.zip", RemoteOptions{})
+	if !errors.Is(err, errInsecureRedirect) || calls != 1 {
+		t.Fatalf("FetchRemoteApplication() error = %v after %d calls, want the redirect to plain HTTP refused once", err, calls)
+	}
+
+	if _, err := FetchRemoteApplication(context.Background(), srv.URL+"/rag.zip", RemoteOptions{Insecure: true}); err != nil {
+		t.Errorf("FetchRemoteApplication() error = %v, want the redirect followed with --insecure", err)
+	}
+}
+
+func TestFetchRemoteApplicationSidecars(t *testing.T) {
+	data := zipBundle(t, validBundleFiles())
+	digest := strings.TrimPrefix(checksum.Of(data), "sha256:")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(data)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := cryptoutils.MarshalPublicKeyToPEM(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPublicKey, err := cryptoutils.MarshalPublicKeyToPEM(otherKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		sidecars map[string][]byte
+		opts     RemoteOptions
+		wantErr  string
+	}{
+		{
+			name:     "sha256sum sidecar",
+			sidecars: map[string][]byte{".sha256": []byte(digest + "  bundle.zip\n")},
+		},
+		{
+			name:     "mismatching sidecar",
+			sidecars: map[string][]byte{".sha256": []byte(strings.Repeat("0", 64))},
+			wantErr:  "checksum mismatch",
+		},
+		{
+			name:     "given checksum over the sidecar",
+			sidecars: map[string][]byte{".sha256": []byte(strings.Repeat("0", 64))},
+			opts:     RemoteOptions{Checksum: checksum.Of(data)},
+		},
+		{
+			name:     "signed",
+			sidecars: map[string][]byte{".sig": []byte(base64.StdEncoding.EncodeToString(sig))},
+			opts:     RemoteOptions{PublicKey: publicKey},
+		},
+		{
+			name:     "signed by another key",
+			sidecars: map[string][]byte{".sig": []byte(base64.StdEncoding.EncodeToString(sig))},
+			opts:     RemoteOptions{PublicKey: otherPublicKey},
+			wantErr:  "invalid signature",
+		},
+		{
+			name:    "signature missing",
+			opts:    RemoteOptions{PublicKey: publicKey},
+			wantErr: "failed to fetch the signature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundleURL := serveBundle(t, data, tt.sidecars)
+			tt.opts.Insecure = true
+
+			_, err := FetchRemoteApplication(context.Background(), bundleURL, tt.opts)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("FetchRemoteApplication() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("FetchRemoteApplication() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
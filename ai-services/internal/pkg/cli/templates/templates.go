@@ -10,15 +10,69 @@ import (
 )
 
 type AppMetadata struct {
-	Name                  string           `yaml:"name,omitempty"`
-	Description           string           `yaml:"description,omitempty"`
-	Hidden                bool             `yaml:"hidden,omitempty"`
-	Version               string           `yaml:"version,omitempty"`
-	SMTLevel              *int             `yaml:"smtLevel,omitempty"`
+	Name        string `yaml:"name,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Hidden      bool   `yaml:"hidden,omitempty"`
+	Version     string `yaml:"version,omitempty"`
+	// Category groups the application template in the listing, Eg:- inference-serving, fine-tuning or utility.
+	Category string `yaml:"category,omitempty"`
+	// Aliases are the deprecated names the application template is still resolvable by.
+	Aliases  []string `yaml:"aliases,omitempty"`
+	SMTLevel *int     `yaml:"smtLevel,omitempty"`
+	// MinSpyreCards is the minimum number of spyre cards the application works with, Eg:- for large model serving.
+	// The deployment fails early below it, none when zero.
+	MinSpyreCards         int              `yaml:"minSpyreCards,omitempty"`
 	PodTemplateExecutions [][]string       `yaml:"podTemplateExecutions"`
 	Openshift             OpenshiftRuntime `yaml:"openshift,omitempty"`
+	// ParamTypes declares the type of parameters which must not be rendered as strings.
+	// Key -> dotted parameter name, Value -> one of string, int, float or bool.
+	ParamTypes map[string]ParamType `yaml:"paramTypes,omitempty"`
+	// Hooks are run in order around the deployment of the pod templates.
+	Hooks Hooks `yaml:"hooks,omitempty"`
+	// Builds are the local images built from a Containerfile shipped with the template, before the images are pulled.
+	Builds []Build `yaml:"builds,omitempty"`
 }
 
+// Build is a local image built from a build context shipped with the template.
+type Build struct {
+	// Context is the path of the build context directory relative to the runtime directory, eg:- build/ui.
+	// It holds the Containerfile of the image.
+	Context string `yaml:"context"`
+	// Tag is the tag of the built image, referenced by the pod templates, eg:- localhost/rag-ui:latest.
+	Tag string `yaml:"tag"`
+}
+
+// Hooks holds the ordered hooks to run before and after deploying an application.
+// A failing pre-deploy hook aborts the deployment.
+type Hooks struct {
+	PreDeploy  []Hook `yaml:"preDeploy,omitempty"`
+	PostDeploy []Hook `yaml:"postDeploy,omitempty"`
+}
+
+// Hook is a single deploy hook, running either a command on the host or a manifest.
+// Both the command arguments and the manifest are rendered with the application values.
+// Hooks run on every create, so they must be idempotent.
+type Hook struct {
+	Name string `yaml:"name"`
+	// Command is the command, along with its arguments, to run on the host.
+	Command []string `yaml:"command,omitempty"`
+	// Manifest is the path of a manifest template relative to the runtime directory, eg:- hooks/volume.yaml.tmpl.
+	Manifest string `yaml:"manifest,omitempty"`
+}
+
+// ParamType is the declared type of a template parameter.
+type ParamType string
+
+const (
+	ParamTypeString ParamType = "string"
+	ParamTypeInt    ParamType = "int"
+	ParamTypeFloat  ParamType = "float"
+	ParamTypeBool   ParamType = "bool"
+	// ParamTypeObject and ParamTypeArray are the structured parameters, set with a JSON value.
+	ParamTypeObject ParamType = "object"
+	ParamTypeArray  ParamType = "array"
+)
+
 type OpenshiftRuntime struct {
 	Timeout time.Duration `yaml:"timeout,omitempty"`
 }
@@ -51,8 +105,17 @@ type HostVar struct {
 type Template interface {
 	// ListApplications lists all available application templates
 	ListApplications(hidden bool) ([]string, error)
+	// ResolveApplication resolves a deprecated alias to its application template name, warning about the deprecation.
+	// Names which are not aliases are returned as is.
+	ResolveApplication(name string) (string, error)
+	// ListVersions lists the versions of an application template, newest first.
+	ListVersions(app string) ([]string, error)
+	// ResolveVersion returns the reference of the given version of an application template, see Ref.
+	ResolveVersion(app, version string) (string, error)
 	// ListApplicationTemplateValues lists all available template parameters with description for a single application.
 	ListApplicationTemplateValues(app string) (map[string]string, error)
+	// ListRequiredApplicationTemplateValues lists all template parameters marked as required for a single application.
+	ListRequiredApplicationTemplateValues(app string) (map[string]bool, error)
 	// LoadAllTemplates loads all templates for a given application
 	LoadAllTemplates(app string) (map[string]*template.Template, error)
 	// LoadPodTemplate loads and renders a pod template with the given parameters
@@ -60,6 +123,10 @@ type Template interface {
 	// LoadPodTemplateWithValues loads and renders a pod template with values from application
 	LoadPodTemplateWithValues(app, file, appName string, valuesFileOverrides []string, cliOverrides map[string]string) (*models.PodSpec, error)
 	LoadValues(app string, valuesFileOverrides []string, cliOverrides map[string]string) (map[string]interface{}, error)
+	// LoadHookManifest loads and renders a hook manifest of an application with the given parameters
+	LoadHookManifest(app, file string, params any) ([]byte, error)
+	// CopyBuildContext copies a build context directory of an application to dest on the host
+	CopyBuildContext(app, dir, dest string) error
 	// LoadMetadata loads the metadata for a given application template
 	LoadMetadata(app string, isRuntime bool) (*AppMetadata, error)
 	// LoadMdFiles loads all md files for a given application
@@ -0,0 +1,157 @@
+package templates
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	// LatestVersion selects the current version of an application template.
+	LatestVersion = "latest"
+
+	/*
+		Versions Pattern :- "<root>/<AppName>/versions/<Version>/metadata.yaml"
+		The current version of a template lives at its top level, while the earlier versions it still ships
+		are full copies of the template, laid out the same way, under its versions directory.
+	*/
+	versionsDir = "versions"
+
+	// refSeparator separates the application name from the version in a template reference, Eg:- rag@0.0.1.
+	refSeparator = "@"
+)
+
+// Ref returns the reference of the given version of an application template, accepted by all the Template methods.
+// An empty or latest version refers to the current version of the template.
+func Ref(app, version string) string {
+	if version == "" || version == LatestVersion {
+		return app
+	}
+
+	return app + refSeparator + version
+}
+
+// SplitRef splits a template reference into the application name and its version, empty for the current version.
+func SplitRef(ref string) (string, string) {
+	app, version, _ := strings.Cut(ref, refSeparator)
+
+	return app, version
+}
+
+// appDir returns the directory of the application template version referred by ref.
+func (e *embedTemplateProvider) appDir(ref string) string {
+	app, version := SplitRef(ref)
+	if version == "" {
+		return path.Join(e.root, app)
+	}
+
+	return path.Join(e.root, app, versionsDir, version)
+}
+
+// runtimeVersion returns the version of the template runtime directory, which is labelled on the deployed resources.
+// It is the version of the podman metadata, or else the app version of the OpenShift chart.
+func runtimeVersion(fsys fs.FS, rtDir string, rt types.RuntimeType) (string, error) {
+	file := "metadata.yaml"
+	if rt == types.RuntimeTypeOpenShift {
+		file = "Chart.yaml"
+	}
+
+	data, err := fs.ReadFile(fsys, path.Join(rtDir, file))
+	if err != nil {
+		return "", fmt.Errorf("read %s: %w", file, err)
+	}
+
+	var md struct {
+		Version    string `yaml:"version"`
+		AppVersion string `yaml:"appVersion"`
+	}
+	if err := yaml.Unmarshal(data, &md); err != nil {
+		return "", fmt.Errorf("invalid %s: %w", file, err)
+	}
+
+	if rt == types.RuntimeTypeOpenShift {
+		return md.AppVersion, nil
+	}
+
+	return md.Version, nil
+}
+
+// ListVersions lists the versions of an application template, newest first.
+func (e *embedTemplateProvider) ListVersions(app string) ([]string, error) {
+	current, err := runtimeVersion(e.fs, path.Join(e.root, app, e.Runtime()), e.runtime)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []string
+	entries, err := fs.ReadDir(e.fs, path.Join(e.root, app, versionsDir))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("read versions: %w", err)
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() == current {
+			continue
+		}
+		// a version is only listed for the runtimes it ships
+		if _, err := fs.Stat(e.fs, path.Join(e.root, app, versionsDir, entry.Name(), e.Runtime())); err == nil {
+			versions = append(versions, entry.Name())
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b string) int { return compareVersions(b, a) })
+
+	return append([]string{current}, versions...), nil
+}
+
+// ResolveVersion returns the reference of the given version of an application template.
+// The current version, whether given as latest or by its number, resolves to the application name itself.
+func (e *embedTemplateProvider) ResolveVersion(app, version string) (string, error) {
+	if version == "" || version == LatestVersion {
+		return app, nil
+	}
+
+	versions, err := e.ListVersions(app)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case version == versions[0]:
+		return app, nil
+	case slices.Contains(versions, version):
+		return Ref(app, version), nil
+	default:
+		return "", fmt.Errorf("application template '%s' has no version '%s', available versions: %s",
+			app, version, strings.Join(versions, ", "))
+	}
+}
+
+// compareVersions compares dotted versions number by number, falling back to a plain comparison for the other parts.
+// Eg:- 0.10.0 is newer than 0.9.1.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := range min(len(as), len(bs)) {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		if aErr != nil || bErr != nil {
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+
+			continue
+		}
+		if an != bn {
+			return an - bn
+		}
+	}
+
+	return len(as) - len(bs)
+}
@@ -0,0 +1,111 @@
+package templates
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestListVersions(t *testing.T) {
+	tp := newTestProvider(t)
+
+	got, err := tp.ListVersions("typed")
+	if err != nil {
+		t.Fatalf("ListVersions() error = %v", err)
+	}
+	if want := []string{"0.1.0", "0.0.1"}; !slices.Equal(got, want) {
+		t.Errorf("ListVersions() = %v, want %v", got, want)
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{name: "unset", version: "", want: "typed"},
+		{name: "latest", version: LatestVersion, want: "typed"},
+		{name: "current version", version: "0.1.0", want: "typed"},
+		{name: "earlier version", version: "0.0.1", want: "typed@0.0.1"},
+		{name: "unknown version", version: "9.9.9", wantErr: true},
+	}
+
+	tp := newTestProvider(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tp.ResolveVersion("typed", tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ResolveVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadPinnedVersion(t *testing.T) {
+	tp := newTestProvider(t)
+	ref := Ref("typed", "0.0.1")
+
+	md, err := tp.LoadMetadata(ref, true)
+	if err != nil {
+		t.Fatalf("LoadMetadata() error = %v", err)
+	}
+	if md.Name != "typed" || md.Version != "0.0.1" {
+		t.Errorf("LoadMetadata() = %s %s, want typed 0.0.1", md.Name, md.Version)
+	}
+
+	if got := renderAppDeployment(t, tp, ref, map[string]string{"app.replicas": "2"}); !strings.Contains(got, "name: typed-legacy") || !strings.Contains(got, "replicas: 2") {
+		t.Errorf("rendered deployment of version 0.0.1:\n%s", got)
+	}
+}
+
+func TestVerifyVersions(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		wantErr bool
+	}{
+		{name: "matching version", version: "0.0.1"},
+		{name: "mismatching version", version: "0.0.2", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{
+				"app/versions/0.0.1/metadata.yaml":                     {Data: []byte("name: app\n")},
+				"app/versions/0.0.1/podman/metadata.yaml":              {Data: []byte("version: " + tt.version + "\n")},
+				"app/versions/0.0.1/podman/values.yaml":                {Data: []byte("{}\n")},
+				"app/versions/0.0.1/podman/templates/deploy.yaml.tmpl": {Data: []byte("kind: Pod\n")},
+			}
+			if err := verifyVersions(fsys, "app"); (err != nil) != tt.wantErr {
+				t.Errorf("verifyVersions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "0.10.0", b: "0.9.1", want: 1},
+		{a: "0.0.1", b: "0.0.1", want: 0},
+		{a: "1.0", b: "1.0.1", want: -1},
+		{a: "1.0.0-rc1", b: "1.0.0-rc2", want: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			got := compareVersions(tt.a, tt.b)
+			if (got > 0) != (tt.want > 0) || (got < 0) != (tt.want < 0) {
+				t.Errorf("compareVersions(%q, %q) = %d, want sign of %d", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
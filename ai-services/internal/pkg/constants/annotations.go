@@ -6,3 +6,7 @@ const (
 	PodStartAnnotationkey    = "ai-services.io/start"
 	PodPortsAnnotationKey    = "ai-services.io/ports"
 )
+
+// ReservedKeyDomain is the domain of the label and annotation keys managed by AI Services,
+// which cannot be set by the custom labels and annotations of the deployed resources.
+const ReservedKeyDomain = "ai-services.io"
@@ -9,6 +9,16 @@ const (
 	ApplicationsPath     = "/var/lib/ai-services/applications"
 	OperatorPollInterval = 5 * time.Second
 	OperatorPollTimeout  = 2 * time.Minute
+	// MinOpenShiftVersion is the oldest OpenShift release providing all the required operators.
+	MinOpenShiftVersion = "4.16"
+	// MinServiceReportVersion is the oldest servicereport tool supporting the spyre plugin.
+	MinServiceReportVersion = "2.2"
+	// DefaultModelDownloadConcurrency is the default number of parallel model download streams.
+	DefaultModelDownloadConcurrency = 4
+	// ImageRegistry is the registry namespace hosting the ai-services container images.
+	ImageRegistry = "icr.io/ai-services"
+	// ToolImageEnv overrides the default tool image, Eg:- to pin it by digest.
+	ToolImageEnv = "AI_SERVICES_TOOL_IMAGE"
 )
 
 // OperatorConfig defines configuration for an operator.
@@ -18,7 +28,8 @@ type OperatorConfig struct {
 	Label     string
 }
 
-// RequiredOperators defines all operators that need to be installed and ready.
+// RequiredOperators defines all operators that need to be installed and ready, in their default namespace.
+// The namespaces are overridden with --operators-namespace-map, see vars.RequiredOperators.
 var RequiredOperators = []OperatorConfig{
 	{
 		Name:      "secondary-scheduler-operator",
@@ -60,6 +71,19 @@ const (
 	ValidationLevelCritical // Critical failures require immediate exit
 )
 
+func (l ValidationLevel) String() string {
+	switch l {
+	case ValidationLevelWarning:
+		return "warning"
+	case ValidationLevelError:
+		return "error"
+	case ValidationLevelCritical:
+		return "critical"
+	default:
+		return "unknown"
+	}
+}
+
 // HealthStatus represents the type for Container Health status.
 type HealthStatus string
 
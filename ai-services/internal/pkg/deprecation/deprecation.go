@@ -0,0 +1,63 @@
+package deprecation
+
+import (
+	"slices"
+	"sync"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// Kind is the kind of the deprecated item.
+type Kind string
+
+const (
+	KindFlag     Kind = "flag"
+	KindTemplate Kind = "template"
+)
+
+// Warning is the structured warning about the use of a deprecated item, emitted in the structured outputs
+// so that the pipelines can detect the deprecated usages before their removal breaks them.
+type Warning struct {
+	Type string `json:"type"`
+	Kind Kind   `json:"kind"`
+	// Name is the deprecated item, Eg:- --skip-image-download or the alias of a template.
+	Name string `json:"name"`
+	// Replacement is the item to use instead, if any.
+	Replacement string `json:"replacement,omitempty"`
+	Message     string `json:"message"`
+}
+
+// warningType tells the deprecation warnings apart from the other warnings of a structured output.
+const warningType = "deprecation"
+
+var (
+	// mu guards the deprecation warnings of the run.
+	mu       sync.Mutex
+	warnings []Warning
+)
+
+// Record records the warning without logging it, for the deprecations already logged, Eg:- the flags by cobra.
+// A deprecated item used several times is only recorded once.
+func Record(w Warning) {
+	w.Type = warningType
+
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Contains(warnings, w) {
+		warnings = append(warnings, w)
+	}
+}
+
+// Report logs the message of the warning, and records it.
+func Report(w Warning) {
+	logger.Warningf("%s\n", w.Message)
+	Record(w)
+}
+
+// Warnings returns the warnings recorded during the run, in the order they were reported.
+func Warnings() []Warning {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return slices.Clone(warnings)
+}
@@ -0,0 +1,27 @@
+package deprecation
+
+import (
+	"testing"
+)
+
+func TestRecord(t *testing.T) {
+	warnings = nil
+	t.Cleanup(func() { warnings = nil })
+
+	alias := Warning{Kind: KindTemplate, Name: "legacy-rag", Replacement: "rag", Message: "Application template 'legacy-rag' is deprecated"}
+	flag := Warning{Kind: KindFlag, Name: "--skip-image-download", Message: "Flag --skip-image-download has been deprecated"}
+	Record(alias)
+	Record(flag)
+	// the alias is resolved again later in the run
+	Record(alias)
+
+	got := Warnings()
+	if len(got) != 2 || got[0].Name != "legacy-rag" || got[1].Name != "--skip-image-download" {
+		t.Fatalf("Warnings() = %+v, want the alias then the flag, once each", got)
+	}
+	for _, w := range got {
+		if w.Type != "deprecation" {
+			t.Errorf("Type = %q, want deprecation", w.Type)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package diff
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// ChangeType is the kind of change of a resource between its live and desired state.
+type ChangeType string
+
+const (
+	Added   ChangeType = "added"
+	Removed ChangeType = "removed"
+	Changed ChangeType = "changed"
+)
+
+// Resource is a single object rendered from a template, or fetched from the runtime.
+type Resource struct {
+	Kind   string
+	Name   string
+	Object map[string]any
+}
+
+// Key identifies the resource, Eg:- "Pod/rag--opensearch".
+func (r Resource) Key() string {
+	return r.Kind + "/" + r.Name
+}
+
+// Change describes how a resource differs between its live and desired state.
+type Change struct {
+	Kind string
+	Name string
+	Type ChangeType
+	// Fields lists the paths of the fields which differ, only set for changed resources.
+	Fields []string
+}
+
+// Options tunes the comparison of the resources.
+type Options struct {
+	// Ignore lists the dot separated paths of the fields to leave out of the comparison, Eg:- "metadata.managedFields".
+	Ignore []string
+	// LiveOnlyFields reports the fields only present in the live state as changed.
+	// It should be left off when the live state gets defaulted by the runtime, to keep the defaults out of the diff.
+	LiveOnlyFields bool
+}
+
+// Compare computes the changes needed to go from the live resources to the desired ones.
+// The returned changes are sorted by kind and name.
+func Compare(desired, live []Resource, opts Options) []Change {
+	liveByKey := map[string]Resource{}
+	for _, r := range live {
+		liveByKey[r.Key()] = r
+	}
+
+	changes := []Change{}
+	seen := map[string]bool{}
+	for _, want := range desired {
+		seen[want.Key()] = true
+
+		got, ok := liveByKey[want.Key()]
+		if !ok {
+			changes = append(changes, Change{Kind: want.Kind, Name: want.Name, Type: Added})
+
+			continue
+		}
+
+		c := &comparer{opts: opts}
+		c.compare("", want.Object, got.Object)
+		if len(c.fields) > 0 {
+			changes = append(changes, Change{Kind: want.Kind, Name: want.Name, Type: Changed, Fields: c.fields})
+		}
+	}
+
+	for _, got := range live {
+		if !seen[got.Key()] {
+			changes = append(changes, Change{Kind: got.Kind, Name: got.Name, Type: Removed})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return changes[i].Kind < changes[j].Kind
+		}
+
+		return changes[i].Name < changes[j].Name
+	})
+
+	return changes
+}
+
+type comparer struct {
+	opts   Options
+	fields []string
+}
+
+func (c *comparer) ignored(path string) bool {
+	for _, p := range c.opts.Ignore {
+		if path == p || strings.HasPrefix(path, p+".") || strings.HasPrefix(path, p+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *comparer) compare(path string, want, got any) {
+	if c.ignored(path) {
+		return
+	}
+
+	switch w := want.(type) {
+	case map[string]any:
+		g, ok := got.(map[string]any)
+		if !ok {
+			c.fields = append(c.fields, path)
+
+			return
+		}
+		c.compareMaps(path, w, g)
+	case []any:
+		g, ok := got.([]any)
+		if !ok {
+			c.fields = append(c.fields, path)
+
+			return
+		}
+		c.compareLists(path, w, g)
+	default:
+		if fmt.Sprint(want) != fmt.Sprint(got) {
+			c.fields = append(c.fields, path)
+		}
+	}
+}
+
+func (c *comparer) compareMaps(path string, want, got map[string]any) {
+	keys := make([]string, 0, len(want)+len(got))
+	for k := range want {
+		keys = append(keys, k)
+	}
+	for k := range got {
+		if _, ok := want[k]; !ok && c.opts.LiveOnlyFields {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		p := joinPath(path, k)
+		w, inWant := want[k]
+		g, inGot := got[k]
+		switch {
+		case c.ignored(p):
+		case !inWant || !inGot:
+			c.fields = append(c.fields, p)
+		default:
+			c.compare(p, w, g)
+		}
+	}
+}
+
+// compareLists matches the items of lists of named objects, such as containers or env, by their name.
+// Any other list is compared as a whole.
+func (c *comparer) compareLists(path string, want, got []any) {
+	wantByName, ok := byName(want)
+	gotByName, gotOK := byName(got)
+	if !ok || !gotOK {
+		if !reflect.DeepEqual(normalize(want), normalize(got)) {
+			c.fields = append(c.fields, path)
+		}
+
+		return
+	}
+
+	names := make([]string, 0, len(wantByName)+len(gotByName))
+	for name := range wantByName {
+		names = append(names, name)
+	}
+	for name := range gotByName {
+		if _, ok := wantByName[name]; !ok && c.opts.LiveOnlyFields {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		p := fmt.Sprintf("%s[%s]", path, name)
+		w, inWant := wantByName[name]
+		g, inGot := gotByName[name]
+		if !inWant || !inGot {
+			c.fields = append(c.fields, p)
+
+			continue
+		}
+		c.compare(p, w, g)
+	}
+}
+
+// byName indexes the items of a list by their name, it reports false when some item is not a named object.
+func byName(items []any) (map[string]any, bool) {
+	m := make(map[string]any, len(items))
+	for _, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		m[name] = obj
+	}
+
+	return m, len(items) > 0
+}
+
+// normalize renders scalars as strings, so that the same value decoded as different types compares equal.
+func normalize(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[k] = normalize(val)
+		}
+
+		return m
+	case []any:
+		l := make([]any, len(t))
+		for i, val := range t {
+			l[i] = normalize(val)
+		}
+
+		return l
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// ParseManifest parses the resources of a multi document YAML manifest, empty documents are skipped.
+func ParseManifest(data []byte) ([]Resource, error) {
+	resources := []Resource{}
+
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	for {
+		var obj map[string]any
+		err := dec.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse manifest: %w", err)
+		}
+		if len(obj) == 0 {
+			continue
+		}
+
+		r, err := NewResource(obj)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, r)
+	}
+
+	return resources, nil
+}
+
+// NewResource wraps the given object, reading its kind and name.
+func NewResource(obj map[string]any) (Resource, error) {
+	kind, _ := obj["kind"].(string)
+	metadata, _ := obj["metadata"].(map[string]any)
+	name, _ := metadata["name"].(string)
+	if kind == "" || name == "" {
+		return Resource{}, errors.New("resource is missing its kind or metadata.name")
+	}
+
+	return Resource{Kind: kind, Name: name, Object: obj}, nil
+}
@@ -0,0 +1,129 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+const liveManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: app--api
+  labels:
+    ai-services.io/version: "1.0"
+spec:
+  containers:
+    - name: api
+      image: icr.io/ai-services/api:1.0
+      securityContext:
+        privileged: false
+      env:
+        - name: PATH
+          value: /usr/bin
+        - name: LOG_LEVEL
+          value: info
+      ports:
+        - containerPort: 8000
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app--legacy
+`
+
+func mustParse(t *testing.T, manifest string) []Resource {
+	t.Helper()
+
+	resources, err := ParseManifest([]byte(manifest))
+	if err != nil {
+		t.Fatalf("ParseManifest() error = %v", err)
+	}
+
+	return resources
+}
+
+func TestCompare(t *testing.T) {
+	desired := mustParse(t, `apiVersion: v1
+kind: Pod
+metadata:
+  name: app--api
+  labels:
+    ai-services.io/version: "1.1"
+spec:
+  containers:
+    - name: api
+      image: icr.io/ai-services/api:1.1
+      env:
+        - name: LOG_LEVEL
+          value: info
+        - name: WORKERS
+          value: "4"
+      ports:
+        - containerPort: 8000
+---
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: app--ui
+`)
+	live := mustParse(t, liveManifest)
+
+	tests := []struct {
+		name string
+		opts Options
+		want []Change
+	}{
+		{
+			name: "desired fields only",
+			want: []Change{
+				{Kind: "Pod", Name: "app--api", Type: Changed, Fields: []string{
+					"metadata.labels.ai-services.io/version",
+					"spec.containers[api].env[WORKERS]",
+					"spec.containers[api].image",
+				}},
+				{Kind: "Pod", Name: "app--legacy", Type: Removed},
+				{Kind: "Pod", Name: "app--ui", Type: Added},
+			},
+		},
+		{
+			name: "live only fields and ignored fields",
+			opts: Options{LiveOnlyFields: true, Ignore: []string{"metadata.labels", "spec.containers[api].image"}},
+			want: []Change{
+				{Kind: "Pod", Name: "app--api", Type: Changed, Fields: []string{
+					"spec.containers[api].env[PATH]",
+					"spec.containers[api].env[WORKERS]",
+					"spec.containers[api].securityContext",
+				}},
+				{Kind: "Pod", Name: "app--legacy", Type: Removed},
+				{Kind: "Pod", Name: "app--ui", Type: Added},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Compare(desired, live, tt.opts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Compare() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	live := mustParse(t, liveManifest)
+	// ports decoded from JSON are float64, while the YAML ones are int
+	live[0].Object["spec"].(map[string]any)["containers"].([]any)[0].(map[string]any)["ports"] = []any{
+		map[string]any{"containerPort": float64(8000)},
+	}
+
+	if got := Compare(mustParse(t, liveManifest), live, Options{}); len(got) != 0 {
+		t.Errorf("Compare() = %+v, want no changes", got)
+	}
+}
+
+func TestParseManifestInvalid(t *testing.T) {
+	if _, err := ParseManifest([]byte("kind: Pod\nmetadata: {}\n")); err == nil {
+		t.Error("ParseManifest() expected an error for a resource without a name")
+	}
+}
@@ -0,0 +1,96 @@
+package facts
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/affinity"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+)
+
+const osReleasePath = "/etc/os-release"
+
+// Facts are the detected facts of the host, available to the podman application templates as .Facts, so that they adapt
+// to it, Eg:- {{ if ge .Facts.SpyreCardCount 4 }}4{{ else }}1{{ end }}. The OpenShift Helm charts do not get them.
+// A fact which cannot be detected is left to its zero value. They are documented for the template authors in assets/README.md.
+type Facts struct {
+	// SpyreCardCount is the number of IBM Spyre cards attached to the host, free or not.
+	SpyreCardCount int
+	// NUMANodeCount is the number of NUMA nodes holding CPUs or memory of the LPAR.
+	NUMANodeCount int
+	// LparAffinity is the share of the CPUs and memory of the LPAR placed on its home NUMA node, in percent.
+	LparAffinity int
+	// RHELVersion is the version of RHEL installed on the host, Eg:- 9.6, empty on another operating system.
+	RHELVersion string
+	// Arch is the architecture of the host, Eg:- ppc64le.
+	Arch string
+	// Power11 is set on an IBM Power11 host.
+	Power11 bool
+}
+
+var (
+	// mu guards the cached facts, detected once per process.
+	mu     sync.Mutex
+	cached *Facts
+)
+
+// Get returns the facts of the host, detected on the first call and cached for the rest of the process.
+func Get() Facts {
+	mu.Lock()
+	defer mu.Unlock()
+	if cached == nil {
+		f := Detect(hostfs.OS, hostfs.Arch())
+		logger.Infof("Detected host facts: %+v\n", f, logger.VerbosityLevelDebug)
+		cached = &f
+	}
+
+	return *cached
+}
+
+// Reset forgets the cached facts, so that they are detected again on the next call to Get,
+// Eg:- once the host filesystem is substituted by a test.
+func Reset() {
+	mu.Lock()
+	defer mu.Unlock()
+	cached = nil
+}
+
+// Detect detects the facts from the files of the given host filesystem, without caching the result.
+func Detect(fsys hostfs.FS, arch string) Facts {
+	info := platform.Detect(fsys, arch)
+	f := Facts{Arch: info.Arch, Power11: info.Power11}
+
+	if cards, err := spyre.ListDevices(fsys); err == nil {
+		f.SpyreCardCount = len(cards)
+	}
+
+	if percent, detail, err := affinity.ComputeLparAffinityFS(fsys); err == nil {
+		f.NUMANodeCount = len(detail.Nodes)
+		f.LparAffinity = percent
+	}
+
+	if data, err := fsys.ReadFile(osReleasePath); err == nil {
+		f.RHELVersion = rhelVersion(string(data))
+	}
+
+	return f
+}
+
+// rhelVersion returns the VERSION_ID of the given os-release, when it is the one of RHEL.
+func rhelVersion(osRelease string) string {
+	fields := map[string]string{}
+	for line := range strings.SplitSeq(osRelease, "\n") {
+		key, value, found := strings.Cut(strings.TrimSpace(line), "=")
+		if found {
+			fields[key] = strings.Trim(value, `"'`)
+		}
+	}
+	if fields["ID"] != "rhel" {
+		return ""
+	}
+
+	return fields["VERSION_ID"]
+}
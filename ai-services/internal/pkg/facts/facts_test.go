@@ -0,0 +1,58 @@
+package facts
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func TestDetect(t *testing.T) {
+	fsys := hostfs.Fake{
+		"/proc/cpuinfo":   "processor\t: 0\ncpu\t\t: Power11 (architected), altivec supported\n",
+		"/etc/os-release": "NAME=\"Red Hat Enterprise Linux\"\nID=\"rhel\"\nVERSION_ID=\"9.6\"\n",
+		"/sys/bus/pci/devices/0182:70:00.0/vendor": "0x1014\n",
+		"/sys/bus/pci/devices/0182:70:00.0/device": "0x06a7\n",
+		"/sys/bus/pci/devices/0183:70:00.0/vendor": "0x1014\n",
+		"/sys/bus/pci/devices/0183:70:00.0/device": "0x06a7\n",
+		"/sys/devices/system/node/node0/cpulist":   "0-5\n",
+		"/sys/devices/system/node/node0/meminfo":   "Node 0 MemTotal:       3000 kB\n",
+		"/sys/devices/system/node/node1/cpulist":   "6-7\n",
+		"/sys/devices/system/node/node1/meminfo":   "Node 1 MemTotal:       1000 kB\n",
+		"/sys/devices/system/node/node2/cpulist":   "\n",
+		"/sys/devices/system/node/node2/meminfo":   "Node 2 MemTotal:       0 kB\n",
+		"/sys/bus/pci/devices/0001:00:01.0/vendor": "0x15b3\n",
+		"/sys/bus/pci/devices/0001:00:01.0/device": "0x1019\n",
+	}
+
+	want := Facts{SpyreCardCount: 2, NUMANodeCount: 2, LparAffinity: 75, RHELVersion: "9.6", Arch: "ppc64le", Power11: true}
+	if got := Detect(fsys, "ppc64le"); got != want {
+		t.Errorf("Detect() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDetectUndetectedFacts(t *testing.T) {
+	want := Facts{Arch: "amd64"}
+	if got := Detect(hostfs.Fake{}, "amd64"); got != want {
+		t.Errorf("Detect() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRHELVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		osRelease string
+		want      string
+	}{
+		{name: "quoted", osRelease: "ID=\"rhel\"\nVERSION_ID=\"9.6\"\n", want: "9.6"},
+		{name: "unquoted", osRelease: "ID=rhel\nVERSION_ID=10.0\n", want: "10.0"},
+		{name: "another distribution", osRelease: "ID=\"fedora\"\nID_LIKE=\"rhel\"\nVERSION_ID=42\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := rhelVersion(tt.osRelease); got != tt.want {
+				t.Errorf("rhelVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v4/pkg/kube"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// SetApplyTimeout bounds each request applying the resources of the releases by the given timeout, 0 does not bound them,
+// and retries the apply failing on a timeout as set by vars.RetryCount. The readiness wait is left to the Timeout
+// of the install and upgrade options.
+func (h *Helm) SetApplyTimeout(timeout time.Duration) {
+	kc, ok := h.actionConfig.KubeClient.(*kube.Client)
+	if !ok {
+		return
+	}
+
+	apply := kc
+	if timeout > 0 {
+		apply = kube.New(&timeoutGetter{RESTClientGetter: h.getter, timeout: timeout})
+		apply.Namespace = kc.Namespace
+		apply.SetLogger(kc.Logger().Handler())
+	}
+	h.actionConfig.KubeClient = &applyClient{Client: kc, apply: apply}
+}
+
+// applyClient is the kube client of helm creating and updating the resources through a client bounded by the apply timeout,
+// with retries on the timeouts. Its other requests, Eg:- the watches of the readiness wait, go through the embedded client.
+type applyClient struct {
+	*kube.Client
+	apply *kube.Client
+}
+
+func (c *applyClient) Create(resources kube.ResourceList, options ...kube.ClientCreateOption) (*kube.Result, error) {
+	var result *kube.Result
+	err := retryOnTimeout(func() error {
+		var err error
+		result, err = c.apply.Create(resources, options...)
+
+		return err
+	})
+
+	return result, err
+}
+
+func (c *applyClient) Update(originals, targets kube.ResourceList, options ...kube.ClientUpdateOption) (*kube.Result, error) {
+	var result *kube.Result
+	err := retryOnTimeout(func() error {
+		var err error
+		result, err = c.apply.Update(originals, targets, options...)
+
+		return err
+	})
+
+	return result, err
+}
+
+// retryOnTimeout retries fn failing on a timeout or throttled by the API server, after the delay it asked for if any.
+// The server side apply of the resources is safe to repeat.
+func retryOnTimeout(fn func() error) error {
+	return utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
+		err := fn()
+		switch {
+		case err == nil:
+			return nil
+		case isTimeout(err) || apierrors.IsTooManyRequests(err):
+			return openshift.WithSuggestedDelay(err)
+		default:
+			return utils.Permanent(err)
+		}
+	})
+}
+
+// isTimeout reports whether the error is a timeout of the request or of the API server,
+// Eg:- an admission webhook which did not answer in time.
+func isTimeout(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return apierrors.IsTimeout(err) || apierrors.IsServerTimeout(err) || errors.Is(err, context.DeadlineExceeded) ||
+		strings.Contains(err.Error(), context.DeadlineExceeded.Error())
+}
+
+// timeoutGetter sets the timeout of the requests of the clients it configures.
+type timeoutGetter struct {
+	genericclioptions.RESTClientGetter
+	timeout time.Duration
+}
+
+func (g *timeoutGetter) ToRESTConfig() (*rest.Config, error) {
+	config, err := g.RESTClientGetter.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	config = rest.CopyConfig(config)
+	config.Timeout = g.timeout
+
+	return config, nil
+}
@@ -0,0 +1,93 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "Client.Timeout exceeded while awaiting headers" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestIsTimeout(t *testing.T) {
+	gr := schema.GroupResource{Resource: "deployments"}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "request timeout", err: &url.Error{Op: "Patch", URL: "https://api", Err: timeoutError{}}, want: true},
+		{name: "server timeout", err: apierrors.NewServerTimeout(gr, "patch", 1), want: true},
+		{name: "gateway timeout", err: apierrors.NewTimeoutError("timed out", 1), want: true},
+		{
+			name: "webhook deadline",
+			err:  apierrors.NewInternalError(errors.New(`failed calling webhook "validate.example.com": context deadline exceeded`)),
+			want: true,
+		},
+		{name: "wrapped deadline", err: fmt.Errorf("failed to create resource: %w", context.DeadlineExceeded), want: true},
+		{name: "rejection", err: apierrors.NewForbidden(gr, "vllm", errors.New("denied by the webhook"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTimeout(tt.err); got != tt.want {
+				t.Errorf("isTimeout(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryOnTimeout(t *testing.T) {
+	count, interval := vars.RetryCount, vars.RetryInterval
+	vars.RetryCount, vars.RetryInterval = 2, 0
+	t.Cleanup(func() { vars.RetryCount, vars.RetryInterval = count, interval })
+
+	calls := 0
+	err := retryOnTimeout(func() error {
+		calls++
+		if calls == 1 {
+			return context.DeadlineExceeded
+		}
+
+		return nil
+	})
+	if err != nil || calls != 2 {
+		t.Errorf("retryOnTimeout() of a timeout = %v after %d calls, want success after 2 calls", err, calls)
+	}
+
+	calls = 0
+	rejected := apierrors.NewBadRequest("invalid spec")
+	err = retryOnTimeout(func() error {
+		calls++
+
+		return rejected
+	})
+	if !errors.Is(err, rejected) || calls != 1 {
+		t.Errorf("retryOnTimeout() of a rejection = %v after %d calls, want the rejection after 1 call", err, calls)
+	}
+
+	calls = 0
+	throttled := apierrors.NewTooManyRequests("too many requests", 0)
+	err = retryOnTimeout(func() error {
+		calls++
+		if calls == 1 {
+			return throttled
+		}
+
+		return nil
+	})
+	if err != nil || calls != 2 {
+		t.Errorf("retryOnTimeout() of a throttled request = %v after %d calls, want success after 2 calls", err, calls)
+	}
+}
@@ -5,18 +5,26 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"slices"
 	"time"
 
 	"helm.sh/helm/v4/pkg/action"
 	"helm.sh/helm/v4/pkg/chart"
 	"helm.sh/helm/v4/pkg/cli"
 	"helm.sh/helm/v4/pkg/kube"
+	"helm.sh/helm/v4/pkg/postrenderer"
+	"helm.sh/helm/v4/pkg/release"
+	rcommon "helm.sh/helm/v4/pkg/release/common"
 	"helm.sh/helm/v4/pkg/storage/driver"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
 )
 
 type Helm struct {
 	namespace    string
 	actionConfig *action.Configuration
+	getter       genericclioptions.RESTClientGetter
 }
 
 func NewHelm(namespace string) (*Helm, error) {
@@ -30,8 +38,9 @@ func NewHelm(namespace string) (*Helm, error) {
 	}))
 	actionConfig.SetLogger(baseLogger.Handler())
 
+	getter := settings.RESTClientGetter()
 	if err := actionConfig.Init(
-		settings.RESTClientGetter(),
+		getter,
 		namespace,
 		"",
 	); err != nil {
@@ -41,15 +50,20 @@ func NewHelm(namespace string) (*Helm, error) {
 	return &Helm{
 		namespace:    namespace,
 		actionConfig: actionConfig,
+		getter:       getter,
 	}, nil
 }
 
 type InstallOpts struct {
 	Values  map[string]any
 	Timeout time.Duration
+	// PostRenderer modifies the rendered manifests before they are installed, none when nil.
+	PostRenderer postrenderer.PostRenderer
 }
 
 func (h *Helm) Install(release string, chart chart.Charter, opts *InstallOpts) error {
+	defer trace.Start("helm install " + release)()
+
 	// Configure the Installer client
 	installClient := action.NewInstall(h.actionConfig)
 	installClient.ReleaseName = release
@@ -57,6 +71,7 @@ func (h *Helm) Install(release string, chart chart.Charter, opts *InstallOpts) e
 	installClient.CreateNamespace = true
 	installClient.WaitStrategy = kube.StatusWatcherStrategy
 	installClient.Timeout = opts.Timeout
+	installClient.PostRenderer = opts.PostRenderer
 
 	// Perform helm install
 	_, err := installClient.Run(chart, opts.Values)
@@ -70,15 +85,20 @@ func (h *Helm) Install(release string, chart chart.Charter, opts *InstallOpts) e
 type UpgradeOpts struct {
 	Values  map[string]any
 	Timeout time.Duration
+	// PostRenderer modifies the rendered manifests before they are applied, none when nil.
+	PostRenderer postrenderer.PostRenderer
 }
 
 func (h *Helm) Upgrade(release string, chart chart.Charter, opts *UpgradeOpts) error {
+	defer trace.Start("helm upgrade " + release)()
+
 	// Configure the Upgrade client
 	upgradeClient := action.NewUpgrade(h.actionConfig)
 	upgradeClient.Namespace = h.namespace
 	upgradeClient.ServerSideApply = "true"
 	upgradeClient.WaitStrategy = kube.StatusWatcherStrategy
 	upgradeClient.Timeout = opts.Timeout
+	upgradeClient.PostRenderer = opts.PostRenderer
 	upgradeClient.ForceConflicts = true
 	upgradeClient.RollbackOnFailure = true
 
@@ -91,6 +111,63 @@ func (h *Helm) Upgrade(release string, chart chart.Charter, opts *UpgradeOpts) e
 	return nil
 }
 
+// Render returns the manifest an upgrade of the release would apply, without changing the release.
+// The cluster is queried for the lookups performed by the chart, as done by a server side dry-run.
+func (h *Helm) Render(release string, chart chart.Charter, values map[string]any) (string, error) {
+	return h.RenderUpgrade(release, chart, &UpgradeOpts{Values: values})
+}
+
+// RenderUpgrade returns the manifest an upgrade of the release would apply with the given options, without changing the release.
+func (h *Helm) RenderUpgrade(release string, chart chart.Charter, opts *UpgradeOpts) (string, error) {
+	upgradeClient := action.NewUpgrade(h.actionConfig)
+	upgradeClient.Namespace = h.namespace
+	upgradeClient.DryRunStrategy = action.DryRunServer
+	upgradeClient.PostRenderer = opts.PostRenderer
+
+	rel, err := upgradeClient.Run(release, chart, opts.Values)
+	if err != nil {
+		return "", fmt.Errorf("failed to render the release: %w", err)
+	}
+
+	return releaseManifest(rel)
+}
+
+// RenderInstall returns the manifest an install of the release would apply with the given options, without installing it.
+// The cluster is queried for the lookups performed by the chart, as done by a server side dry-run.
+func (h *Helm) RenderInstall(release string, chart chart.Charter, opts *InstallOpts) (string, error) {
+	installClient := action.NewInstall(h.actionConfig)
+	installClient.ReleaseName = release
+	installClient.Namespace = h.namespace
+	installClient.DryRunStrategy = action.DryRunServer
+	installClient.PostRenderer = opts.PostRenderer
+
+	rel, err := installClient.Run(chart, opts.Values)
+	if err != nil {
+		return "", fmt.Errorf("failed to render the release: %w", err)
+	}
+
+	return releaseManifest(rel)
+}
+
+// Manifest returns the manifest of the latest revision of the release.
+func (h *Helm) Manifest(release string) (string, error) {
+	rel, err := action.NewGet(h.actionConfig).Run(release)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the release: %w", err)
+	}
+
+	return releaseManifest(rel)
+}
+
+func releaseManifest(rel release.Releaser) (string, error) {
+	accessor, err := release.NewAccessor(rel)
+	if err != nil {
+		return "", err
+	}
+
+	return accessor.Manifest(), nil
+}
+
 func (h *Helm) IsReleaseExist(release string) (bool, error) {
 	client := action.NewGet(h.actionConfig)
 
@@ -110,6 +187,70 @@ func (h *Helm) IsReleaseExist(release string) (bool, error) {
 	return true, nil
 }
 
+// Statuses of the revisions which were successfully deployed, the latest one and the ones it replaced.
+const (
+	StatusDeployed   = string(rcommon.StatusDeployed)
+	StatusSuperseded = string(rcommon.StatusSuperseded)
+)
+
+// Revision is a single revision of a release, recorded by every install, upgrade and rollback.
+type Revision struct {
+	Number int
+	// AppVersion is the app version of the chart, which is the version of the application template.
+	AppVersion string
+	Status     string
+	DeployedAt time.Time
+}
+
+// History returns the revisions of the release, oldest first.
+func (h *Helm) History(name string) ([]Revision, error) {
+	rels, err := action.NewHistory(h.actionConfig).Run(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the release history: %w", err)
+	}
+
+	revisions := make([]Revision, 0, len(rels))
+	for _, rel := range rels {
+		accessor, err := release.NewAccessor(rel)
+		if err != nil {
+			return nil, err
+		}
+
+		revision := Revision{Number: accessor.Version(), Status: accessor.Status(), DeployedAt: accessor.DeployedAt()}
+		if ch, err := chart.NewAccessor(accessor.Chart()); err == nil {
+			// the metadata is keyed by the names of the fields of the chart metadata, rather than the ones of Chart.yaml
+			revision.AppVersion, _ = ch.MetadataAsMap()["AppVersion"].(string)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	slices.SortFunc(revisions, func(a, b Revision) int { return a.Number - b.Number })
+
+	return revisions, nil
+}
+
+type RollbackOpts struct {
+	Timeout time.Duration
+}
+
+// Rollback redeploys the chart and the values of the given revision of the release, as a new revision.
+func (h *Helm) Rollback(name string, revision int, opts *RollbackOpts) error {
+	rollbackClient := action.NewRollback(h.actionConfig)
+	rollbackClient.Version = revision
+	rollbackClient.ServerSideApply = "true"
+	rollbackClient.WaitStrategy = kube.StatusWatcherStrategy
+	rollbackClient.ForceConflicts = true
+	if opts != nil {
+		rollbackClient.Timeout = opts.Timeout
+	}
+
+	if err := rollbackClient.Run(name); err != nil {
+		return fmt.Errorf("Rollback failed: %w", err)
+	}
+
+	return nil
+}
+
 type UninstallOpts struct {
 	Timeout time.Duration
 }
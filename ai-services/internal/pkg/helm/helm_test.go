@@ -0,0 +1,71 @@
+package helm
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"helm.sh/helm/v4/pkg/action"
+	chart "helm.sh/helm/v4/pkg/chart/v2"
+	kubefake "helm.sh/helm/v4/pkg/kube/fake"
+	rcommon "helm.sh/helm/v4/pkg/release/common"
+	rspb "helm.sh/helm/v4/pkg/release/v1"
+	"helm.sh/helm/v4/pkg/storage"
+	"helm.sh/helm/v4/pkg/storage/driver"
+)
+
+// newMemoryHelm returns a helm client whose releases are stored in memory, holding the given ones.
+func newMemoryHelm(t *testing.T, releases ...*rspb.Release) *Helm {
+	t.Helper()
+
+	cfg := action.NewConfiguration()
+	cfg.Releases = storage.Init(driver.NewMemory())
+	cfg.KubeClient = &kubefake.PrintingKubeClient{Out: io.Discard}
+	for _, rel := range releases {
+		if err := cfg.Releases.Create(rel); err != nil {
+			t.Fatalf("failed to store release %s revision %d: %v", rel.Name, rel.Version, err)
+		}
+	}
+
+	return &Helm{namespace: "rag", actionConfig: cfg}
+}
+
+func mockRelease(version int, appVersion string, status rcommon.Status) *rspb.Release {
+	return rspb.Mock(&rspb.MockReleaseOptions{
+		Name:      "rag",
+		Namespace: "rag",
+		Version:   version,
+		Status:    status,
+		Chart:     &chart.Chart{Metadata: &chart.Metadata{Name: "rag", Version: "0.1.0", AppVersion: appVersion}},
+	})
+}
+
+func TestHistory(t *testing.T) {
+	h := newMemoryHelm(t,
+		mockRelease(3, "1.1.0", rcommon.StatusDeployed),
+		mockRelease(1, "1.0.0", rcommon.StatusSuperseded),
+		mockRelease(2, "1.1.0", rcommon.StatusFailed),
+	)
+
+	revisions, err := h.History("rag")
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	for i := range revisions {
+		revisions[i].DeployedAt = revisions[i].DeployedAt.UTC()
+	}
+
+	deployedAt := revisions[0].DeployedAt
+	want := []Revision{
+		{Number: 1, AppVersion: "1.0.0", Status: StatusSuperseded, DeployedAt: deployedAt},
+		{Number: 2, AppVersion: "1.1.0", Status: "failed", DeployedAt: deployedAt},
+		{Number: 3, AppVersion: "1.1.0", Status: StatusDeployed, DeployedAt: deployedAt},
+	}
+	if !reflect.DeepEqual(revisions, want) {
+		t.Errorf("History() = %+v, want %+v", revisions, want)
+	}
+
+	if _, err := h.History("chatbot"); err == nil {
+		t.Errorf("History() of a missing release expected an error")
+	}
+}
@@ -0,0 +1,41 @@
+package hostfs
+
+import (
+	"io/fs"
+	"strings"
+	"testing/fstest"
+)
+
+// Fake is an in-memory FS keyed by absolute host paths, meant for tests.
+// Eg:- Fake{"/etc/os-release": `ID="rhel"`}.
+type Fake map[string]string
+
+func (f Fake) mapFS() fstest.MapFS {
+	m := fstest.MapFS{}
+	for name, data := range f {
+		m[strings.TrimPrefix(name, "/")] = &fstest.MapFile{Data: []byte(data)}
+	}
+
+	return m
+}
+
+func (f Fake) ReadFile(name string) ([]byte, error) {
+	return fs.ReadFile(f.mapFS(), strings.TrimPrefix(name, "/"))
+}
+
+func (f Fake) Glob(pattern string) ([]string, error) {
+	matches, err := fs.Glob(f.mapFS(), strings.TrimPrefix(pattern, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	for i, m := range matches {
+		matches[i] = "/" + m
+	}
+
+	return matches, nil
+}
+
+func (f Fake) Stat(name string) (fs.FileInfo, error) {
+	return fs.Stat(f.mapFS(), strings.TrimPrefix(name, "/"))
+}
@@ -0,0 +1,82 @@
+package hostfs
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// FS abstracts the host filesystem reads performed by the host validators,
+// so that files under /proc, /sys and /etc can be substituted in tests.
+type FS interface {
+	// ReadFile reads the named file and returns its contents.
+	ReadFile(name string) ([]byte, error)
+	// Glob returns the names of all files matching pattern.
+	Glob(pattern string) ([]string, error)
+	// Stat returns the FileInfo describing the named file.
+	Stat(name string) (fs.FileInfo, error)
+}
+
+// OS is the FS implementation backed by the real host filesystem, unless substituted with Substitute.
+// The validators hold it from their creation, so it delegates each read to the current filesystem.
+var OS FS = hostFS{}
+
+var (
+	// substituteMu guards the filesystem substituted for the real one, none when nil.
+	substituteMu sync.RWMutex
+	substitute   FS
+)
+
+// Substitute substitutes fsys for the real host filesystem behind OS, Eg:- a Fake one in the end-to-end tests
+// of the commands, and returns the function restoring the real one.
+func Substitute(fsys FS) (restore func()) {
+	substituteMu.Lock()
+	defer substituteMu.Unlock()
+	substitute = fsys
+
+	return func() {
+		substituteMu.Lock()
+		defer substituteMu.Unlock()
+		substitute = nil
+	}
+}
+
+// hostFS delegates to the substituted filesystem, or else to the real one.
+type hostFS struct{}
+
+func (hostFS) current() FS {
+	substituteMu.RLock()
+	defer substituteMu.RUnlock()
+	if substitute != nil {
+		return substitute
+	}
+
+	return osFS{}
+}
+
+func (h hostFS) ReadFile(name string) ([]byte, error) {
+	return h.current().ReadFile(name)
+}
+
+func (h hostFS) Glob(pattern string) ([]string, error) {
+	return h.current().Glob(pattern)
+}
+
+func (h hostFS) Stat(name string) (fs.FileInfo, error) {
+	return h.current().Stat(name)
+}
+
+type osFS struct{}
+
+func (osFS) ReadFile(name string) ([]byte, error) {
+	return os.ReadFile(name)
+}
+
+func (osFS) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(pattern)
+}
+
+func (osFS) Stat(name string) (fs.FileInfo, error) {
+	return os.Stat(name)
+}
@@ -0,0 +1,309 @@
+package hostfs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const (
+	// sshConnectTimeout is the number of seconds ssh waits for the remote host to accept the connection.
+	sshConnectTimeout = "10"
+	// sshControlPersist is the number of seconds the shared connection outlives its last use, so that it is closed
+	// even when the CLI exits without closing it, Eg:- when killed.
+	sshControlPersist = "60"
+	// notExistStatus is the exit status of the remote scripts for a missing file, told apart from the one of ssh.
+	notExistStatus = 3
+	// statFields is the number of fields printed by the remote stat, Eg:- "4096 41ed 1760000000".
+	statFields = 3
+	// sIFMT and sIFDIR are the file type bits of the raw mode printed by stat, and the one of a directory.
+	sIFMT  = 0o170000
+	sIFDIR = 0o040000
+)
+
+// Remote is implemented by the filesystems of a host other than the one the CLI runs on, Eg:- an LPAR validated
+// from a management node. Substituted for the real filesystem, it makes the host checks verify the remote host.
+type Remote interface {
+	FS
+	// Target names the remote host, Eg:- root@lpar1.example.com.
+	Target() string
+	// Arch is the architecture of the remote host, Eg:- ppc64le.
+	Arch() string
+}
+
+// CurrentRemote returns the remote filesystem substituted for the real one, nil when the host is the local one.
+func CurrentRemote() Remote {
+	substituteMu.RLock()
+	defer substituteMu.RUnlock()
+	remote, _ := substitute.(Remote)
+
+	return remote
+}
+
+// Arch returns the architecture of the host, the one of the remote host when substituted, else the one the CLI runs on.
+func Arch() string {
+	if remote := CurrentRemote(); remote != nil {
+		return remote.Arch()
+	}
+
+	return runtime.GOARCH
+}
+
+// SSH is the filesystem of a remote host, read with the ssh client of the local host. It relies on the ssh
+// configuration and keys of the user, as no password can be prompted for, and on a POSIX shell on the remote host.
+type SSH struct {
+	target string
+	arch   string
+	// controlDir holds the socket of the connection shared by the reads, so that a validation, reading tens
+	// of files, authenticates once rather than once per file.
+	controlDir string
+	// run runs the shell script on the remote host, returning its standard output.
+	run func(script string) ([]byte, error)
+}
+
+// DialSSH checks that the target, Eg:- root@lpar1.example.com, is reachable over ssh and returns its filesystem.
+func DialSSH(target string) (*SSH, error) {
+	if err := validateTarget(target); err != nil {
+		return nil, err
+	}
+
+	controlDir, err := os.MkdirTemp("", "ai-services-ssh-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the ssh control directory: %w", err)
+	}
+
+	s := &SSH{target: target, controlDir: controlDir}
+	s.run = s.runSSH
+
+	out, err := s.run("uname -m")
+	if err != nil {
+		_ = s.Close()
+
+		return nil, fmt.Errorf("failed to reach %s over ssh: %w", target, err)
+	}
+	s.arch = strings.TrimSpace(string(out))
+
+	return s, nil
+}
+
+// validateTarget rejects the targets which ssh would take for an option, or which are not a single [user@]host word.
+func validateTarget(target string) error {
+	user, host, found := strings.Cut(target, "@")
+	if !found {
+		host, user = user, ""
+	}
+	if host == "" || (found && user == "") || strings.HasPrefix(target, "-") || strings.ContainsFunc(target, isSpaceOrControl) {
+		return fmt.Errorf("invalid target '%s', expected [user@]host", target)
+	}
+
+	return nil
+}
+
+func isSpaceOrControl(r rune) bool {
+	return r <= ' ' || r == 0x7f
+}
+
+func (s *SSH) runSSH(script string) ([]byte, error) {
+	logger.Infof("Running on %s: %s\n", s.target, script, logger.VerbosityLevelDebug)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command("ssh", append(s.sshOptions(), s.target, script)...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
+
+// sshOptions are the options of the ssh runs, sharing a single connection to the remote host.
+func (s *SSH) sshOptions() []string {
+	return []string{
+		"-o", "BatchMode=yes",
+		"-o", "ConnectTimeout=" + sshConnectTimeout,
+		"-o", "ControlMaster=auto",
+		"-o", "ControlPath=" + filepath.Join(s.controlDir, "control"),
+		"-o", "ControlPersist=" + sshControlPersist,
+	}
+}
+
+// Close closes the connection shared by the reads, if any, and removes its socket.
+func (s *SSH) Close() error {
+	if s.controlDir == "" {
+		return nil
+	}
+
+	// the shared connection is only opened once a run succeeded, so that there may be none to stop
+	_ = exec.Command("ssh", append(s.sshOptions(), "-O", "exit", s.target)...).Run()
+	err := os.RemoveAll(s.controlDir)
+	s.controlDir = ""
+
+	return err
+}
+
+// Target names the remote host.
+func (s *SSH) Target() string {
+	return s.target
+}
+
+// Arch is the architecture of the remote host, as reported by uname.
+func (s *SSH) Arch() string {
+	return s.arch
+}
+
+func (s *SSH) ReadFile(name string) ([]byte, error) {
+	out, err := s.runFileScript(name, "cat -- "+shellQuote(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: err}
+	}
+
+	return out, nil
+}
+
+func (s *SSH) Glob(pattern string) ([]string, error) {
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	// an unmatched pattern is left as is by the shell, hence the check that each match exists
+	out, err := s.run(fmt.Sprintf(`for f in %s; do [ -e "$f" ] && printf '%%s\n' "$f"; done; true`, globQuote(pattern)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to glob %s on %s: %w", pattern, s.target, err)
+	}
+
+	var matches []string
+	for line := range strings.Lines(string(out)) {
+		if line = strings.TrimSuffix(line, "\n"); line != "" {
+			matches = append(matches, line)
+		}
+	}
+	slices.Sort(matches)
+
+	return matches, nil
+}
+
+func (s *SSH) Stat(name string) (fs.FileInfo, error) {
+	out, err := s.runFileScript(name, "stat -L -c '%s %f %Y' -- "+shellQuote(name))
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+
+	info, err := parseStat(path.Base(name), string(out))
+	if err != nil {
+		return nil, &fs.PathError{Op: "stat", Path: name, Err: err}
+	}
+
+	return info, nil
+}
+
+// runFileScript runs the script reading the named file, failing with fs.ErrNotExist when the file is missing.
+func (s *SSH) runFileScript(name, script string) ([]byte, error) {
+	out, err := s.run(fmt.Sprintf("[ -e %s ] || exit %d; %s", shellQuote(name), notExistStatus, script))
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == notExistStatus {
+		return nil, fs.ErrNotExist
+	}
+
+	return out, err
+}
+
+// parseStat parses the size, the raw mode in hexadecimal and the modification time printed by the remote stat.
+func parseStat(name, out string) (fs.FileInfo, error) {
+	fields := strings.Fields(out)
+	if len(fields) != statFields {
+		return nil, fmt.Errorf("unexpected stat output %q", out)
+	}
+
+	size, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat size %q: %w", fields[0], err)
+	}
+	rawMode, err := strconv.ParseUint(fields[1], 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat mode %q: %w", fields[1], err)
+	}
+	mtime, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected stat modification time %q: %w", fields[2], err)
+	}
+
+	mode := fs.FileMode(rawMode & uint64(fs.ModePerm))
+	if rawMode&sIFMT == sIFDIR {
+		mode |= fs.ModeDir
+	}
+
+	return fileInfo{name: name, size: size, mode: mode, modTime: time.Unix(mtime, 0)}, nil
+}
+
+// fileInfo describes a file of the remote host.
+type fileInfo struct {
+	name    string
+	size    int64
+	mode    fs.FileMode
+	modTime time.Time
+}
+
+func (fi fileInfo) Name() string       { return fi.name }
+func (fi fileInfo) Size() int64        { return fi.size }
+func (fi fileInfo) Mode() fs.FileMode  { return fi.mode }
+func (fi fileInfo) ModTime() time.Time { return fi.modTime }
+func (fi fileInfo) IsDir() bool        { return fi.mode.IsDir() }
+func (fi fileInfo) Sys() any           { return nil }
+
+// shellQuote quotes the string as a single word of a POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// globQuote quotes the pattern for a POSIX shell, leaving its wildcards and bracket expressions unquoted so that
+// the shell expands them, Eg:- /sys/devices/system/node/node[0-9]* becomes '/sys/devices/system/node/node'[0-9]*.
+func globQuote(pattern string) string {
+	var b strings.Builder
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() > 0 {
+			b.WriteString(shellQuote(literal.String()))
+			literal.Reset()
+		}
+	}
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*', '?':
+			flush()
+			b.WriteByte(c)
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				literal.WriteByte(c)
+
+				continue
+			}
+			flush()
+			b.WriteString(pattern[i : i+end+2])
+			i += end + 1
+		default:
+			literal.WriteByte(c)
+		}
+	}
+	flush()
+
+	return b.String()
+}
@@ -0,0 +1,157 @@
+package hostfs
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"testing"
+)
+
+// newLocalSSH returns an SSH filesystem running its scripts with the local shell, in place of the remote one.
+func newLocalSSH() *SSH {
+	return &SSH{target: "root@lpar1", arch: "ppc64le", run: func(script string) ([]byte, error) {
+		return exec.Command("sh", "-c", script).Output()
+	}}
+}
+
+func TestSSHReadFile(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "os release")
+	if err := os.WriteFile(name, []byte(`ID="rhel"`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := newLocalSSH()
+	data, err := s.ReadFile(name)
+	if err != nil || string(data) != `ID="rhel"` {
+		t.Errorf("ReadFile() = %q, %v, want the content of the file", data, err)
+	}
+
+	if _, err := s.ReadFile(filepath.Join(dir, "missing")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("ReadFile() of a missing file error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestSSHGlob(t *testing.T) {
+	dir := t.TempDir()
+	for _, node := range []string{"node0", "node1", "node10", "nodex", "possible"} {
+		if err := os.Mkdir(filepath.Join(dir, node), 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := newLocalSSH()
+	matches, err := s.Glob(filepath.Join(dir, "node[0-9]*"))
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+	want := []string{filepath.Join(dir, "node0"), filepath.Join(dir, "node1"), filepath.Join(dir, "node10")}
+	if !reflect.DeepEqual(matches, want) {
+		t.Errorf("Glob() = %v, want %v", matches, want)
+	}
+
+	if matches, err := s.Glob(filepath.Join(dir, "cpu*")); err != nil || len(matches) != 0 {
+		t.Errorf("Glob() of an unmatched pattern = %v, %v, want no match", matches, err)
+	}
+}
+
+func TestSSHStat(t *testing.T) {
+	dir := t.TempDir()
+	name := filepath.Join(dir, "vfio_pci")
+	if err := os.WriteFile(name, []byte("loaded"), 0o640); err != nil {
+		t.Fatal(err)
+	}
+
+	s := newLocalSSH()
+	info, err := s.Stat(name)
+	if err != nil {
+		t.Fatalf("Stat() error = %v", err)
+	}
+	if info.Name() != "vfio_pci" || info.Size() != 6 || info.IsDir() || info.Mode().Perm() != 0o640 {
+		t.Errorf("Stat() = %s %d %s, want the regular file vfio_pci of 6 bytes", info.Name(), info.Size(), info.Mode())
+	}
+
+	if info, err := s.Stat(dir); err != nil || !info.IsDir() {
+		t.Errorf("Stat() of a directory = %v, %v, want a directory", info, err)
+	}
+	if _, err := s.Stat(filepath.Join(dir, "missing")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Stat() of a missing file error = %v, want fs.ErrNotExist", err)
+	}
+}
+
+func TestValidateTarget(t *testing.T) {
+	for target, valid := range map[string]bool{
+		"root@lpar1.example.com": true,
+		"lpar1":                  true,
+		"admin@10.0.0.5":         true,
+		"":                       false,
+		"root@":                  false,
+		"@lpar1":                 false,
+		"-oProxyCommand=sh":      false,
+		"root@lpar1 uptime":      false,
+	} {
+		if err := validateTarget(target); (err == nil) != valid {
+			t.Errorf("validateTarget(%q) error = %v, want valid %v", target, err, valid)
+		}
+	}
+}
+
+func TestGlobQuote(t *testing.T) {
+	tests := map[string]string{
+		"/sys/devices/system/node/node[0-9]*": "'/sys/devices/system/node/node'[0-9]*",
+		"/sys/bus/pci/drivers/vfio-pci/*:*":   "'/sys/bus/pci/drivers/vfio-pci/'*':'*",
+		"/it's/a?":                            `'/it'\''s/a'?`,
+	}
+	for pattern, want := range tests {
+		if got := globQuote(pattern); got != want {
+			t.Errorf("globQuote(%q) = %s, want %s", pattern, got, want)
+		}
+	}
+}
+
+func TestCurrentRemote(t *testing.T) {
+	if remote := CurrentRemote(); remote != nil {
+		t.Fatalf("CurrentRemote() = %v, want none for the local host", remote)
+	}
+
+	restore := Substitute(newLocalSSH())
+	defer restore()
+
+	remote := CurrentRemote()
+	if remote == nil || remote.Target() != "root@lpar1" {
+		t.Fatalf("CurrentRemote() = %v, want root@lpar1", remote)
+	}
+	if arch := Arch(); arch != "ppc64le" {
+		t.Errorf("Arch() = %s, want the one of the remote host", arch)
+	}
+}
+
+func TestSSHSharedConnection(t *testing.T) {
+	controlDir := filepath.Join(t.TempDir(), "ssh")
+	if err := os.Mkdir(controlDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	s := newLocalSSH()
+	s.controlDir = controlDir
+
+	opts := s.sshOptions()
+	for _, want := range []string{"ControlMaster=auto", "ControlPath=" + filepath.Join(controlDir, "control")} {
+		if !slices.Contains(opts, want) {
+			t.Errorf("sshOptions() = %v, want %s", opts, want)
+		}
+	}
+
+	if err := s.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if _, err := os.Stat(controlDir); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("control directory still exists after Close(): %v", err)
+	}
+	if err := s.Close(); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+}
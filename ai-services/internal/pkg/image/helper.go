@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"slices"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
@@ -13,14 +14,18 @@ import (
 
 // ListImages returns the list of images required for given application template.
 func ListImages(template, appName string) ([]string, error) {
-	tp := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	tp, err := templates.NewEmbedTemplateProvider(templates.EmbedOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error loading templates: %w", err)
+	}
 
 	// fetch list of app templates
 	apps, err := tp.ListApplications(true)
 	if err != nil {
 		return nil, fmt.Errorf("error listing templates: %w", err)
 	}
-	if found := slices.Contains(apps, template); !found {
+	app, _ := templates.SplitRef(template)
+	if found := slices.Contains(apps, app); !found {
 		return nil, fmt.Errorf("provided template name is wrong. Please provide a valid template name")
 	}
 
@@ -30,6 +35,16 @@ func ListImages(template, appName string) ([]string, error) {
 		return nil, fmt.Errorf("error loading templates for %s: %w", template, err)
 	}
 
+	// the images built from the template are not pulled
+	appMetadata, err := tp.LoadMetadata(template, true)
+	if err != nil {
+		return nil, fmt.Errorf("error loading metadata for %s: %w", template, err)
+	}
+	built := make(map[string]bool, len(appMetadata.Builds))
+	for _, build := range appMetadata.Builds {
+		built[build.Tag] = true
+	}
+
 	images := []string{
 		// include tool image as well which is used for all the housekeeping tasks
 		vars.ToolImage,
@@ -42,7 +57,9 @@ func ListImages(template, appName string) ([]string, error) {
 			return nil, fmt.Errorf("error loading pod template: %w", err)
 		}
 		for _, container := range ps.Spec.Containers {
-			images = append(images, container.Image)
+			if !built[container.Image] {
+				images = append(images, container.Image)
+			}
 		}
 	}
 
@@ -56,6 +73,10 @@ func pullImageFromRegistry(runtime runtime.Runtime, images []string) error {
 		if err := utils.Retry(vars.RetryCount, vars.RetryInterval, nil, func() error {
 			return runtime.PullImage(image)
 		}); err != nil {
+			if errhints.IsNetworkError(err) {
+				err = errhints.Mark(err, errhints.ErrRegistryUnreachable)
+			}
+
 			return fmt.Errorf("failed to download image: %w", err)
 		}
 	}
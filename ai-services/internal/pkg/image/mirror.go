@@ -0,0 +1,142 @@
+package image
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/pkg/sysregistriesv2"
+	"github.com/containers/image/v5/types"
+)
+
+const manifestCheckTimeout = 30 * time.Second
+
+// MirrorCheck is the outcome of looking up an image in the registry it gets pulled from.
+type MirrorCheck struct {
+	Image string `json:"image"`
+	// Locations are the references the image was looked up at, Eg:- its mirrors in registries.conf.
+	Locations []string `json:"locations"`
+	// Found is the location holding the image, empty when it is missing from all of them.
+	Found string `json:"found,omitempty"`
+	Error string `json:"error,omitempty"`
+}
+
+// Missing reports whether the image is missing from all its locations.
+func (c MirrorCheck) Missing() bool {
+	return c.Found == ""
+}
+
+// RewriteRegistry replaces the registry of the image reference by the given prefix, keeping its repository path,
+// tag and digest. Eg:- "icr.io/ai-services/vllm:0.1" with the prefix "mirror.local:5000/ibm" becomes
+// "mirror.local:5000/ibm/ai-services/vllm:0.1".
+func RewriteRegistry(ref, prefix string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return "", fmt.Errorf("invalid image reference '%s': %w", ref, err)
+	}
+
+	rewritten := strings.TrimSuffix(prefix, "/") + "/" + reference.Path(named)
+	if tagged, ok := named.(reference.Tagged); ok {
+		rewritten += ":" + tagged.Tag()
+	}
+	if digested, ok := named.(reference.Digested); ok {
+		rewritten += digestSeparator + digested.Digest().String()
+	}
+	if _, err := reference.ParseNormalizedNamed(rewritten); err != nil {
+		return "", fmt.Errorf("invalid image reference '%s' with the registry prefix '%s': %w", rewritten, prefix, err)
+	}
+
+	return rewritten, nil
+}
+
+// Locations returns the references the image is pulled from: the one rewritten with the registry prefix when given,
+// or else the mirrors and the rewritten location of its registry in registries.conf, falling back to the image itself.
+func Locations(sys *types.SystemContext, ref, prefix string) ([]string, error) {
+	if prefix != "" {
+		rewritten, err := RewriteRegistry(ref, prefix)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{rewritten}, nil
+	}
+
+	named, err := reference.ParseNormalizedNamed(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid image reference '%s': %w", ref, err)
+	}
+	named = reference.TagNameOnly(named)
+
+	reg, err := sysregistriesv2.FindRegistry(sys, named.String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the registries configuration: %w", err)
+	}
+	if reg == nil {
+		return []string{named.String()}, nil
+	}
+
+	sources, err := reg.PullSourcesFromReference(named)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve the pull sources of '%s': %w", ref, err)
+	}
+	locations := make([]string, 0, len(sources))
+	for _, source := range sources {
+		locations = append(locations, source.Reference.String())
+	}
+
+	return locations, nil
+}
+
+// CheckMirrors looks up the manifest of every image at its locations, without pulling the image.
+func CheckMirrors(ctx context.Context, sys *types.SystemContext, images []string, prefix string) []MirrorCheck {
+	checks := make([]MirrorCheck, 0, len(images))
+	for _, img := range images {
+		check := MirrorCheck{Image: img}
+		locations, err := Locations(sys, img, prefix)
+		if err != nil {
+			check.Error = err.Error()
+			checks = append(checks, check)
+
+			continue
+		}
+		check.Locations = locations
+
+		var errs []string
+		for _, location := range locations {
+			if err := manifestExists(ctx, sys, location); err != nil {
+				errs = append(errs, fmt.Sprintf("%s: %v", location, err))
+
+				continue
+			}
+			check.Found = location
+
+			break
+		}
+		if check.Missing() {
+			check.Error = strings.Join(errs, "; ")
+		}
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+// manifestExists checks that the registry serves the manifest of the image, through a HEAD request of the manifest.
+func manifestExists(ctx context.Context, sys *types.SystemContext, ref string) error {
+	imageRef, err := docker.ParseReference("//" + ref)
+	if err != nil {
+		return fmt.Errorf("invalid image reference: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, manifestCheckTimeout)
+	defer cancel()
+
+	if _, err := docker.GetDigest(ctx, sys, imageRef); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package image
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/containers/image/v5/types"
+)
+
+func TestRewriteRegistry(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     string
+		prefix  string
+		want    string
+		wantErr bool
+	}{
+		{name: "tag", ref: "icr.io/ai-services/vllm:0.1", prefix: "mirror.local:5000", want: "mirror.local:5000/ai-services/vllm:0.1"},
+		{name: "prefix with a path", ref: "icr.io/ai-services/vllm:0.1", prefix: "mirror.local/ibm/", want: "mirror.local/ibm/ai-services/vllm:0.1"},
+		{name: "digest", ref: "icr.io/ai-services/tools@" + testDigest, prefix: "mirror.local", want: "mirror.local/ai-services/tools@" + testDigest},
+		{name: "docker hub", ref: "opensearchproject/opensearch:3", prefix: "mirror.local", want: "mirror.local/opensearchproject/opensearch:3"},
+		{name: "invalid prefix", ref: "icr.io/ai-services/vllm:0.1", prefix: "https://mirror.local", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RewriteRegistry(tt.ref, tt.prefix)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RewriteRegistry(%q, %q) = %q, error = %v, wantErr %v", tt.ref, tt.prefix, got, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("RewriteRegistry(%q, %q) = %q, want %q", tt.ref, tt.prefix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocations(t *testing.T) {
+	dir := t.TempDir()
+	confPath := filepath.Join(dir, "registries.conf")
+	conf := `[[registry]]
+prefix = "icr.io/ai-services"
+location = "icr.io/ai-services"
+
+[[registry.mirror]]
+location = "mirror.local:5000/ai-services"
+`
+	if err := os.WriteFile(confPath, []byte(conf), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	sys := &types.SystemContext{
+		SystemRegistriesConfPath:    confPath,
+		SystemRegistriesConfDirPath: filepath.Join(dir, "registries.conf.d"),
+	}
+
+	tests := []struct {
+		name   string
+		ref    string
+		prefix string
+		want   []string
+	}{
+		{
+			name: "mirrored registry",
+			ref:  "icr.io/ai-services/vllm:0.1",
+			want: []string{"mirror.local:5000/ai-services/vllm:0.1", "icr.io/ai-services/vllm:0.1"},
+		},
+		{name: "registry without a mirror", ref: "quay.io/opensearch/opensearch:3", want: []string{"quay.io/opensearch/opensearch:3"}},
+		{name: "registry prefix overrides the mirrors", ref: "icr.io/ai-services/vllm:0.1", prefix: "other.local", want: []string{"other.local/ai-services/vllm:0.1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Locations(sys, tt.ref, tt.prefix)
+			if err != nil {
+				t.Fatalf("Locations() unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Locations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
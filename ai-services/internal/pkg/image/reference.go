@@ -0,0 +1,51 @@
+package image
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	digestSeparator = "@"
+	latestTag       = "latest"
+)
+
+// digestRegex matches the content digests accepted by the registries, Eg:- sha256:<64 hex digits>.
+var digestRegex = regexp.MustCompile(`^(sha256:[a-f0-9]{64}|sha512:[a-f0-9]{128})$`)
+
+// ValidateReference validates an image reference, pinned by tag (registry/repo:tag) or by digest (registry/repo@sha256:...).
+// The reference is passed on unchanged to the runtime, so that a digest pins the exact image content.
+func ValidateReference(ref string) error {
+	name, digest, pinned := strings.Cut(ref, digestSeparator)
+	if name == "" {
+		return fmt.Errorf("invalid image reference '%s': missing the image name", ref)
+	}
+	if strings.ContainsAny(ref, " \t\n") {
+		return fmt.Errorf("invalid image reference '%s': must not contain whitespaces", ref)
+	}
+	if pinned && !digestRegex.MatchString(digest) {
+		return fmt.Errorf("invalid image reference '%s': digest '%s' must be sha256:<64 hex digits> or sha512:<128 hex digits>", ref, digest)
+	}
+
+	return nil
+}
+
+// HasDigest reports whether the image reference pins the image by digest.
+func HasDigest(ref string) bool {
+	return strings.Contains(ref, digestSeparator)
+}
+
+// IsUnpinned reports whether the image reference resolves to whatever image is latest, as it has no digest and
+// either no tag or the latest one.
+func IsUnpinned(ref string) bool {
+	if HasDigest(ref) {
+		return false
+	}
+
+	// the tag follows the last path component, whereas the registry host may have a port
+	lastComponent := ref[strings.LastIndex(ref, "/")+1:]
+	_, tag, tagged := strings.Cut(lastComponent, ":")
+
+	return !tagged || tag == latestTag
+}
@@ -0,0 +1,56 @@
+package image
+
+import (
+	"strings"
+	"testing"
+)
+
+const testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestValidateReference(t *testing.T) {
+	tests := []struct {
+		name    string
+		ref     string
+		wantErr bool
+	}{
+		{name: "tag", ref: "icr.io/ai-services/tools:0.6"},
+		{name: "digest", ref: "icr.io/ai-services/tools@" + testDigest},
+		{name: "tag and digest", ref: "icr.io/ai-services/tools:0.6@" + testDigest},
+		{name: "registry port", ref: "localhost:5000/tools@" + testDigest},
+		{name: "short digest", ref: "icr.io/ai-services/tools@sha256:0123", wantErr: true},
+		{name: "uppercase digest", ref: "icr.io/ai-services/tools@" + strings.ToUpper(testDigest), wantErr: true},
+		{name: "unknown algorithm", ref: "icr.io/ai-services/tools@md5:0123456789abcdef0123456789abcdef", wantErr: true},
+		{name: "missing name", ref: "@" + testDigest, wantErr: true},
+		{name: "empty", ref: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateReference(tt.ref); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateReference(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsUnpinned(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want bool
+	}{
+		{ref: "icr.io/ai-services/tools:0.6"},
+		{ref: "icr.io/ai-services/tools@" + testDigest},
+		{ref: "icr.io/ai-services/tools:latest@" + testDigest},
+		{ref: "icr.io/ai-services/tools:latest", want: true},
+		{ref: "icr.io/ai-services/tools", want: true},
+		{ref: "localhost:5000/tools", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			if got := IsUnpinned(tt.ref); got != tt.want {
+				t.Errorf("IsUnpinned(%q) = %t, want %t", tt.ref, got, tt.want)
+			}
+		})
+	}
+}
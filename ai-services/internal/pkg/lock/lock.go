@@ -0,0 +1,146 @@
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+)
+
+const (
+	// DefaultDir holds the lock of the operations run as root, Eg:- bootstrap configure.
+	DefaultDir = "/var/run/ai-services"
+	fileName   = "ai-services.lock"
+
+	dirPerm  = 0o755
+	filePerm = 0o644
+
+	exemptAnnotationKey = "ai-services.io/lock-exempt"
+)
+
+// dir overrides the directory of the lock file when set, Eg:- to a temporary directory in the tests.
+var dir string
+
+// Lock is the exclusive lock of the host held by a mutating operation.
+// It is an flock(2) on the lock file, so that the kernel releases it as soon as the process exits,
+// including when it is killed by a signal, and a lock is never left behind by a crashed operation.
+type Lock struct {
+	file *os.File
+}
+
+// DefaultPath returns the path of the lock file: under DefaultDir for root, or else under the runtime directory
+// of the user, as the rootless users have their own podman installation.
+func DefaultPath() string {
+	if dir != "" {
+		return filepath.Join(dir, fileName)
+	}
+	if os.Geteuid() == 0 {
+		return filepath.Join(DefaultDir, fileName)
+	}
+	if dir := os.Getenv("XDG_RUNTIME_DIR"); dir != "" {
+		return filepath.Join(dir, "ai-services", fileName)
+	}
+
+	return filepath.Join(os.TempDir(), "ai-services-"+strconv.Itoa(os.Getuid()), fileName)
+}
+
+// SetDir moves the lock file to the given directory, for root and the other users alike, Eg:- in the tests.
+// It returns the function restoring the previous directory.
+func SetDir(d string) (restore func()) {
+	previous := dir
+	dir = d
+
+	return func() { dir = previous }
+}
+
+// Acquire takes the lock at path for the given operation, failing with errhints.ErrLockHeld when another operation holds it.
+func Acquire(path, operation string) (*Lock, error) {
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return nil, fmt.Errorf("failed to create the lock directory: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, filePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the lock file: %w", err)
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		_ = f.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, errhints.Mark(fmt.Errorf("another ai-services operation is in progress: %s", holder(path)), errhints.ErrLockHeld)
+		}
+
+		return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+	}
+
+	// record the holder, so that the operations waiting on the lock can tell which one it is
+	info := fmt.Sprintf("pid=%d\noperation=%s\nsince=%s\n", os.Getpid(), operation, time.Now().Format(time.RFC3339))
+	if err := f.Truncate(0); err == nil {
+		_, _ = f.WriteAt([]byte(info), 0)
+	}
+
+	return &Lock{file: f}, nil
+}
+
+// ForceUnlock removes the lock file, so that the next Acquire takes a new lock even if the holder still runs,
+// Eg:- an operation which hung. The holder keeps the lock of the removed file, which no longer excludes anyone.
+func ForceUnlock(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove the lock file: %w", err)
+	}
+
+	return nil
+}
+
+// Release releases the lock, the lock file is kept so that the operations racing for it lock the same file.
+func (l *Lock) Release() error {
+	if l == nil || l.file == nil {
+		return nil
+	}
+	_ = l.file.Truncate(0)
+	err := syscall.Flock(int(l.file.Fd()), syscall.LOCK_UN)
+	if closeErr := l.file.Close(); err == nil {
+		err = closeErr
+	}
+	l.file = nil
+
+	return err
+}
+
+// holder describes the operation holding the lock at path, from the info it recorded.
+func holder(path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) == 0 {
+		return "lock " + path
+	}
+
+	fields := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok {
+			fields[key] = value
+		}
+	}
+
+	return fmt.Sprintf("'%s' with pid %s since %s, lock %s", fields["operation"], fields["pid"], fields["since"], path)
+}
+
+// Exempt marks the given mutating command as not taking the lock, Eg:- a long-lived interactive command.
+func Exempt(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[exemptAnnotationKey] = "true"
+}
+
+// IsExempt reports whether the given command was marked as not taking the lock.
+func IsExempt(cmd *cobra.Command) bool {
+	return cmd != nil && cmd.Annotations[exemptAnnotationKey] == "true"
+}
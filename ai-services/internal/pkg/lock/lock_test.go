@@ -0,0 +1,64 @@
+package lock
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+)
+
+func TestAcquire(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run", fileName)
+
+	l, err := Acquire(path, "bootstrap configure")
+	if err != nil {
+		t.Fatalf("Acquire() unexpected error: %v", err)
+	}
+
+	// flock excludes the other open file descriptions, be it in the same process
+	_, err = Acquire(path, "application create")
+	if !errors.Is(err, errhints.ErrLockHeld) {
+		t.Fatalf("Acquire() of a held lock error = %v, want ErrLockHeld", err)
+	}
+	if !strings.Contains(err.Error(), "'bootstrap configure'") {
+		t.Errorf("Acquire() of a held lock error = %v, want the holder", err)
+	}
+
+	if err := l.Release(); err != nil {
+		t.Fatalf("Release() unexpected error: %v", err)
+	}
+	l, err = Acquire(path, "application create")
+	if err != nil {
+		t.Fatalf("Acquire() of a released lock unexpected error: %v", err)
+	}
+	if err := l.Release(); err != nil {
+		t.Fatalf("Release() unexpected error: %v", err)
+	}
+}
+
+func TestForceUnlock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), fileName)
+
+	held, err := Acquire(path, "bootstrap configure")
+	if err != nil {
+		t.Fatalf("Acquire() unexpected error: %v", err)
+	}
+	defer held.Release()
+
+	if err := ForceUnlock(path); err != nil {
+		t.Fatalf("ForceUnlock() unexpected error: %v", err)
+	}
+	l, err := Acquire(path, "application create")
+	if err != nil {
+		t.Fatalf("Acquire() after ForceUnlock() unexpected error: %v", err)
+	}
+	if err := l.Release(); err != nil {
+		t.Fatalf("Release() unexpected error: %v", err)
+	}
+
+	if err := ForceUnlock(filepath.Join(t.TempDir(), fileName)); err != nil {
+		t.Errorf("ForceUnlock() of a missing lock unexpected error: %v", err)
+	}
+}
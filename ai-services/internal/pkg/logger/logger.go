@@ -2,8 +2,15 @@ package logger
 
 import (
 	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"k8s.io/klog/v2"
 )
 
@@ -11,49 +18,164 @@ const (
 	VerbosityLevelDebug = 2
 )
 
+// quiet suppresses informational and warning output when set, it is read by the goroutines logging concurrently.
+var quiet atomic.Bool
+
+var (
+	// mu guards the writers, so that they can be swapped while other goroutines log.
+	mu sync.Mutex
+	// output receives the informational messages and the results, errorOutput the warnings and errors.
+	output      io.Writer = os.Stdout
+	errorOutput io.Writer = os.Stderr
+)
+
+// flusher is implemented by the writers buffering their output, Eg:- bufio.Writer.
+type flusher interface {
+	Flush() error
+}
+
+// SetOutput redirects the informational messages and the results to w, os.Stdout by default.
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	output = w
+}
+
+// Output returns the writer the informational messages and the results are written to.
+func Output() io.Writer {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return output
+}
+
+// IsTerminal reports whether the informational messages and the results are written to a terminal only,
+// Eg:- not copied to a log file, so that the output can be redrawn in place.
+func IsTerminal() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	f, ok := output.(*os.File)
+
+	return ok && term.IsTerminal(int(f.Fd()))
+}
+
+// SetErrorOutput redirects the warnings and errors to w, os.Stderr by default.
+func SetErrorOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	errorOutput = w
+}
+
+// ErrorOutput returns the writer the warnings and errors are written to.
+func ErrorOutput() io.Writer {
+	mu.Lock()
+	defer mu.Unlock()
+
+	return errorOutput
+}
+
+// write writes the message to the given package writer, terminated by a newline.
+// The writer is dereferenced under the lock, so that it can be swapped concurrently.
+func write(w *io.Writer, msg string) {
+	if !strings.HasSuffix(msg, "\n") {
+		msg += "\n"
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	_, _ = io.WriteString(*w, msg)
+}
+
+// SetQuiet enables or disables quiet mode. In quiet mode only errors and
+// results logged via Resultln/Resultf are emitted.
+func SetQuiet(q bool) {
+	quiet.Store(q)
+}
+
+// IsQuiet reports whether quiet mode is enabled.
+func IsQuiet() bool {
+	return quiet.Load()
+}
+
+// Init registers the -v flag, setting the verbosity of the informational messages, on flag.CommandLine.
 func Init() {
-	klog.InitFlags(flag.CommandLine)
-	_ = flag.CommandLine.Set("alsologtostderr", "true")
-	_ = flag.CommandLine.Set("skip_headers", "true")
-	_ = flag.CommandLine.Set("skip_log_headers", "true")
+	flag.CommandLine.Var(verbosityFlag().Value, "v", verbosityUsage)
 }
 
+// InitFlags registers the -v flag, setting the verbosity of the informational messages, on the command.
 func InitFlags(cmd *cobra.Command) {
 	klogFlags := flag.NewFlagSet("klog", flag.ExitOnError)
-	klog.InitFlags(klogFlags)
+	klogFlags.Var(verbosityFlag().Value, "v", verbosityUsage)
 
 	cmd.PersistentFlags().AddGoFlagSet(klogFlags)
 }
 
+const verbosityUsage = "Verbosity of the informational messages, e.g. 2 for the debug messages."
+
+// verbosityFlag returns the verbosity flag of klog, read by Infoln and Infof. The other klog flags are not exposed,
+// as the messages are written to the configured writers instead of through klog, Eg:- its log_file is never written.
+// klog still logs the messages of the Kubernetes client, without headers as before.
+func verbosityFlag() *flag.Flag {
+	klogFlags := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(klogFlags)
+	_ = klogFlags.Set("skip_headers", "true")
+	_ = klogFlags.Set("skip_log_headers", "true")
+
+	return klogFlags.Lookup("v")
+}
+
+// Flush flushes the configured writers which buffer their output, such as a bufio.Writer.
 func Flush() {
 	klog.Flush()
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, w := range []io.Writer{output, errorOutput} {
+		if f, ok := w.(flusher); ok {
+			_ = f.Flush()
+		}
+	}
 }
 
 func Warningln(msg string) {
-	klog.Warningln("WARNING: ", msg)
+	if quiet.Load() {
+		return
+	}
+	write(&errorOutput, "WARNING: "+msg)
 }
 
 func Warningf(msg string, args ...interface{}) {
-	klog.Warningf("WARNING: "+msg, args...)
+	if quiet.Load() {
+		return
+	}
+	write(&errorOutput, fmt.Sprintf("WARNING: "+msg, args...))
 }
 
 func Errorln(msg string) {
-	klog.Errorln("ERROR: ", msg)
+	write(&errorOutput, "ERROR: "+msg)
 }
 
 func Errorf(msg string, args ...interface{}) {
-	klog.Errorf("ERROR: "+msg, args...)
+	write(&errorOutput, fmt.Sprintf("ERROR: "+msg, args...))
 }
 
 func Infoln(msg string, verbose ...int) {
+	if quiet.Load() {
+		return
+	}
 	v := 0
 	if len(verbose) > 0 {
 		v = verbose[0]
 	}
-	klog.V(klog.Level(v)).Infoln(msg)
+	if klog.V(klog.Level(v)).Enabled() {
+		write(&output, msg)
+	}
 }
 
 func Infof(msg string, args ...interface{}) {
+	if quiet.Load() {
+		return
+	}
 	v := 0
 	// The last arg is an int, used for verbosity level
 	if len(args) > 0 {
@@ -62,5 +184,17 @@ func Infof(msg string, args ...interface{}) {
 			args = args[:len(args)-1] // remove verbosity argument
 		}
 	}
-	klog.V(klog.Level(v)).Infof(msg, args...)
+	if klog.V(klog.Level(v)).Enabled() {
+		write(&output, fmt.Sprintf(msg, args...))
+	}
+}
+
+// Resultln logs the final result of a command. Unlike Infoln it is emitted even in quiet mode.
+func Resultln(msg string) {
+	write(&output, msg)
+}
+
+// Resultf logs the final result of a command. Unlike Infof it is emitted even in quiet mode.
+func Resultf(msg string, args ...interface{}) {
+	write(&output, fmt.Sprintf(msg, args...))
 }
@@ -0,0 +1,145 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// captureOutput redirects both writers to buffers for the duration of the test.
+func captureOutput(t *testing.T) (*bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+
+	var out, errOut bytes.Buffer
+	SetOutput(&out)
+	SetErrorOutput(&errOut)
+	t.Cleanup(func() {
+		SetOutput(os.Stdout)
+		SetErrorOutput(os.Stderr)
+		SetQuiet(false)
+	})
+
+	return &out, &errOut
+}
+
+func TestOutputWriters(t *testing.T) {
+	out, errOut := captureOutput(t)
+
+	Infoln("configuring podman")
+	Infof("pulled %s images", "2")
+	Infoln("debug details", VerbosityLevelDebug)
+	Resultln("done")
+	Warningf("%s check skipped\n", "numa")
+	Errorln("failed")
+
+	if want := "configuring podman\npulled 2 images\ndone\n"; out.String() != want {
+		t.Errorf("output = %q, want %q", out.String(), want)
+	}
+	if want := "WARNING: numa check skipped\nERROR: failed\n"; errOut.String() != want {
+		t.Errorf("error output = %q, want %q", errOut.String(), want)
+	}
+}
+
+func TestInitFlagsVerbosity(t *testing.T) {
+	out, _ := captureOutput(t)
+
+	cmd := &cobra.Command{Use: "test"}
+	InitFlags(cmd)
+	if f := cmd.PersistentFlags().Lookup("log_file"); f != nil {
+		t.Errorf("InitFlags() registered --log_file, want only the flags which take effect")
+	}
+	if err := cmd.PersistentFlags().Set("v", "2"); err != nil {
+		t.Fatalf("Set(v) error = %v", err)
+	}
+	t.Cleanup(func() { _ = cmd.PersistentFlags().Set("v", "0") })
+
+	Infoln("debug details", VerbosityLevelDebug)
+	if want := "debug details\n"; out.String() != want {
+		t.Errorf("output = %q, want %q with -v=2", out.String(), want)
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	captureOutput(t)
+	if IsTerminal() {
+		t.Errorf("IsTerminal() = true, want false for a buffer")
+	}
+
+	f, err := os.CreateTemp(t.TempDir(), "output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	SetOutput(f)
+	if IsTerminal() {
+		t.Errorf("IsTerminal() = true, want false for a regular file")
+	}
+}
+
+func TestQuietKeepsResultsAndErrors(t *testing.T) {
+	out, errOut := captureOutput(t)
+	SetQuiet(true)
+
+	Infoln("configuring podman")
+	Warningln("skipped")
+	Resultln("done")
+	Errorf("failed: %s", "boom")
+
+	if out.String() != "done\n" {
+		t.Errorf("output = %q, want %q", out.String(), "done\n")
+	}
+	if errOut.String() != "ERROR: failed: boom\n" {
+		t.Errorf("error output = %q, want %q", errOut.String(), "ERROR: failed: boom\n")
+	}
+}
+
+func TestFlushBufferedWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	captureOutput(t)
+	SetOutput(w)
+
+	Resultln("done")
+	if buf.Len() != 0 {
+		t.Fatalf("output written before Flush: %q", buf.String())
+	}
+
+	Flush()
+	if buf.String() != "done\n" {
+		t.Errorf("output after Flush = %q, want %q", buf.String(), "done\n")
+	}
+}
+
+func TestConcurrentLogging(t *testing.T) {
+	out, _ := captureOutput(t)
+
+	const goroutines, lines = 8, 50
+	var wg sync.WaitGroup
+	for g := range goroutines {
+		wg.Go(func() {
+			for i := range lines {
+				Resultf("goroutine %d line %d", g, i)
+			}
+		})
+	}
+	// swapping the writer concurrently must not race with the logging goroutines
+	wg.Go(func() { SetErrorOutput(&bytes.Buffer{}) })
+	wg.Wait()
+
+	got := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(got) != goroutines*lines {
+		t.Fatalf("got %d lines, want %d", len(got), goroutines*lines)
+	}
+	for _, line := range got {
+		var g, i int
+		if _, err := fmt.Sscanf(line, "goroutine %d line %d", &g, &i); err != nil {
+			t.Errorf("interleaved line %q", line)
+		}
+	}
+}
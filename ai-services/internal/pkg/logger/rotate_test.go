@@ -0,0 +1,130 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func readLog(t *testing.T, path string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return ""
+		}
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+
+	return string(data)
+}
+
+func TestRotatingFile(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxSize    int64
+		maxBackups int
+		writes     []string
+		want       map[string]string
+	}{
+		{
+			name:       "below the max size",
+			maxSize:    10,
+			maxBackups: 2,
+			writes:     []string{"aaaa\n", "bbbb\n"},
+			want:       map[string]string{"cli.log": "aaaa\nbbbb\n", "cli.log.1": ""},
+		},
+		{
+			name:       "rotated keeping the backups",
+			maxSize:    10,
+			maxBackups: 2,
+			writes:     []string{"aaaa\n", "bbbb\n", "cccc\n", "dddd\n", "eeee\n", "ffff\n", "gggg\n"},
+			want: map[string]string{
+				"cli.log":   "gggg\n",
+				"cli.log.1": "eeee\nffff\n",
+				"cli.log.2": "cccc\ndddd\n",
+				"cli.log.3": "",
+			},
+		},
+		{
+			name:       "no backups",
+			maxSize:    10,
+			maxBackups: 0,
+			writes:     []string{"aaaa\n", "bbbb\n", "cccc\n"},
+			want:       map[string]string{"cli.log": "cccc\n", "cli.log.1": ""},
+		},
+		{
+			name:       "write larger than the max size",
+			maxSize:    4,
+			maxBackups: 1,
+			writes:     []string{"aa\n", "bbbbbbbb\n"},
+			want:       map[string]string{"cli.log": "bbbbbbbb\n", "cli.log.1": "aa\n"},
+		},
+		{
+			name:       "rotation disabled",
+			maxSize:    0,
+			maxBackups: 1,
+			writes:     []string{"aaaa\n", "bbbb\n", "cccc\n"},
+			want:       map[string]string{"cli.log": "aaaa\nbbbb\ncccc\n", "cli.log.1": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			f, err := OpenRotatingFile(filepath.Join(dir, "cli.log"), tt.maxSize, tt.maxBackups)
+			if err != nil {
+				t.Fatalf("OpenRotatingFile() error = %v", err)
+			}
+			for _, w := range tt.writes {
+				if _, err := f.Write([]byte(w)); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+			if err := f.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			for name, want := range tt.want {
+				if got := readLog(t, filepath.Join(dir, name)); got != want {
+					t.Errorf("%s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRotatingFileAppendsAcrossRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "cli.log")
+
+	for _, w := range []string{"first run\n", "second run\n"} {
+		f, err := OpenRotatingFile(path, 15, 1)
+		if err != nil {
+			t.Fatalf("OpenRotatingFile() error = %v", err)
+		}
+		if _, err := f.Write([]byte(w)); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		_ = f.Close()
+	}
+
+	if got := readLog(t, path); got != "second run\n" {
+		t.Errorf("cli.log = %q, want the second run only", got)
+	}
+	if got := readLog(t, path+".1"); got != "first run\n" {
+		t.Errorf("cli.log.1 = %q, want the first run", got)
+	}
+}
+
+func TestRotatingFileClosed(t *testing.T) {
+	f, err := OpenRotatingFile(filepath.Join(t.TempDir(), "cli.log"), 10, 1)
+	if err != nil {
+		t.Fatalf("OpenRotatingFile() error = %v", err)
+	}
+	_ = f.Close()
+
+	if _, err := f.Write([]byte("late\n")); err == nil {
+		t.Error("Write() after Close() error = nil, want an error")
+	}
+}
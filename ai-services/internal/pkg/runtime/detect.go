@@ -0,0 +1,27 @@
+package runtime
+
+import (
+	"os"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+// serviceAccountTokenPath is where the token of the service account is mounted in a Kubernetes or OpenShift pod.
+var serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// DefaultRuntimeType returns the runtime to use when none is given with --runtime: openshift when running inside
+// a pod, Eg:- as a Job in the cluster, detected by its mounted service account token, and podman on a bare host.
+func DefaultRuntimeType() types.RuntimeType {
+	if _, err := os.Stat(serviceAccountTokenPath); err != nil {
+		logger.Infof("No service account token found at %s, not running in a pod, defaulting to the %s runtime\n",
+			serviceAccountTokenPath, types.RuntimeTypePodman, logger.VerbosityLevelDebug)
+
+		return types.RuntimeTypePodman
+	}
+
+	logger.Infof("Service account token found at %s, running in a pod, defaulting to the %s runtime\n",
+		serviceAccountTokenPath, types.RuntimeTypeOpenShift, logger.VerbosityLevelDebug)
+
+	return types.RuntimeTypeOpenShift
+}
@@ -0,0 +1,26 @@
+package runtime
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+)
+
+func TestDefaultRuntimeType(t *testing.T) {
+	path := serviceAccountTokenPath
+	t.Cleanup(func() { serviceAccountTokenPath = path })
+
+	serviceAccountTokenPath = filepath.Join(t.TempDir(), "token")
+	if got := DefaultRuntimeType(); got != types.RuntimeTypePodman {
+		t.Errorf("DefaultRuntimeType() on a bare host = %s, want %s", got, types.RuntimeTypePodman)
+	}
+
+	if err := os.WriteFile(serviceAccountTokenPath, []byte("token"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if got := DefaultRuntimeType(); got != types.RuntimeTypeOpenShift {
+		t.Errorf("DefaultRuntimeType() in a pod = %s, want %s", got, types.RuntimeTypeOpenShift)
+	}
+}
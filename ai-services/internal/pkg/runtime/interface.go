@@ -1,6 +1,7 @@
 package runtime
 
 import (
+	"context"
 	"io"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
@@ -10,6 +11,7 @@ type Runtime interface {
 	// Image operations
 	ListImages() ([]types.Image, error)
 	PullImage(image string) error
+	Build(ctx context.Context, contextDir, tag string) error
 
 	// Pod operations
 	ListPods(filters map[string][]string) ([]types.Pod, error)
@@ -19,13 +21,14 @@ type Runtime interface {
 	StartPod(id string) error
 	InspectPod(nameOrId string) (*types.Pod, error)
 	PodExists(nameOrID string) (bool, error)
-	PodLogs(nameOrID string) error
+	PodLogs(nameOrID string, opts types.LogsOptions) error
 
 	// Container operations
 	// ListContainers(filters map[string][]string) ([]types.Container, error)
 	InspectContainer(nameOrId string) (*types.Container, error)
 	ContainerExists(nameOrID string) (bool, error)
-	ContainerLogs(containerNameOrID string) error
+	ContainerLogs(containerNameOrID string, opts types.LogsOptions) error
+	ExecContainer(podNameOrID, containerName string, opts types.ExecOptions) error
 
 	// Network operations
 	ListRoutes() ([]types.Route, error)
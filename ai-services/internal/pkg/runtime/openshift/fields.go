@@ -0,0 +1,55 @@
+package openshift
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// ErrFieldNotFound is matched with errors.Is by the FieldError of a missing field.
+var ErrFieldNotFound = errors.New("not found")
+
+// FieldError reports an expected field of an unstructured object which is missing, or holds a value of another type,
+// Eg:- when the schema of a custom resource changed.
+type FieldError struct {
+	// Path is the dotted path of the field, Eg:- status.phase.
+	Path string
+	// Err is ErrFieldNotFound for a missing field, the type mismatch otherwise.
+	Err error
+}
+
+func (e *FieldError) Error() string {
+	if errors.Is(e.Err, ErrFieldNotFound) {
+		return e.Path + " not found"
+	}
+
+	return fmt.Sprintf("invalid %s: %v", e.Path, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// NestedString returns the string field of the object at the given path.
+// Unlike unstructured.NestedString, a missing field is an error too, so that it is not mistaken for an empty value.
+func NestedString(obj map[string]any, fields ...string) (string, error) {
+	path := strings.Join(fields, ".")
+
+	val, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil {
+		// one of the parent fields is not an object
+		return "", &FieldError{Path: path, Err: err}
+	}
+	if !found || val == nil {
+		return "", &FieldError{Path: path, Err: ErrFieldNotFound}
+	}
+
+	s, ok := val.(string)
+	if !ok {
+		return "", &FieldError{Path: path, Err: fmt.Errorf("got %v of the type %T, expected a string", val, val)}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,78 @@
+package openshift
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNestedString(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      map[string]any
+		want     string
+		wantErr  string
+		notFound bool
+	}{
+		{
+			name: "string field",
+			obj:  map[string]any{"status": map[string]any{"phase": "Succeeded"}},
+			want: "Succeeded",
+		},
+		{
+			name: "empty string field",
+			obj:  map[string]any{"status": map[string]any{"phase": ""}},
+		},
+		{
+			name:     "missing field",
+			obj:      map[string]any{"status": map[string]any{}},
+			wantErr:  "status.phase not found",
+			notFound: true,
+		},
+		{
+			name:     "missing parent",
+			obj:      map[string]any{},
+			wantErr:  "status.phase not found",
+			notFound: true,
+		},
+		{
+			name:     "null field",
+			obj:      map[string]any{"status": map[string]any{"phase": nil}},
+			wantErr:  "status.phase not found",
+			notFound: true,
+		},
+		{
+			name:    "wrong type",
+			obj:     map[string]any{"status": map[string]any{"phase": int64(1)}},
+			wantErr: "invalid status.phase: got 1 of the type int64, expected a string",
+		},
+		{
+			name:    "parent not an object",
+			obj:     map[string]any{"status": "Succeeded"},
+			wantErr: "invalid status.phase: .status.phase accessor error: Succeeded is of the type string, expected map[string]interface{}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NestedString(tt.obj, "status", "phase")
+			if tt.wantErr == "" {
+				if err != nil || got != tt.want {
+					t.Fatalf("NestedString() = %q, %v, want %q", got, err, tt.want)
+				}
+
+				return
+			}
+
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Path != "status.phase" {
+				t.Fatalf("NestedString() error = %v, want a FieldError of status.phase", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("NestedString() error = %q, want %q", err, tt.wantErr)
+			}
+			if errors.Is(err, ErrFieldNotFound) != tt.notFound {
+				t.Errorf("errors.Is(err, ErrFieldNotFound) = %v, want %v", !tt.notFound, tt.notFound)
+			}
+		})
+	}
+}
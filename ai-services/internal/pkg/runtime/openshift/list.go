@@ -0,0 +1,32 @@
+package openshift
+
+import (
+	"context"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListPageSize is the number of objects fetched per request by ListPages, in line with the default page size of kubectl.
+const ListPageSize = 500
+
+// ListPages lists the objects into list one page at a time, through the limit and continue of the list requests,
+// so that the large lists do not get fetched in one heavy call, Eg:- the CSVs of a cluster with hundreds of operators.
+// visit is called once list holds each page, and every page replaces the items of the previous one.
+func ListPages(ctx context.Context, c client.Reader, list client.ObjectList, visit func() error, opts ...client.ListOption) error {
+	continueToken := ""
+	for {
+		pageOpts := append(slices.Clone(opts), client.Limit(ListPageSize), client.Continue(continueToken))
+		if err := c.List(ctx, list, pageOpts...); err != nil {
+			return err
+		}
+		if err := visit(); err != nil {
+			return err
+		}
+
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			return nil
+		}
+	}
+}
@@ -6,21 +6,32 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"os"
+	"os/exec"
 	"os/signal"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
+	configv1 "github.com/openshift/api/config/v1"
 	routeclient "github.com/openshift/client-go/route/clientset/versioned"
+	operatorsv1 "github.com/operator-framework/api/pkg/operators/v1"
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -33,8 +44,13 @@ var (
 	scheme = runtime.NewScheme()
 
 	// Singleton instances for all three clients, initialized together.
-	clientsOnce sync.Once
-	clientsErr  error
+	// Failures are not cached, so that a later call can retry the initialization.
+	clientsMu    sync.Mutex
+	clientsReady bool
+	// kubeContext overrides the current context of the kubeconfig when set.
+	kubeContext string
+	// insecureSkipTLSVerify disables the verification of the API server certificate.
+	insecureSkipTLSVerify bool
 
 	controllerRuntimeClient client.Client
 	kubeClient              *kubernetes.Clientset
@@ -43,11 +59,18 @@ var (
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(operatorsv1.AddToScheme(scheme))
 	utilruntime.Must(operatorsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(configv1.AddToScheme(scheme))
 }
 
 const (
-	labelPartsCount = 2 // labelPartsCount is used to split label filters in the format "key=value".
+	labelPartsCount = 2 // labelPartsCount is used to split label filters in the format "key=value" or "key".
+
+	// retry settings for transient failures while constructing the clients.
+	clientRetryAttempts = 3
+	clientRetryDelay    = 2 * time.Second
+	clientRetryFactor   = 2
 )
 
 // OpenshiftClient implements the Runtime interface for Openshift.
@@ -82,61 +105,168 @@ func NewOpenshiftClientWithNamespace(namespace string) (*OpenshiftClient, error)
 	}, nil
 }
 
-// initializeClients initializes all three clients once using sync.Once.
+// UseContext switches all the clients to the given kubeconfig context.
+// An empty name selects the current context of the kubeconfig. The clients are recreated on next use.
+func UseContext(name string) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	kubeContext = name
+	clientsReady = false
+}
+
+// CurrentContext returns the kubeconfig context the clients use, empty for the current context of the kubeconfig.
+func CurrentContext() string {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	return kubeContext
+}
+
+// SetInsecureSkipTLSVerify toggles the verification of the API server certificate for all the clients.
+// The clients are recreated on next use.
+func SetInsecureSkipTLSVerify(insecure bool) {
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
+
+	insecureSkipTLSVerify = insecure
+	clientsReady = false
+}
+
+// ListContexts returns the names of all the contexts defined in the kubeconfig.
+func ListContexts() ([]string, error) {
+	config, err := clientcmd.LoadFromFile(kubeconfigPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	contexts := make([]string, 0, len(config.Contexts))
+	for name := range config.Contexts {
+		contexts = append(contexts, name)
+	}
+	sort.Strings(contexts)
+
+	return contexts, nil
+}
+
+// initializeClients initializes all three clients once.
+// Transient failures while reaching the cluster are retried with backoff,
+// whereas permanent config errors (e.g. a bad kubeconfig path) fail right away.
 func initializeClients() error {
-	clientsOnce.Do(func() {
-		config, err := getKubeConfig()
-		if err != nil {
-			clientsErr = fmt.Errorf("failed to get openshift config: %w", err)
+	clientsMu.Lock()
+	defer clientsMu.Unlock()
 
-			return
-		}
+	if clientsReady {
+		return nil
+	}
+	defer trace.Start("openshift client")()
 
-		// Initialize controller-runtime client
-		controllerRuntimeClient, err = client.New(config, client.Options{Scheme: scheme})
-		if err != nil {
-			clientsErr = fmt.Errorf("failed to create controller-runtime client: %w", err)
+	config, err := getKubeConfig()
+	if err != nil {
+		return fmt.Errorf("failed to get openshift config: %w", err)
+	}
+	if insecureSkipTLSVerify {
+		// a CA bundle cannot be combined with insecure mode in the rest config
+		config.Insecure = true
+		config.CAFile = ""
+		config.CAData = nil
+	}
 
-			return
-		}
+	backoff := func(d time.Duration) time.Duration { return d * clientRetryFactor }
+	if err := utils.Retry(clientRetryAttempts, clientRetryDelay, backoff, func() error {
+		return createClients(config)
+	}); err != nil {
+		return err
+	}
+	clientsReady = true
+
+	return nil
+}
 
-		// Initialize Kubernetes clientset
-		kubeClient, err = kubernetes.NewForConfig(config)
-		if err != nil {
-			clientsErr = fmt.Errorf("failed to create openshift clientset: %w", err)
+// createClients creates all three clients, and verifies that the cluster can be reached via discovery.
+// Errors which are not worth retrying are marked as permanent.
+func createClients(config *rest.Config) error {
+	var err error
 
-			return
-		}
+	// Initialize controller-runtime client
+	controllerRuntimeClient, err = client.New(config, client.Options{Scheme: scheme})
+	if err != nil {
+		return classifyClientError(fmt.Errorf("failed to create controller-runtime client: %w", err))
+	}
 
-		// Initialize OpenShift Route client
-		routeClient, err = routeclient.NewForConfig(config)
-		if err != nil {
-			clientsErr = fmt.Errorf("failed to create openshift route clientset: %w", err)
+	// Initialize Kubernetes clientset
+	kubeClient, err = kubernetes.NewForConfig(config)
+	if err != nil {
+		return utils.Permanent(fmt.Errorf("failed to create openshift clientset: %w", err))
+	}
 
-			return
-		}
-	})
+	// Initialize OpenShift Route client
+	routeClient, err = routeclient.NewForConfig(config)
+	if err != nil {
+		return utils.Permanent(fmt.Errorf("failed to create openshift route clientset: %w", err))
+	}
+
+	// Ensure the API server is reachable, this is where transient discovery errors surface
+	if _, err := kubeClient.Discovery().ServerVersion(); err != nil {
+		return classifyClientError(fmt.Errorf("failed to reach the openshift API server: %w", err))
+	}
+
+	return nil
+}
+
+// classifyClientError marks the error as permanent unless it is a transient discovery or network error.
+func classifyClientError(err error) error {
+	if isTransientError(err) {
+		logger.Infof("Transient error while creating openshift client: %v\n", err, logger.VerbosityLevelDebug)
+
+		return WithSuggestedDelay(err)
+	}
+
+	return utils.Permanent(err)
+}
+
+// WithSuggestedDelay attaches the delay the API server asked to wait before retrying to the error,
+// Eg:- the Retry-After of a 429 Too Many Requests of the API priority and fairness, so that utils.Retry honors it.
+func WithSuggestedDelay(err error) error {
+	seconds, ok := apierrors.SuggestsClientDelay(err)
+	if !ok {
+		return err
+	}
+
+	return utils.WithRetryAfter(err, time.Duration(seconds)*time.Second)
+}
 
-	return clientsErr
+func isTransientError(err error) bool {
+	if apierrors.IsUnauthorized(err) || apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) || apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err) {
+		return true
+	}
+
+	if discovery.IsGroupDiscoveryFailedError(err) {
+		return true
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNREFUSED)
 }
 
 // getKubeConfig attempts to get openshift config from in-cluster or kubeconfig file.
+// In-cluster config is skipped when a specific kubeconfig context was requested.
 func getKubeConfig() (*rest.Config, error) {
-	// Try in-cluster config first
-	config, err := rest.InClusterConfig()
-	if err == nil {
-		return config, nil
+	if kubeContext == "" {
+		// Try in-cluster config first
+		config, err := rest.InClusterConfig()
+		if err == nil {
+			return config, nil
+		}
 	}
 
 	// Fall back to kubeconfig file
-	var kubeconfig string
-	if kubeconfigEnv := os.Getenv("KUBECONFIG"); kubeconfigEnv != "" {
-		kubeconfig = kubeconfigEnv
-	} else if home := homedir.HomeDir(); home != "" {
-		kubeconfig = filepath.Join(home, ".kube", "config")
-	}
-
-	config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfigPath()},
+		&clientcmd.ConfigOverrides{CurrentContext: kubeContext},
+	).ClientConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to build config from kubeconfig: %w", err)
 	}
@@ -144,6 +274,18 @@ func getKubeConfig() (*rest.Config, error) {
 	return config, nil
 }
 
+// kubeconfigPath returns the kubeconfig file path from KUBECONFIG, or the default one under the home directory.
+func kubeconfigPath() string {
+	if kubeconfigEnv := os.Getenv("KUBECONFIG"); kubeconfigEnv != "" {
+		return kubeconfigEnv
+	}
+	if home := homedir.HomeDir(); home != "" {
+		return filepath.Join(home, ".kube", "config")
+	}
+
+	return ""
+}
+
 // ListImages lists container images.
 func (kc *OpenshiftClient) ListImages() ([]types.Image, error) {
 	logger.Warningln("ListImages is not implemented for OpenshiftClient. Returning empty list.")
@@ -158,20 +300,28 @@ func (kc *OpenshiftClient) PullImage(image string) error {
 	return nil
 }
 
+// Build is not supported, as the images of the cluster are built in the cluster itself.
+func (kc *OpenshiftClient) Build(ctx context.Context, contextDir, tag string) error {
+	return fmt.Errorf("%w: building images is not supported for the openshift runtime, "+
+		"build the image '%s' in the cluster with a BuildConfig (oc new-build --binary) and reference it from the chart", errors.ErrUnsupported, tag)
+}
+
 // ListPods lists pods with optional filters.
 func (kc *OpenshiftClient) ListPods(filters map[string][]string) ([]types.Pod, error) {
 	labels := client.MatchingLabels{}
-	if labelFilters, exists := filters["label"]; exists {
-		for _, lf := range labelFilters {
-			parts := strings.SplitN(lf, "=", labelPartsCount)
-			if len(parts) == labelPartsCount {
-				labels[parts[0]] = parts[1]
-			}
+	hasLabels := client.HasLabels{}
+	for _, lf := range filters["label"] {
+		// a filter without a value, matches any value of the label, same as podman
+		parts := strings.SplitN(lf, "=", labelPartsCount)
+		if len(parts) == labelPartsCount {
+			labels[parts[0]] = parts[1]
+		} else {
+			hasLabels = append(hasLabels, lf)
 		}
 	}
 
 	podList := &corev1.PodList{}
-	err := kc.Client.List(kc.Ctx, podList, client.InNamespace(kc.Namespace), labels)
+	err := kc.Client.List(kc.Ctx, podList, client.InNamespace(kc.Namespace), labels, hasLabels)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list pods: %w", err)
 	}
@@ -238,7 +388,7 @@ func (kc *OpenshiftClient) StartPod(id string) error {
 }
 
 // PodLogs retrieves logs from a pod.
-func (kc *OpenshiftClient) PodLogs(podNameOrID string) error {
+func (kc *OpenshiftClient) PodLogs(podNameOrID string, logsOpts types.LogsOptions) error {
 	podName, err := getPodNameWithPrefix(kc, podNameOrID)
 	if err != nil {
 		return fmt.Errorf("failed to get the pod: %w", err)
@@ -246,7 +396,8 @@ func (kc *OpenshiftClient) PodLogs(podNameOrID string) error {
 
 	// Defaults to only container if there is one container in the pod.
 	opts := &corev1.PodLogOptions{
-		Follow: true,
+		Follow:       true,
+		SinceSeconds: sinceSeconds(logsOpts.Since),
 	}
 
 	return followLogs(kc, podName, opts)
@@ -299,7 +450,7 @@ func (kc *OpenshiftClient) ContainerExists(nameOrID string) (bool, error) {
 }
 
 // ContainerLogs retrieves logs from a specific container.
-func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string) error {
+func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string, logsOpts types.LogsOptions) error {
 	if containerNameOrID == "" {
 		return fmt.Errorf("container name is required to fetch logs")
 	}
@@ -315,8 +466,9 @@ func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string) error {
 		for _, container := range pod.Spec.Containers {
 			if container.Name == containerNameOrID {
 				opts := &corev1.PodLogOptions{
-					Container: containerNameOrID,
-					Follow:    true,
+					Container:    containerNameOrID,
+					Follow:       true,
+					SinceSeconds: sinceSeconds(logsOpts.Since),
 				}
 
 				return followLogs(kc, pod.Name, opts)
@@ -327,6 +479,68 @@ func (kc *OpenshiftClient) ContainerLogs(containerNameOrID string) error {
 	return fmt.Errorf("cannot find pod for the given container")
 }
 
+// ExecContainer runs a command inside the container of the pod, streaming its standard input and outputs.
+func (kc *OpenshiftClient) ExecContainer(podNameOrID, containerName string, opts types.ExecOptions) error {
+	podName, err := getPodNameWithPrefix(kc, podNameOrID)
+	if err != nil {
+		return fmt.Errorf("failed to get the pod: %w", err)
+	}
+
+	cli, err := clusterCLI()
+	if err != nil {
+		return err
+	}
+
+	//nolint:godox
+	// TODO: exec via the SDK way, with the remotecommand executor of client-go
+	cmdExec := exec.CommandContext(kc.Ctx, cli, execArgs(kc.Namespace, podName, containerName, opts)...)
+	cmdExec.Stdin = opts.Stdin
+	cmdExec.Stdout = opts.Stdout
+	cmdExec.Stderr = opts.Stderr
+
+	err = cmdExec.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return fmt.Errorf("command exited with code %d", exitErr.ExitCode())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to exec into the container: %w", err)
+	}
+
+	return nil
+}
+
+// clusterCLI returns the command line client of the cluster, oc or else kubectl, used for the operations
+// which are not supported by the clients.
+func clusterCLI() (string, error) {
+	for _, cli := range []string{"oc", "kubectl"} {
+		if path, err := exec.LookPath(cli); err == nil {
+			return path, nil
+		}
+	}
+
+	return "", errors.New("neither oc nor kubectl is found in PATH, install one of them to exec into the containers")
+}
+
+// execArgs returns the arguments of the exec command, targeting the same cluster as the clients.
+func execArgs(namespace, podName, containerName string, opts types.ExecOptions) []string {
+	args := []string{"exec", "--namespace", namespace, podName, "--container", containerName}
+	if kubeContext != "" {
+		args = append(args, "--context", kubeContext)
+	}
+	if insecureSkipTLSVerify {
+		args = append(args, "--insecure-skip-tls-verify")
+	}
+	if opts.Stdin != nil {
+		args = append(args, "--stdin")
+	}
+	if opts.TTY {
+		args = append(args, "--tty")
+	}
+
+	return append(append(args, "--"), opts.Command...)
+}
+
 // ListRoutes lists all routes in the namespace.
 func (kc *OpenshiftClient) ListRoutes() ([]types.Route, error) {
 	routeList, err := kc.RouteClient.RouteV1().Routes(kc.Namespace).List(kc.Ctx, metav1.ListOptions{})
@@ -337,7 +551,7 @@ func (kc *OpenshiftClient) ListRoutes() ([]types.Route, error) {
 	return toOpenShiftRouteList(routeList.Items), nil
 }
 
-// DeletePVCs deletes all PVCs matching the given application label.
+// DeletePVCs deletes all PVCs matching the given application label, attempting each and joining the errors of the failed ones.
 func (kc *OpenshiftClient) DeletePVCs(appLabel string) error {
 	pvcs, err := kc.KubeClient.CoreV1().PersistentVolumeClaims(kc.Namespace).List(kc.Ctx, metav1.ListOptions{
 		LabelSelector: appLabel,
@@ -346,9 +560,12 @@ func (kc *OpenshiftClient) DeletePVCs(appLabel string) error {
 		return fmt.Errorf("failed to list PVCs for cleanup: %w", err)
 	}
 
+	var errs []error
 	for _, pvc := range pvcs.Items {
-		if err := kc.KubeClient.CoreV1().PersistentVolumeClaims(kc.Namespace).Delete(kc.Ctx, pvc.Name, metav1.DeleteOptions{}); err != nil {
-			logger.Warningf("Failed to delete PVC '%s': %v\n", pvc.Name, err)
+		err := kc.KubeClient.CoreV1().PersistentVolumeClaims(kc.Namespace).Delete(kc.Ctx, pvc.Name, metav1.DeleteOptions{})
+		// a PVC deleted meanwhile is cleaned up all the same
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("PVC '%s': %w", pvc.Name, err))
 
 			continue
 		}
@@ -356,7 +573,7 @@ func (kc *OpenshiftClient) DeletePVCs(appLabel string) error {
 		logger.Infof("Deleted PVC '%s'\n", pvc.Name, logger.VerbosityLevelDebug)
 	}
 
-	return nil
+	return errors.Join(errs...)
 }
 
 // Type returns the runtime type.
@@ -379,6 +596,17 @@ func getPodNameWithPrefix(kc *OpenshiftClient, nameOrID string) (string, error)
 	return "", fmt.Errorf("cannot find pod: %s", nameOrID)
 }
 
+// sinceSeconds converts the since duration of the logs to whole seconds, rounded up so that no line is missed,
+// nil when the logs are not limited.
+func sinceSeconds(since time.Duration) *int64 {
+	if since <= 0 {
+		return nil
+	}
+	seconds := int64(math.Ceil(since.Seconds()))
+
+	return &seconds
+}
+
 func followLogs(kc *OpenshiftClient, podName string, opts *corev1.PodLogOptions) error {
 	// Create interrupt-aware context (Ctrl+C)
 	ctx, stop := signal.NotifyContext(kc.Ctx, os.Interrupt, syscall.SIGTERM)
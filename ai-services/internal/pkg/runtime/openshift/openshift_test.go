@@ -0,0 +1,94 @@
+package openshift
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+)
+
+func TestClassifyClientError(t *testing.T) {
+	csv := schema.GroupResource{Group: "operators.coreos.com", Resource: "clusterserviceversions"}
+
+	tests := []struct {
+		name           string
+		err            error
+		wantTransient  bool
+		wantRetryAfter time.Duration
+	}{
+		{name: "server timeout", err: apierrors.NewServerTimeout(csv, "list", 0), wantTransient: true},
+		{name: "timeout", err: apierrors.NewTimeoutError("request timed out", 0), wantTransient: true},
+		{
+			name:           "timeout with a suggested delay",
+			err:            apierrors.NewTimeoutError("request timed out", 3),
+			wantTransient:  true,
+			wantRetryAfter: 3 * time.Second,
+		},
+		{
+			name:           "too many requests",
+			err:            apierrors.NewTooManyRequests("the API server is throttling", 10),
+			wantTransient:  true,
+			wantRetryAfter: 10 * time.Second,
+		},
+		{name: "service unavailable", err: apierrors.NewServiceUnavailable("the API server is restarting"), wantTransient: true},
+		{name: "internal error", err: apierrors.NewInternalError(errors.New("etcd leader changed")), wantTransient: true},
+		{name: "unauthorized", err: apierrors.NewUnauthorized("token expired"), wantTransient: true},
+		{name: "network timeout", err: &net.DNSError{Err: "i/o timeout", Name: "api.example.com", IsTimeout: true}, wantTransient: true},
+		{name: "connection refused", err: fmt.Errorf("dial tcp: %w", syscall.ECONNREFUSED), wantTransient: true},
+		{name: "connection closed", err: fmt.Errorf("failed to read the response: %w", io.ErrUnexpectedEOF), wantTransient: true},
+		{name: "conflict", err: apierrors.NewConflict(csv, "nfd.v4.18.0", errors.New("the object has been modified"))},
+		{name: "not found", err: apierrors.NewNotFound(csv, "nfd.v4.18.0")},
+		{name: "forbidden", err: apierrors.NewForbidden(csv, "nfd.v4.18.0", errors.New("missing permissions"))},
+		{name: "invalid kubeconfig", err: errors.New("failed to build config from kubeconfig")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransientError(tt.err); got != tt.wantTransient {
+				t.Errorf("isTransientError() = %v, want %v", got, tt.wantTransient)
+			}
+
+			err := classifyClientError(tt.err)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("classifyClientError() = %v, want it to wrap %v", err, tt.err)
+			}
+			var permanent *utils.PermanentError
+			if errors.As(err, &permanent) == tt.wantTransient {
+				t.Errorf("classifyClientError() = %#v, want permanent %v", err, !tt.wantTransient)
+			}
+			after, ok := utils.RetryAfterOf(err)
+			if after != tt.wantRetryAfter || ok != (tt.wantRetryAfter > 0) {
+				t.Errorf("classifyClientError() retry after = %s, %v, want %s", after, ok, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestSinceSeconds(t *testing.T) {
+	tests := []struct {
+		since time.Duration
+		want  int64
+	}{
+		{since: 30 * time.Second, want: 30},
+		{since: 90 * time.Minute, want: 5400},
+		{since: 1500 * time.Millisecond, want: 2},
+		{since: time.Nanosecond, want: 1},
+	}
+	for _, tt := range tests {
+		if got := sinceSeconds(tt.since); got == nil || *got != tt.want {
+			t.Errorf("sinceSeconds(%s) = %v, want %d", tt.since, got, tt.want)
+		}
+	}
+
+	if got := sinceSeconds(0); got != nil {
+		t.Errorf("sinceSeconds(0) = %d, want the logs not limited", *got)
+	}
+}
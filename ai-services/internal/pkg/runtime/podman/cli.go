@@ -10,6 +10,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
 )
 
 const (
@@ -22,6 +23,7 @@ var (
 )
 
 func RunPodmanKubePlay(body io.Reader, opts map[string]string) ([]types.Pod, error) {
+	defer trace.Start("podman kube play")()
 	cmdName := "podman"
 
 	cmd := exec.Command(cmdName, buildCmdArgs(opts)...)
@@ -65,6 +67,47 @@ func RunPodmanKubePlay(body io.Reader, opts map[string]string) ([]types.Pod, err
 	return result, nil
 }
 
+// Mode is the privilege mode podman runs the containers of the current user with.
+type Mode string
+
+const (
+	ModeRootful  Mode = "rootful"
+	ModeRootless Mode = "rootless"
+)
+
+// DetectMode reports whether podman is configured rootless for the current user.
+// The podman CLI is queried rather than the API socket, as the pods get deployed with the CLI.
+func DetectMode() (Mode, error) {
+	out, err := exec.Command("podman", "info", "--format", "{{.Host.Security.Rootless}}").CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to execute podman info: %w. Output: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	switch strings.TrimSpace(string(out)) {
+	case "true":
+		return ModeRootless, nil
+	case "false":
+		return ModeRootful, nil
+	default:
+		return "", fmt.Errorf("unexpected podman info output: %s", strings.TrimSpace(string(out)))
+	}
+}
+
+// GenerateKube returns the kube YAML describing the current run spec of the given pod.
+func GenerateKube(podNameOrID string) ([]byte, error) {
+	cmd := exec.Command("podman", "kube", "generate", podNameOrID)
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to execute podman kube generate: %w. StdErr: %v", err, stderr.String())
+	}
+
+	return stdout.Bytes(), nil
+}
+
 // Helper function to extract podIds from RunKubePlay stdout.
 func extractPodIDsFromOutput(output string) []string {
 	lines := strings.Split(output, "\n")
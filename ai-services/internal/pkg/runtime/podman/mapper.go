@@ -13,11 +13,12 @@ func toPodsList(input any) []types.Pod {
 		out := make([]types.Pod, 0, len(val))
 		for _, r := range val {
 			out = append(out, types.Pod{
-				ID:         r.Id,
-				Name:       r.Name,
-				Status:     r.Status,
-				Labels:     r.Labels,
-				Containers: toPodContainerList(r.Containers),
+				ID:               r.Id,
+				Name:             r.Name,
+				Status:           r.Status,
+				Labels:           r.Labels,
+				Containers:       toPodContainerList(r.Containers),
+				InfraContainerID: r.InfraId,
 			})
 		}
 
@@ -1,15 +1,18 @@
 package podman
 
 import (
+	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
 	"syscall"
 
+	"github.com/containers/podman/v5/pkg/api/handlers"
 	"github.com/containers/podman/v5/pkg/bindings"
 	"github.com/containers/podman/v5/pkg/bindings/containers"
 	"github.com/containers/podman/v5/pkg/bindings/images"
@@ -17,15 +20,26 @@ import (
 	"github.com/containers/podman/v5/pkg/bindings/pods"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/trace"
 	"github.com/project-ai-services/ai-services/internal/pkg/utils"
 )
 
+// insecureSkipTLSVerify disables the verification of the registry certificates while pulling images.
+var insecureSkipTLSVerify bool
+
+// SetInsecureSkipTLSVerify toggles the verification of the registry certificates while pulling images.
+func SetInsecureSkipTLSVerify(insecure bool) {
+	insecureSkipTLSVerify = insecure
+}
+
 type PodmanClient struct {
 	Context context.Context
 }
 
 // NewPodmanClient creates and returns a new PodmanClient instance.
 func NewPodmanClient() (*PodmanClient, error) {
+	defer trace.Start("podman client")()
+
 	// Default Podman socket URI is unix:///run/podman/podman.sock running on the local machine,
 	// but it can be overridden by the CONTAINER_HOST and CONTAINER_SSHKEY environment variable to support remote connections.
 	// Please use `podman system connection list` to see available connections.
@@ -56,8 +70,13 @@ func (pc *PodmanClient) ListImages() ([]types.Image, error) {
 }
 
 func (pc *PodmanClient) PullImage(image string) error {
+	defer trace.Start("pull image " + image)()
 	logger.Infof("Pulling image %s...\n", image)
-	_, err := images.Pull(pc.Context, image, nil)
+	var opts *images.PullOptions
+	if insecureSkipTLSVerify {
+		opts = new(images.PullOptions).WithSkipTLSVerify(true)
+	}
+	_, err := images.Pull(pc.Context, image, opts)
 	if err != nil {
 		return fmt.Errorf("failed to pull image %s: %w", image, err)
 	}
@@ -66,6 +85,47 @@ func (pc *PodmanClient) PullImage(image string) error {
 	return nil
 }
 
+// Build builds the image of the given build context tagged as tag, streaming the build output to the logger.
+// The build is run by the podman CLI, and is killed when ctx is cancelled.
+func (pc *PodmanClient) Build(ctx context.Context, contextDir, tag string) error {
+	logger.Infof("Building image %s...\n", tag)
+
+	cmd := exec.CommandContext(ctx, "podman", "build", "--tag", tag, contextDir)
+	pr, pw := io.Pipe()
+	cmd.Stdout = pw
+	cmd.Stderr = pw
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		streamLines(pr, tag)
+	}()
+
+	err := cmd.Run()
+	_ = pw.Close()
+	<-done
+
+	if ctx.Err() != nil {
+		return fmt.Errorf("build of image %s was cancelled: %w", tag, ctx.Err())
+	}
+	if err != nil {
+		return fmt.Errorf("failed to build image %s: %w", tag, err)
+	}
+	logger.Infof("Successfully built image %s\n", tag)
+
+	return nil
+}
+
+// streamLines logs each line read from r, prefixed by the given tag, until r is closed.
+func streamLines(r io.Reader, tag string) {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		logger.Infof("[build %s] %s\n", tag, scanner.Text())
+	}
+	// keep draining, so that the writer is not blocked by an overlong line
+	_, _ = io.Copy(io.Discard, r)
+}
+
 func (pc *PodmanClient) ListPods(filters map[string][]string) ([]types.Pod, error) {
 	var listOpts pods.ListOptions
 
@@ -82,6 +142,7 @@ func (pc *PodmanClient) ListPods(filters map[string][]string) ([]types.Pod, erro
 }
 
 func (pc *PodmanClient) CreatePod(body io.Reader) ([]types.Pod, error) {
+	defer trace.Start("podman kube play")()
 	kubeReport, err := kube.PlayWithBody(pc.Context, body, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to execute podman kube play: %w", err)
@@ -92,6 +153,9 @@ func (pc *PodmanClient) CreatePod(body io.Reader) ([]types.Pod, error) {
 
 func (pc *PodmanClient) DeletePod(id string, force *bool) error {
 	_, err := pods.Remove(pc.Context, id, &pods.RemoveOptions{Force: force})
+	if isNotFound(err) {
+		return fmt.Errorf("failed to delete the pod: %w: %w", types.ErrNotFound, err)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to delete the pod: %w", err)
 	}
@@ -174,7 +238,7 @@ func (pc *PodmanClient) InspectPod(nameOrID string) (*types.Pod, error) {
 	return toPodInspectReport(podInspectReport), nil
 }
 
-func (pc *PodmanClient) PodLogs(podNameOrID string) error {
+func (pc *PodmanClient) PodLogs(podNameOrID string, logsOpts types.LogsOptions) error {
 	if podNameOrID == "" {
 		return errors.New("pod name or ID cannot be empty")
 	}
@@ -184,7 +248,11 @@ func (pc *PodmanClient) PodLogs(podNameOrID string) error {
 
 	//nolint:godox
 	// TODO: fetch pods logs via sdk way
-	cmdExec := exec.CommandContext(pc.Context, "podman", "pod", "logs", "-f", podNameOrID)
+	args := []string{"pod", "logs", "-f"}
+	if logsOpts.Since > 0 {
+		args = append(args, "--since", logsOpts.Since.String())
+	}
+	cmdExec := exec.CommandContext(pc.Context, "podman", append(args, podNameOrID)...)
 	cmdExec.Stdout = os.Stdout
 	cmdExec.Stderr = os.Stderr
 
@@ -202,7 +270,7 @@ func (pc *PodmanClient) PodExists(nameOrID string) (bool, error) {
 	return pods.Exists(pc.Context, nameOrID, nil)
 }
 
-func (pc *PodmanClient) ContainerLogs(containerNameOrID string) error {
+func (pc *PodmanClient) ContainerLogs(containerNameOrID string, logsOpts types.LogsOptions) error {
 	if containerNameOrID == "" {
 		return fmt.Errorf("container name or ID required to fetch logs")
 	}
@@ -219,6 +287,10 @@ func (pc *PodmanClient) ContainerLogs(containerNameOrID string) error {
 		Stderr: utils.BoolPtr(true),
 		Stdout: utils.BoolPtr(true),
 	}
+	if logsOpts.Since > 0 {
+		since := logsOpts.Since.String()
+		opts.Since = &since
+	}
 
 	// Channel to signal goroutine completion
 	done := make(chan struct{})
@@ -256,6 +328,48 @@ func (pc *PodmanClient) ContainerExists(nameOrID string) (bool, error) {
 	return containers.Exists(pc.Context, nameOrID, nil)
 }
 
+// ExecContainer runs a command inside the container, streaming its standard input and outputs.
+// The container names are unique across the pods in podman, the pod is not needed to select it.
+func (pc *PodmanClient) ExecContainer(podNameOrID, containerName string, opts types.ExecOptions) error {
+	if containerName == "" {
+		return errors.New("container name or ID required to exec into")
+	}
+
+	config := new(handlers.ExecCreateConfig)
+	config.Cmd = opts.Command
+	config.Tty = opts.TTY
+	config.AttachStdin = opts.Stdin != nil
+	config.AttachStdout = true
+	config.AttachStderr = true
+
+	sessionID, err := containers.ExecCreate(pc.Context, containerName, config)
+	if err != nil {
+		return fmt.Errorf("failed to create the exec session: %w", err)
+	}
+
+	attachOpts := new(containers.ExecStartAndAttachOptions).
+		WithOutputStream(opts.Stdout).
+		WithErrorStream(opts.Stderr).
+		WithAttachOutput(true).
+		WithAttachError(true)
+	if opts.Stdin != nil {
+		attachOpts.WithInputStream(*bufio.NewReader(opts.Stdin)).WithAttachInput(true)
+	}
+	if err := containers.ExecStartAndAttach(pc.Context, sessionID, attachOpts); err != nil {
+		return fmt.Errorf("failed to start the exec session: %w", err)
+	}
+
+	session, err := containers.ExecInspect(pc.Context, sessionID, nil)
+	if err != nil {
+		return fmt.Errorf("failed to inspect the exec session: %w", err)
+	}
+	if session.ExitCode != 0 {
+		return fmt.Errorf("command exited with code %d", session.ExitCode)
+	}
+
+	return nil
+}
+
 func (pc *PodmanClient) ListRoutes() ([]types.Route, error) {
 	logger.Errorf("unsupported method called!")
 
@@ -272,3 +386,10 @@ func (pc *PodmanClient) DeletePVCs(appLabel string) error {
 func (pc *PodmanClient) Type() types.RuntimeType {
 	return types.RuntimeTypePodman
 }
+
+// isNotFound tells whether the error of the podman API reports a missing resource.
+func isNotFound(err error) bool {
+	var apiErr interface{ Code() int }
+
+	return errors.As(err, &apiErr) && apiErr.Code() == http.StatusNotFound
+}
@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
@@ -23,7 +24,35 @@ func NewRuntimeFactory(runtimeType types.RuntimeType) *RuntimeFactory {
 
 // Create creates a runtime instance based on the factory configuration.
 func (f *RuntimeFactory) Create(namespace string) (Runtime, error) {
-	return CreateRuntime(f.runtimeType, namespace)
+	creatorMu.RLock()
+	create := creator
+	creatorMu.RUnlock()
+
+	return create(f.runtimeType, namespace)
+}
+
+// Creator creates the runtime of the given type in the namespace.
+type Creator func(runtimeType types.RuntimeType, namespace string) (Runtime, error)
+
+var (
+	// creatorMu guards the creator of the runtimes of the factories, CreateRuntime unless substituted.
+	creatorMu sync.RWMutex
+	creator   Creator = CreateRuntime
+)
+
+// SetCreator substitutes how the factories create the runtimes, Eg:- with a fake runtime in the tests of the commands,
+// and returns the function restoring the previous creator.
+func SetCreator(c Creator) (restore func()) {
+	creatorMu.Lock()
+	defer creatorMu.Unlock()
+	previous := creator
+	creator = c
+
+	return func() {
+		creatorMu.Lock()
+		defer creatorMu.Unlock()
+		creator = previous
+	}
 }
 
 // GetRuntimeType returns the configured runtime type.
@@ -31,6 +60,13 @@ func (f *RuntimeFactory) GetRuntimeType() types.RuntimeType {
 	return f.runtimeType
 }
 
+// SetInsecureSkipTLSVerify disables the TLS certificate verification of the API server and image registries
+// for all the runtimes.
+func SetInsecureSkipTLSVerify(insecure bool) {
+	openshift.SetInsecureSkipTLSVerify(insecure)
+	podman.SetInsecureSkipTLSVerify(insecure)
+}
+
 // CreateRuntime creates a runtime instance based on the specified type.
 func CreateRuntime(runtimeType types.RuntimeType, namespace string) (Runtime, error) {
 	switch runtimeType {
@@ -0,0 +1,64 @@
+package selector
+
+import (
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// labelFilterKey is the key of the label filters given to runtime.ListPods.
+const labelFilterKey = "label"
+
+// Selector selects the resources of the AI Services applications by their labels.
+// An empty value matches any value of the label, Eg:- an empty Selector matches the pods of all the applications.
+type Selector struct {
+	Application string
+	Template    string
+	Version     string
+	// fromTemplate requires the template label even when any template matches.
+	fromTemplate bool
+}
+
+// NewAIServicesSelector returns a selector of the applications deployed from the given template and version,
+// Eg:- NewAIServicesSelector("", "") selects the applications deployed from any template.
+func NewAIServicesSelector(template, version string) Selector {
+	return Selector{Template: template, Version: version, fromTemplate: true}
+}
+
+// ForApplication returns a selector of the resources of the given application.
+func ForApplication(name string) Selector {
+	return Selector{Application: name}
+}
+
+// requirements returns the label requirements in the "key=value" format, or just "key" when any value matches.
+// The application label is always required, so that the resources which are not part of an application are left out.
+func (s Selector) requirements() []string {
+	reqs := []string{requirement(vars.ApplicationLabel, s.Application)}
+	if s.Template != "" || s.fromTemplate {
+		reqs = append(reqs, requirement(vars.TemplateLabel, s.Template))
+	}
+	if s.Version != "" {
+		reqs = append(reqs, requirement(vars.VersionLabel, s.Version))
+	}
+
+	return reqs
+}
+
+func requirement(label vars.Label, value string) string {
+	if value == "" {
+		return string(label)
+	}
+
+	return string(label) + "=" + value
+}
+
+// Filters returns the selector as the filters of runtime.ListPods, understood by both the podman and openshift runtimes.
+func (s Selector) Filters() map[string][]string {
+	return map[string][]string{labelFilterKey: s.requirements()}
+}
+
+// String returns the selector in the kubernetes label selector format.
+// Eg:- "ai-services.io/application=rag,ai-services.io/template=rag".
+func (s Selector) String() string {
+	return strings.Join(s.requirements(), ",")
+}
@@ -0,0 +1,61 @@
+package selector
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSelector(t *testing.T) {
+	tests := []struct {
+		name        string
+		selector    Selector
+		wantFilters map[string][]string
+		wantString  string
+	}{
+		{
+			name:        "any application",
+			selector:    Selector{},
+			wantFilters: map[string][]string{"label": {"ai-services.io/application"}},
+			wantString:  "ai-services.io/application",
+		},
+		{
+			name:        "application",
+			selector:    ForApplication("rag"),
+			wantFilters: map[string][]string{"label": {"ai-services.io/application=rag"}},
+			wantString:  "ai-services.io/application=rag",
+		},
+		{
+			name:     "template and version",
+			selector: NewAIServicesSelector("rag", "1.0.0"),
+			wantFilters: map[string][]string{"label": {
+				"ai-services.io/application", "ai-services.io/template=rag", "ai-services.io/version=1.0.0",
+			}},
+			wantString: "ai-services.io/application,ai-services.io/template=rag,ai-services.io/version=1.0.0",
+		},
+		{
+			name:        "any template",
+			selector:    NewAIServicesSelector("", ""),
+			wantFilters: map[string][]string{"label": {"ai-services.io/application", "ai-services.io/template"}},
+			wantString:  "ai-services.io/application,ai-services.io/template",
+		},
+		{
+			name:     "template of an application",
+			selector: Selector{Application: "prod", Template: "rag"},
+			wantFilters: map[string][]string{"label": {
+				"ai-services.io/application=prod", "ai-services.io/template=rag",
+			}},
+			wantString: "ai-services.io/application=prod,ai-services.io/template=rag",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.selector.Filters(); !reflect.DeepEqual(got, tt.wantFilters) {
+				t.Errorf("Filters() = %v, want %v", got, tt.wantFilters)
+			}
+			if got := tt.selector.String(); got != tt.wantString {
+				t.Errorf("String() = %q, want %q", got, tt.wantString)
+			}
+		})
+	}
+}
@@ -1,6 +1,13 @@
 package types
 
-import "time"
+import (
+	"errors"
+	"io"
+	"time"
+)
+
+// ErrNotFound is matched with errors.Is by the errors of the runtimes on a resource which does not exist, Eg:- a pod already deleted.
+var ErrNotFound = errors.New("not found")
 
 // RuntimeType represents the type of container runtime.
 type RuntimeType string
@@ -56,3 +63,20 @@ type Route struct {
 	HostPort   string
 	TargetPort string
 }
+
+// LogsOptions are the options of the logs followed from a pod or a container.
+type LogsOptions struct {
+	// Since limits the logs to the lines newer than the duration, all of them when zero.
+	Since time.Duration
+}
+
+// ExecOptions are the options of a command run inside a container.
+type ExecOptions struct {
+	Command []string
+	// Stdin is attached to the command, none when nil.
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// TTY allocates a terminal for the command, for an interactive session.
+	TTY bool
+}
@@ -0,0 +1,184 @@
+package specs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	apiyaml "k8s.io/apimachinery/pkg/util/yaml"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+const metadataDecoderBufSz = 4096
+
+// Metadata are the custom labels and annotations set on the deployed resources, on top of the ones of the template.
+type Metadata struct {
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// Empty reports whether there is no custom label nor annotation to set.
+func (m Metadata) Empty() bool {
+	return len(m.Labels) == 0 && len(m.Annotations) == 0
+}
+
+// ParseLabels parses the key=value pairs of the custom labels, validated as kubernetes label keys and values.
+func ParseLabels(pairs []string) (map[string]string, error) {
+	return parseMetadata("label", pairs, func(value string) []string {
+		return validation.IsValidLabelValue(value)
+	})
+}
+
+// ParseAnnotations parses the key=value pairs of the custom annotations, validated as kubernetes annotation keys.
+func ParseAnnotations(pairs []string) (map[string]string, error) {
+	return parseMetadata("annotation", pairs, nil)
+}
+
+func parseMetadata(kind string, pairs []string, validateValue func(string) []string) (map[string]string, error) {
+	out := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid %s '%s' (expected key=value)", kind, pair)
+		}
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid %s key '%s': %s", kind, key, strings.Join(errs, "; "))
+		}
+		if IsReservedKey(key) {
+			return nil, fmt.Errorf("%s key '%s' is in the reserved %s/ namespace, managed by AI Services", kind, key, constants.ReservedKeyDomain)
+		}
+		if validateValue != nil {
+			if errs := validateValue(value); len(errs) > 0 {
+				return nil, fmt.Errorf("invalid %s value '%s' of '%s': %s", kind, value, key, strings.Join(errs, "; "))
+			}
+		}
+		if prev, ok := out[key]; ok && prev != value {
+			return nil, fmt.Errorf("%s '%s' is set more than once", kind, key)
+		}
+		out[key] = value
+	}
+
+	return out, nil
+}
+
+// IsReservedKey reports whether the label or annotation key is in the namespace managed by AI Services,
+// Eg:- "ai-services.io/application" or "runtime.ai-services.io/start".
+func IsReservedKey(key string) bool {
+	prefix, _, ok := strings.Cut(key, "/")
+	if !ok {
+		return false
+	}
+
+	return prefix == constants.ReservedKeyDomain || strings.HasSuffix(prefix, "."+constants.ReservedKeyDomain)
+}
+
+// Apply sets the custom labels and annotations on each resource of the multi document manifest,
+// along with the pod templates of the workloads. The labels and annotations set by the template are kept.
+func (m Metadata) Apply(manifest []byte) ([]byte, error) {
+	if m.Empty() {
+		return manifest, nil
+	}
+
+	var out bytes.Buffer
+	decoder := apiyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), metadataDecoderBufSz)
+	for i := 1; ; i++ {
+		var obj map[string]any
+		err := decoder.Decode(&obj)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("document %d: failed to parse: %w", i, err)
+		}
+		// empty documents are skipped
+		if len(obj) == 0 {
+			continue
+		}
+
+		name := resourceName(obj)
+		m.applyTo(obj, name)
+		if template, ok := nestedMap(obj, "spec", "template"); ok {
+			m.applyTo(template, name+" pod template")
+		}
+
+		data, err := k8syaml.Marshal(obj)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: failed to marshal: %w", i, err)
+		}
+		out.WriteString("---\n")
+		out.Write(data)
+	}
+
+	return out.Bytes(), nil
+}
+
+func (m Metadata) applyTo(obj map[string]any, name string) {
+	meta, ok := obj["metadata"].(map[string]any)
+	if !ok {
+		meta = map[string]any{}
+		obj["metadata"] = meta
+	}
+	mergeInto(meta, "labels", "label", m.Labels, name)
+	mergeInto(meta, "annotations", "annotation", m.Annotations, name)
+}
+
+// mergeInto adds the values to the given field of the metadata, leaving out the keys it already sets.
+func mergeInto(meta map[string]any, field, kind string, values map[string]string, name string) {
+	if len(values) == 0 {
+		return
+	}
+
+	existing, ok := meta[field].(map[string]any)
+	if !ok {
+		existing = map[string]any{}
+		meta[field] = existing
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if current, ok := existing[key]; ok {
+			if current != values[key] {
+				logger.Warningf("%s: keeping the %s '%s=%v' set by the template, in place of '%s'\n", name, kind, key, current, values[key])
+			}
+
+			continue
+		}
+		existing[key] = values[key]
+	}
+}
+
+// resourceName returns the kind and name of the resource, Eg:- "Deployment/rag-backend".
+func resourceName(obj map[string]any) string {
+	kind, _ := obj["kind"].(string)
+	name := ""
+	if meta, ok := obj["metadata"].(map[string]any); ok {
+		name, _ = meta["name"].(string)
+	}
+
+	return kind + "/" + name
+}
+
+func nestedMap(obj map[string]any, fields ...string) (map[string]any, bool) {
+	current := obj
+	for _, field := range fields {
+		next, ok := current[field].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+
+	return current, true
+}
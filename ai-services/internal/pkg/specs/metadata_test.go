@@ -0,0 +1,157 @@
+package specs
+
+import (
+	"maps"
+	"strings"
+	"testing"
+
+	k8syaml "sigs.k8s.io/yaml"
+)
+
+func TestParseLabels(t *testing.T) {
+	tests := []struct {
+		name    string
+		pairs   []string
+		want    map[string]string
+		wantErr string
+	}{
+		{name: "no labels", want: map[string]string{}},
+		{
+			name:  "plain and prefixed keys",
+			pairs: []string{"cost-center=1234", "example.com/team=search", "empty="},
+			want:  map[string]string{"cost-center": "1234", "example.com/team": "search", "empty": ""},
+		},
+		{name: "missing value", pairs: []string{"cost-center"}, wantErr: "expected key=value"},
+		{name: "invalid key", pairs: []string{"cost center=1234"}, wantErr: "invalid label key"},
+		{name: "invalid value", pairs: []string{"team=search team"}, wantErr: "invalid label value"},
+		{name: "reserved namespace", pairs: []string{"ai-services.io/template=rag"}, wantErr: "reserved ai-services.io/ namespace"},
+		{name: "reserved subdomain", pairs: []string{"runtime.ai-services.io/start=off"}, wantErr: "reserved ai-services.io/ namespace"},
+		{name: "not the reserved domain", pairs: []string{"my-ai-services.io/team=search"}, want: map[string]string{"my-ai-services.io/team": "search"}},
+		{name: "set twice", pairs: []string{"team=search", "team=ingest"}, wantErr: "set more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseLabels(tt.pairs)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseLabels() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseLabels() unexpected error: %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("ParseLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAnnotations(t *testing.T) {
+	// annotation values are not restricted the way label values are
+	got, err := ParseAnnotations([]string{"example.com/owner=Search Team <search@example.com>"})
+	if err != nil {
+		t.Fatalf("ParseAnnotations() unexpected error: %v", err)
+	}
+	if want := "Search Team <search@example.com>"; got["example.com/owner"] != want {
+		t.Errorf("ParseAnnotations() = %v, want the owner %q", got, want)
+	}
+
+	if _, err := ParseAnnotations([]string{"ai-services.io/start=off"}); err == nil {
+		t.Error("ParseAnnotations() accepted a key of the reserved namespace")
+	}
+}
+
+func TestMetadataApply(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: Service
+metadata:
+  name: backend
+  labels:
+    app: backend
+spec:
+  selector:
+    app: backend
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: backend
+  annotations:
+    team: ingest
+spec:
+  template:
+    metadata:
+      labels:
+        app: backend
+    spec:
+      containers:
+        - name: backend
+          image: icr.io/ai-services/backend:0.1
+`
+	m := Metadata{
+		Labels:      map[string]string{"cost-center": "1234", "app": "other"},
+		Annotations: map[string]string{"team": "search"},
+	}
+
+	out, err := m.Apply([]byte(manifest))
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+
+	docs := strings.Split(strings.TrimPrefix(string(out), "---\n"), "\n---\n")
+	if len(docs) != 2 {
+		t.Fatalf("Apply() returned %d documents, want 2:\n%s", len(docs), out)
+	}
+
+	type objectMeta struct {
+		Labels      map[string]string `json:"labels"`
+		Annotations map[string]string `json:"annotations"`
+	}
+	var resources [2]struct {
+		Metadata objectMeta `json:"metadata"`
+		Spec     struct {
+			Selector map[string]string `json:"selector"`
+			Template struct {
+				Metadata objectMeta `json:"metadata"`
+			} `json:"template"`
+		} `json:"spec"`
+	}
+	for i, doc := range docs {
+		if err := k8syaml.Unmarshal([]byte(doc), &resources[i]); err != nil {
+			t.Fatalf("document %d: %v", i+1, err)
+		}
+	}
+
+	service, deployment := resources[0], resources[1]
+	if want := map[string]string{"app": "backend", "cost-center": "1234"}; !maps.Equal(service.Metadata.Labels, want) {
+		t.Errorf("service labels = %v, want %v", service.Metadata.Labels, want)
+	}
+	if want := map[string]string{"app": "backend"}; !maps.Equal(service.Spec.Selector, want) {
+		t.Errorf("service selector = %v, want it untouched %v", service.Spec.Selector, want)
+	}
+	if want := map[string]string{"team": "search"}; !maps.Equal(service.Metadata.Annotations, want) {
+		t.Errorf("service annotations = %v, want %v", service.Metadata.Annotations, want)
+	}
+	if want := map[string]string{"team": "ingest"}; !maps.Equal(deployment.Metadata.Annotations, want) {
+		t.Errorf("deployment annotations = %v, want the template ones kept %v", deployment.Metadata.Annotations, want)
+	}
+	if want := map[string]string{"app": "backend", "cost-center": "1234"}; !maps.Equal(deployment.Spec.Template.Metadata.Labels, want) {
+		t.Errorf("pod template labels = %v, want %v", deployment.Spec.Template.Metadata.Labels, want)
+	}
+}
+
+func TestMetadataApplyEmpty(t *testing.T) {
+	manifest := []byte("kind: Pod\nmetadata:\n  name: rag--vllm-server\n")
+
+	out, err := Metadata{}.Apply(manifest)
+	if err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	if string(out) != string(manifest) {
+		t.Errorf("Apply() = %q, want the manifest untouched", out)
+	}
+}
@@ -1,6 +1,15 @@
 package specs
 
-import "github.com/project-ai-services/ai-services/internal/pkg/models"
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
 
 func FetchPodAnnotations(podspec models.PodSpec) map[string]string {
 	return podspec.Annotations
@@ -14,3 +23,88 @@ func FetchContainerNames(podspec models.PodSpec) []string {
 
 	return containerNames
 }
+
+// ValidateSpyreCardAnnotations verifies that every spyre cards annotation of the pod
+// refers to a container that actually exists in the pod spec.
+func ValidateSpyreCardAnnotations(podspec models.PodSpec) error {
+	containerNames := FetchContainerNames(podspec)
+
+	var mismatched []string
+	for annotation := range podspec.Annotations {
+		matches := vars.SpyreCardAnnotationRegex.FindStringSubmatch(annotation)
+		if matches == nil {
+			continue
+		}
+		if !slices.Contains(containerNames, matches[1]) {
+			mismatched = append(mismatched, fmt.Sprintf("'%s' (annotation '%s')", matches[1], annotation))
+		}
+	}
+
+	if len(mismatched) > 0 {
+		sort.Strings(mismatched)
+
+		return fmt.Errorf("spyre cards annotation refers to container(s) not found in pod '%s': %s; available containers: %s",
+			podspec.Name, strings.Join(mismatched, ", "), strings.Join(containerNames, ", "))
+	}
+
+	return nil
+}
+
+// SpyreAllocation is the number of spyre cards allocated to a container of a pod.
+type SpyreAllocation struct {
+	Container string
+	Cards     int
+}
+
+// SpyreAllocationPlan returns the spyre cards allocated to every container of the pod in the order of the containers,
+// from the spyre cards annotations of the pod, which must refer to its containers and hold a non-negative count.
+func SpyreAllocationPlan(podspec models.PodSpec) ([]SpyreAllocation, error) {
+	if err := ValidateSpyreCardAnnotations(podspec); err != nil {
+		return nil, err
+	}
+
+	cards := map[string]int{}
+	for annotation, value := range podspec.Annotations {
+		matches := vars.SpyreCardAnnotationRegex.FindStringSubmatch(annotation)
+		if matches == nil {
+			continue
+		}
+		count, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("invalid spyre cards count '%s' of container '%s' in pod '%s', must be a non-negative integer", value, matches[1], podspec.Name)
+		}
+		cards[matches[1]] = count
+	}
+
+	containerNames := FetchContainerNames(podspec)
+	plan := make([]SpyreAllocation, 0, len(containerNames))
+	for _, name := range containerNames {
+		plan = append(plan, SpyreAllocation{Container: name, Cards: cards[name]})
+	}
+
+	return plan, nil
+}
+
+// TotalSpyreCards returns the number of spyre cards allocated to all the containers of the plan.
+func TotalSpyreCards(plan []SpyreAllocation) int {
+	total := 0
+	for _, allocation := range plan {
+		total += allocation.Cards
+	}
+
+	return total
+}
+
+// FormatSpyreAllocationPlan renders the spyre cards of every container of the plan, Eg:- "infer: 2 cards, sidecar: 0 cards".
+func FormatSpyreAllocationPlan(plan []SpyreAllocation) string {
+	parts := make([]string, 0, len(plan))
+	for _, allocation := range plan {
+		unit := "cards"
+		if allocation.Cards == 1 {
+			unit = "card"
+		}
+		parts = append(parts, fmt.Sprintf("%s: %d %s", allocation.Container, allocation.Cards, unit))
+	}
+
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,147 @@
+package specs
+
+import (
+	"testing"
+
+	v1 "github.com/containers/podman/v5/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v5/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/models"
+)
+
+func newPodSpec(annotations map[string]string, containers ...string) models.PodSpec {
+	ps := models.PodSpec{}
+	ps.ObjectMeta = metav1.ObjectMeta{Name: "test-pod", Annotations: annotations}
+	for _, c := range containers {
+		ps.Spec.Containers = append(ps.Spec.Containers, v1.Container{Name: c})
+	}
+
+	return ps
+}
+
+func TestValidateSpyreCardAnnotations(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		containers  []string
+		wantErr     bool
+	}{
+		{
+			name:        "matched container",
+			annotations: map[string]string{"ai-services.io/instruct--spyre-cards": "4"},
+			containers:  []string{"instruct", "reranker"},
+		},
+		{
+			name: "multiple matched containers",
+			annotations: map[string]string{
+				"ai-services.io/instruct--spyre-cards": "4",
+				"ai-services.io/reranker--spyre-cards": "1",
+			},
+			containers: []string{"instruct", "reranker"},
+		},
+		{
+			name:        "no spyre annotations",
+			annotations: map[string]string{"ai-services.io/other": "value"},
+			containers:  []string{"instruct"},
+		},
+		{
+			name:        "mismatched container",
+			annotations: map[string]string{"ai-services.io/instrcut--spyre-cards": "4"},
+			containers:  []string{"instruct"},
+			wantErr:     true,
+		},
+		{
+			name: "one of many mismatched",
+			annotations: map[string]string{
+				"ai-services.io/instruct--spyre-cards": "4",
+				"ai-services.io/rerank--spyre-cards":   "1",
+			},
+			containers: []string{"instruct", "reranker"},
+			wantErr:    true,
+		},
+		{
+			name:        "pod without containers",
+			annotations: map[string]string{"ai-services.io/instruct--spyre-cards": "1"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSpyreCardAnnotations(newPodSpec(tt.annotations, tt.containers...))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSpyreCardAnnotations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSpyreAllocationPlan(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		containers  []string
+		want        string
+		wantTotal   int
+		wantErr     bool
+	}{
+		{
+			name:        "mixed workload",
+			annotations: map[string]string{"ai-services.io/infer--spyre-cards": "2"},
+			containers:  []string{"infer", "sidecar"},
+			want:        "infer: 2 cards, sidecar: 0 cards",
+			wantTotal:   2,
+		},
+		{
+			name: "every container",
+			annotations: map[string]string{
+				"ai-services.io/instruct--spyre-cards": "4",
+				"ai-services.io/reranker--spyre-cards": "1",
+			},
+			containers: []string{"instruct", "reranker"},
+			want:       "instruct: 4 cards, reranker: 1 card",
+			wantTotal:  5,
+		},
+		{
+			name:       "no spyre annotations",
+			containers: []string{"ui"},
+			want:       "ui: 0 cards",
+		},
+		{
+			name:        "missing container",
+			annotations: map[string]string{"ai-services.io/infer--spyre-cards": "2"},
+			containers:  []string{"sidecar"},
+			wantErr:     true,
+		},
+		{
+			name:        "invalid count",
+			annotations: map[string]string{"ai-services.io/infer--spyre-cards": "two"},
+			containers:  []string{"infer"},
+			wantErr:     true,
+		},
+		{
+			name:        "negative count",
+			annotations: map[string]string{"ai-services.io/infer--spyre-cards": "-1"},
+			containers:  []string{"infer"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := SpyreAllocationPlan(newPodSpec(tt.annotations, tt.containers...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SpyreAllocationPlan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := FormatSpyreAllocationPlan(plan); got != tt.want {
+				t.Errorf("FormatSpyreAllocationPlan() = %q, want %q", got, tt.want)
+			}
+			if got := TotalSpyreCards(plan); got != tt.wantTotal {
+				t.Errorf("TotalSpyreCards() = %d, want %d", got, tt.wantTotal)
+			}
+		})
+	}
+}
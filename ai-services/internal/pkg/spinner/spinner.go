@@ -3,10 +3,13 @@ package spinner
 import (
 	"context"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/yarlson/pin"
 )
 
+const warnSymbol = "⚠"
+
 type Spinner struct {
 	p      *pin.Pin
 	ctx    context.Context
@@ -14,11 +17,12 @@ type Spinner struct {
 }
 
 func New(message string) *Spinner {
+	doneColor, failColor := symbolColors()
 	p := pin.New(message,
 		pin.WithDoneSymbol('✔'),
-		pin.WithDoneSymbolColor(pin.ColorGreen),
+		pin.WithDoneSymbolColor(doneColor),
 		pin.WithFailSymbol('✖'),
-		pin.WithFailSymbolColor(pin.ColorRed),
+		pin.WithFailSymbolColor(failColor),
 	)
 
 	return &Spinner{
@@ -26,12 +30,29 @@ func New(message string) *Spinner {
 	}
 }
 
+// symbolColors returns the colors of the done and fail symbols for the selected theme,
+// the spinner only supporting the basic terminal colors.
+func symbolColors() (pin.Color, pin.Color) {
+	if style.Current() == style.ThemeMonochrome {
+		return pin.ColorDefault, pin.ColorDefault
+	}
+
+	return pin.ColorGreen, pin.ColorRed
+}
+
 func (s *Spinner) Start(ctx context.Context) {
+	// spinner and its checkmarks are not rendered in quiet mode
+	if logger.IsQuiet() {
+		return
+	}
 	s.ctx = ctx
 	s.cancel = s.p.Start(ctx)
 }
 
 func (s *Spinner) Stop(message string) {
+	if logger.IsQuiet() {
+		return
+	}
 	if s.cancel != nil {
 		s.cancel()
 	}
@@ -39,6 +60,9 @@ func (s *Spinner) Stop(message string) {
 }
 
 func (s *Spinner) Fail(message string) {
+	if logger.IsQuiet() {
+		return
+	}
 	if s.cancel != nil {
 		s.cancel()
 	}
@@ -46,10 +70,36 @@ func (s *Spinner) Fail(message string) {
 }
 
 func (s *Spinner) UpdateMessage(message string) {
+	if logger.IsQuiet() {
+		return
+	}
 	s.p.UpdateMessage(message)
 }
 
 func (s *Spinner) StopWithHint(msg, hint string) {
 	s.Fail(msg)
-	logger.Infof("HINT: %s\n", hint)
+	logger.Infof("%s %s\n", style.Hint().Render("HINT:"), hint)
+}
+
+// Warn stops the spinner, reporting a condition which is concerning but not a failure.
+func (s *Spinner) Warn(message string) {
+	if logger.IsQuiet() {
+		return
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.p.Stop()
+	logger.Infoln(style.Warn().Render(warnSymbol) + " " + message)
+}
+
+// Clear stops the spinner without reporting an outcome, Eg:- when the outcomes are reported separately.
+func (s *Spinner) Clear() {
+	if logger.IsQuiet() {
+		return
+	}
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.p.Stop()
 }
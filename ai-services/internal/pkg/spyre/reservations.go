@@ -0,0 +1,124 @@
+package spyre
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// DefaultReservationsPath is the state file recording the spyre cards reserved by each application of the host.
+	DefaultReservationsPath = "/var/lib/ai-services/spyre-reservations.json"
+
+	dirPerm  = 0o755
+	filePerm = 0o644
+)
+
+// Reservations maps each deployed application to the PCI addresses of the spyre cards allocated to it,
+// so that a card already assigned to an application, Eg:- one whose pods are stopped, is not given to another one.
+// The operations updating it are serialized by the lock of the host, see the lock package.
+type Reservations map[string][]string
+
+// LoadReservations reads the reservations from the state file at path, none when it does not exist yet.
+func LoadReservations(path string) (Reservations, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Reservations{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the spyre cards reservations: %w", err)
+	}
+
+	r := Reservations{}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to parse the spyre cards reservations '%s': %w", path, err)
+	}
+
+	return r, nil
+}
+
+// Save writes the reservations to the state file at path, replacing it at once so that it is never left half written.
+func (r Reservations) Save(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return fmt.Errorf("failed to create the spyre cards reservations directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode the spyre cards reservations: %w", err)
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, filePerm); err != nil {
+		return fmt.Errorf("failed to write the spyre cards reservations: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write the spyre cards reservations: %w", err)
+	}
+
+	return nil
+}
+
+// Free returns the cards not reserved by any application other than app, in their given order.
+func (r Reservations) Free(cards []string, app string) []string {
+	reserved := map[string]bool{}
+	for holder, held := range r {
+		if holder == app {
+			continue
+		}
+		for _, card := range held {
+			reserved[card] = true
+		}
+	}
+
+	free := []string{}
+	for _, card := range cards {
+		if !reserved[normalize(card)] {
+			free = append(free, card)
+		}
+	}
+
+	return free
+}
+
+// Reserve records the cards as allocated to app, on top of the ones it already holds.
+func (r Reservations) Reserve(app string, cards []string) {
+	held := slices.Clone(r[app])
+	for _, card := range cards {
+		if card = normalize(card); card != "" && !slices.Contains(held, card) {
+			held = append(held, card)
+		}
+	}
+	sort.Strings(held)
+	r[app] = held
+}
+
+// Release drops the cards reserved by app.
+func (r Reservations) Release(app string) {
+	delete(r, app)
+}
+
+// Prune drops the reservations of the applications which are no longer deployed, Eg:- whose pods were removed
+// without ai-services, returning their names sorted.
+func (r Reservations) Prune(deployed []string) []string {
+	var pruned []string
+	for app := range r {
+		if !slices.Contains(deployed, app) {
+			pruned = append(pruned, app)
+			delete(r, app)
+		}
+	}
+	sort.Strings(pruned)
+
+	return pruned
+}
+
+// normalize trims the PCI address of a card as listed from its IOMMU group, Eg:- with a trailing newline.
+func normalize(card string) string {
+	return strings.TrimSpace(card)
+}
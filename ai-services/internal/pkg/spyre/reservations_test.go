@@ -0,0 +1,69 @@
+package spyre
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestReservationsFree(t *testing.T) {
+	r := Reservations{
+		"rag":     {"0000:01:00.0", "0000:02:00.0"},
+		"summary": {"0000:03:00.0"},
+	}
+	cards := []string{"0000:01:00.0\n", "0000:02:00.0\n", "0000:03:00.0\n", "0000:04:00.0\n"}
+
+	if got, want := r.Free(cards, "summary"), []string{"0000:03:00.0\n", "0000:04:00.0\n"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Free() for 'summary' = %q, want %q", got, want)
+	}
+	if got, want := r.Free(cards, "chat"), []string{"0000:04:00.0\n"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Free() for 'chat' = %q, want %q", got, want)
+	}
+}
+
+func TestReservationsReserveAndRelease(t *testing.T) {
+	r := Reservations{"rag": {"0000:02:00.0"}}
+
+	r.Reserve("rag", []string{"0000:01:00.0\n", "0000:02:00.0\n"})
+	if want := []string{"0000:01:00.0", "0000:02:00.0"}; !reflect.DeepEqual(r["rag"], want) {
+		t.Errorf("Reserve() = %q, want %q", r["rag"], want)
+	}
+
+	r.Release("rag")
+	if _, ok := r["rag"]; ok {
+		t.Errorf("Release() kept the reservation of 'rag': %v", r)
+	}
+}
+
+func TestReservationsPrune(t *testing.T) {
+	r := Reservations{"rag": {"0000:01:00.0"}, "gone": {"0000:02:00.0"}}
+
+	if got, want := r.Prune([]string{"rag"}), []string{"gone"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Prune() = %v, want %v", got, want)
+	}
+	if want := (Reservations{"rag": {"0000:01:00.0"}}); !reflect.DeepEqual(r, want) {
+		t.Errorf("reservations after Prune() = %v, want %v", r, want)
+	}
+}
+
+func TestReservationsSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "spyre-reservations.json")
+
+	r, err := LoadReservations(path)
+	if err != nil || len(r) != 0 {
+		t.Fatalf("LoadReservations() of a missing file = %v, %v, want no reservations", r, err)
+	}
+
+	r.Reserve("rag", []string{"0000:01:00.0"})
+	if err := r.Save(path); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	loaded, err := LoadReservations(path)
+	if err != nil {
+		t.Fatalf("LoadReservations() error = %v", err)
+	}
+	if !reflect.DeepEqual(loaded, r) {
+		t.Errorf("LoadReservations() = %v, want %v", loaded, r)
+	}
+}
@@ -0,0 +1,128 @@
+package support
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/audit"
+)
+
+const (
+	// bundleDir is the directory holding the collected files inside the tarball.
+	bundleDir = "ai-services-support"
+
+	redacted = "<redacted>"
+
+	bundleFilePerm = 0o600
+)
+
+// Item is a single file of the support bundle.
+type Item struct {
+	Name string
+	Data []byte
+}
+
+// Collector gathers one piece of diagnostics, stored in the bundle under the given file name.
+type Collector struct {
+	Name    string
+	Collect func() ([]byte, error)
+}
+
+var (
+	// sensitivePattern matches the key=value, key: value and "key": "value" pairs whose key looks sensitive.
+	sensitivePattern = regexp.MustCompile(`(?i)("?[\w.-]*(?:` + sensitiveKeysPattern() + `)[\w.-]*"?\s*[:=]\s*)("[^"]*"|[^\s,"'\]}]+)`)
+	// bearerPattern matches bearer tokens, Eg:- in the Authorization headers logged at high verbosity.
+	bearerPattern = regexp.MustCompile(`(?i)(bearer\s+)\S+`)
+)
+
+func sensitiveKeysPattern() string {
+	keys := make([]string, 0, len(audit.SensitiveKeys))
+	for _, k := range audit.SensitiveKeys {
+		keys = append(keys, regexp.QuoteMeta(k))
+	}
+
+	return strings.Join(keys, "|")
+}
+
+// Collect runs the given collectors and redacts what they gathered.
+// A failing collector does not abort the bundle, its error is stored in place of the diagnostics and returned.
+func Collect(collectors []Collector) ([]Item, []error) {
+	items := make([]Item, 0, len(collectors))
+	var errs []error
+
+	for _, c := range collectors {
+		data, err := c.Collect()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", c.Name, err))
+			data = fmt.Appendf(nil, "failed to collect: %v\n", err)
+		}
+		items = append(items, Item{Name: c.Name, Data: Redact(data)})
+	}
+
+	return items, errs
+}
+
+// Redact replaces the values of sensitive keys and bearer tokens.
+// Eg:- "opensearch.password=secret" -> "opensearch.password=<redacted>".
+func Redact(data []byte) []byte {
+	data = sensitivePattern.ReplaceAll(data, []byte("${1}"+redacted))
+
+	return bearerPattern.ReplaceAll(data, []byte("${1}"+redacted))
+}
+
+// Tail returns the last n lines of the file at path.
+func Tail(path string, n int) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.SplitAfter(strings.TrimRight(string(data), "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+
+	return []byte(strings.Join(lines, "") + "\n"), nil
+}
+
+// WriteBundle writes the items as a gzip compressed tarball at path.
+func WriteBundle(path string, items []Item) error {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	now := time.Now()
+	for _, item := range items {
+		hdr := &tar.Header{
+			Name:    bundleDir + "/" + item.Name,
+			Mode:    bundleFilePerm,
+			Size:    int64(len(item.Data)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return fmt.Errorf("failed to add %s to the bundle: %w", item.Name, err)
+		}
+		if _, err := tw.Write(item.Data); err != nil {
+			return fmt.Errorf("failed to add %s to the bundle: %w", item.Name, err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize the bundle: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("failed to compress the bundle: %w", err)
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), bundleFilePerm); err != nil {
+		return fmt.Errorf("failed to write the bundle: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package support
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "key value param", in: "--params ui.port=3000,opensearch.password=s3cr3t", want: "--params ui.port=3000,opensearch.password=<redacted>"},
+		{name: "key value param in between", in: "opensearch.password=s3cr3t,ui.port=3000", want: "opensearch.password=<redacted>,ui.port=3000"},
+		{name: "audit entry", in: `"args":["--params","x.password=abc"]`, want: `"args":["--params","x.password=<redacted>"]`},
+		{name: "yaml field", in: "apiToken: abc123", want: "apiToken: <redacted>"},
+		{name: "json field", in: `{"client_secret": "abc def"}`, want: `{"client_secret": <redacted>}`},
+		{name: "bearer token", in: "Authorization: Bearer eyJhbGciOi", want: "Authorization: Bearer <redacted>"},
+		{name: "nothing sensitive", in: "Version: 1.0\nui.port=3000", want: "Version: 1.0\nui.port=3000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(Redact([]byte(tt.in))); got != tt.want {
+				t.Errorf("Redact() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTail(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	if err := os.WriteFile(path, []byte("1\n2\n3\n4\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Tail(path, 2)
+	if err != nil {
+		t.Fatalf("Tail() error = %v", err)
+	}
+	if string(got) != "3\n4\n" {
+		t.Errorf("Tail() = %q, want %q", got, "3\n4\n")
+	}
+}
+
+func TestCollectAndWriteBundle(t *testing.T) {
+	items, errs := Collect([]Collector{
+		{Name: "version.txt", Collect: func() ([]byte, error) { return []byte("Version: 1.0\n"), nil }},
+		{Name: "podman-version.txt", Collect: func() ([]byte, error) { return nil, errors.New("podman not found") }},
+	})
+	if len(errs) != 1 {
+		t.Fatalf("Collect() errors = %v, want 1 error", errs)
+	}
+
+	path := filepath.Join(t.TempDir(), "bundle.tar.gz")
+	if err := WriteBundle(path, items); err != nil {
+		t.Fatalf("WriteBundle() error = %v", err)
+	}
+
+	got := readBundle(t, path)
+	want := map[string]string{
+		"ai-services-support/version.txt":        "Version: 1.0\n",
+		"ai-services-support/podman-version.txt": "failed to collect: podman not found\n",
+	}
+	for name, content := range want {
+		if got[name] != content {
+			t.Errorf("bundle file %s = %q, want %q", name, got[name], content)
+		}
+	}
+}
+
+func TestCollectorsLogFile(t *testing.T) {
+	logFile := filepath.Join(t.TempDir(), "ai-services.log")
+	if err := os.WriteFile(logFile, []byte("Creating application rag\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	hasCLILog := func() bool {
+		for _, c := range Collectors(Options{}) {
+			if c.Name == "cli.log" {
+				data, err := c.Collect()
+
+				return err == nil && string(data) == "Creating application rag\n"
+			}
+		}
+
+		return false
+	}
+
+	if hasCLILog() {
+		t.Errorf("Collectors() collects cli.log, want it only with --log-file")
+	}
+	vars.LogFile = logFile
+	t.Cleanup(func() { vars.LogFile = "" })
+	if !hasCLILog() {
+		t.Errorf("Collectors() does not collect the tail of the --log-file %s", logFile)
+	}
+}
+
+func readBundle(t *testing.T, path string) map[string]string {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[hdr.Name] = string(data)
+	}
+
+	return files
+}
@@ -0,0 +1,153 @@
+package support
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/affinity"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// logTailLines is the number of most recent lines of each log kept in the bundle.
+const logTailLines = 200
+
+// Options selects the diagnostics gathered into the bundle.
+type Options struct {
+	Runtime types.RuntimeType
+	// Version is the version information of the CLI.
+	Version string
+	// AuditLogPath is the path of the audit log, whose recent entries are included.
+	AuditLogPath string
+}
+
+// Collectors returns the collectors of the diagnostics relevant for the given runtime.
+// Apart from the operator phases on OpenShift, all of them only read from the local host, so that they work offline.
+func Collectors(opts Options) []Collector {
+	collectors := []Collector{
+		{Name: "version.txt", Collect: func() ([]byte, error) { return []byte(opts.Version), nil }},
+		{Name: "platform.txt", Collect: func() ([]byte, error) { return platformInfo(hostfs.OS) }},
+	}
+
+	switch opts.Runtime {
+	case types.RuntimeTypePodman:
+		collectors = append(collectors,
+			Collector{Name: "podman-version.txt", Collect: podmanVersion},
+			Collector{Name: "spyre.txt", Collect: func() ([]byte, error) { return spyreCards(hostfs.OS) }},
+			Collector{Name: "lpar-affinity.txt", Collect: lparAffinity},
+		)
+	case types.RuntimeTypeOpenShift:
+		collectors = append(collectors, Collector{Name: "operators.txt", Collect: operatorPhases})
+	}
+
+	collectors = append(collectors, Collector{Name: "audit.log", Collect: func() ([]byte, error) {
+		return Tail(opts.AuditLogPath, logTailLines)
+	}})
+	if logFile := vars.LogFile; logFile != "" {
+		collectors = append(collectors, Collector{Name: "cli.log", Collect: func() ([]byte, error) {
+			return Tail(logFile, logTailLines)
+		}})
+	}
+
+	return collectors
+}
+
+// platformInfo reports the platform, kernel and operating system release of the host.
+func platformInfo(fsys hostfs.FS) ([]byte, error) {
+	var buf bytes.Buffer
+	info := platform.Get()
+	fmt.Fprintf(&buf, "Architecture: %s\n", info.Arch)
+	if info.CPU != "" {
+		fmt.Fprintf(&buf, "CPU: %s\n", info.CPU)
+	}
+	fmt.Fprintf(&buf, "IBM Power11: %t\n", info.Power11)
+
+	if kernel, err := fsys.ReadFile("/proc/sys/kernel/osrelease"); err == nil {
+		fmt.Fprintf(&buf, "Kernel: %s\n", strings.TrimSpace(string(kernel)))
+	}
+
+	osRelease, err := fsys.ReadFile("/etc/os-release")
+	if err != nil {
+		return buf.Bytes(), fmt.Errorf("failed to read /etc/os-release: %w", err)
+	}
+	buf.WriteString("\n/etc/os-release:\n")
+	buf.Write(osRelease)
+
+	return buf.Bytes(), nil
+}
+
+// podmanVersion returns the output of podman version, which reports the client and, if reachable, the service version.
+func podmanVersion() ([]byte, error) {
+	out, err := exec.Command("podman", "version").CombinedOutput()
+	if err != nil {
+		return out, fmt.Errorf("failed to execute podman version: %w", err)
+	}
+
+	return out, nil
+}
+
+func spyreCards(fsys hostfs.FS) ([]byte, error) {
+	cards, err := spyre.ListDevices(fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enumerate PCI devices: %w", err)
+	}
+
+	return fmt.Appendf(nil, "Spyre cards: %d\n%s\n", len(cards), strings.Join(cards, "\n")), nil
+}
+
+func lparAffinity() ([]byte, error) {
+	percent, detail, err := affinity.ComputeLparAffinity()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute the LPAR affinity: %w", err)
+	}
+
+	return fmt.Appendf(nil, "LPAR affinity: %d%% (CPU: %d%%, memory: %d%%)\n%s\n",
+		percent, detail.CPUPercent, detail.MemoryPercent, detail), nil
+}
+
+// operatorPhases reports the installed CSV of each required operator along with its phase.
+func operatorPhases() ([]byte, error) {
+	client, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	var buf bytes.Buffer
+	for _, op := range vars.RequiredOperators() {
+		fmt.Fprintf(&buf, "%s: %s\n", op.Label, operatorPhase(client, op))
+	}
+
+	return buf.Bytes(), nil
+}
+
+func operatorPhase(c *openshift.OpenshiftClient, op constants.OperatorConfig) string {
+	sub := &operatorsv1alpha1.Subscription{}
+	if err := c.Client.Get(c.Ctx, k8sClient.ObjectKey{Name: op.Name, Namespace: op.Namespace}, sub); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "subscription not found"
+		}
+
+		return fmt.Sprintf("failed to get subscription: %v", err)
+	}
+	if sub.Status.InstalledCSV == "" {
+		return "no CSV installed yet"
+	}
+
+	csv := &operatorsv1alpha1.ClusterServiceVersion{}
+	if err := c.Client.Get(c.Ctx, k8sClient.ObjectKey{Name: sub.Status.InstalledCSV, Namespace: op.Namespace}, csv); err != nil {
+		return fmt.Sprintf("%s, failed to get CSV: %v", sub.Status.InstalledCSV, err)
+	}
+
+	return fmt.Sprintf("%s (%s)", csv.Name, csv.Status.Phase)
+}
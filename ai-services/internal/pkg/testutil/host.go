@@ -0,0 +1,73 @@
+package testutil
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/lock"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// Power11Host is the fixture of a RHEL 9.6 Power11 LPAR with 2 Spyre cards, fully placed on its home NUMA node.
+// Clone it with maps.Clone to adapt it, Eg:- to remove the Spyre cards.
+var Power11Host = hostfs.Fake{
+	"/proc/cpuinfo":   "processor\t: 0\ncpu\t\t: Power11 (architected), altivec supported\n",
+	"/etc/os-release": "NAME=\"Red Hat Enterprise Linux\"\nID=\"rhel\"\nVERSION_ID=\"9.6\"\n",
+	"/sys/bus/pci/devices/0182:70:00.0/vendor": "0x1014\n",
+	"/sys/bus/pci/devices/0182:70:00.0/device": "0x06a7\n",
+	"/sys/bus/pci/devices/0183:70:00.0/vendor": "0x1014\n",
+	"/sys/bus/pci/devices/0183:70:00.0/device": "0x06a7\n",
+	"/sys/devices/system/node/node0/cpulist":   "0-7\n",
+	"/sys/devices/system/node/node0/meminfo":   "Node 0 MemTotal:       4000 kB\n",
+}
+
+// FakeHost substitutes the given files for the host filesystem read by the validators and the facts detection,
+// until the end of the test. The facts are detected again from them.
+func FakeHost(t *testing.T, files hostfs.Fake) {
+	t.Helper()
+
+	restore := hostfs.Substitute(maps.Clone(files))
+	facts.Reset()
+	t.Cleanup(func() {
+		restore()
+		facts.Reset()
+	})
+}
+
+// TempModelDir points vars.ModelDirectory to a temporary directory until the end of the test, holding the given
+// models as staged, Eg:- "ibm-granite/granite-3.3-8b-instruct". It returns the directory.
+func TempModelDir(t *testing.T, models ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for _, model := range models {
+		modelDir := filepath.Join(dir, model)
+		if err := os.MkdirAll(modelDir, 0o755); err != nil {
+			t.Fatalf("failed to stage the model %s: %v", model, err)
+		}
+		if err := os.WriteFile(filepath.Join(modelDir, "config.json"), []byte("{}"), 0o644); err != nil {
+			t.Fatalf("failed to stage the model %s: %v", model, err)
+		}
+	}
+
+	previous := vars.ModelDirectory
+	vars.ModelDirectory = dir
+	t.Cleanup(func() { vars.ModelDirectory = previous })
+
+	return dir
+}
+
+// TempLockDir moves the lock of the mutating commands to a temporary directory until the end of the test,
+// as the lock of root is taken under /var/run. It returns the directory.
+func TempLockDir(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	t.Cleanup(lock.SetDir(dir))
+
+	return dir
+}
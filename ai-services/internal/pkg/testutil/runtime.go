@@ -0,0 +1,259 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// FakeRuntime is an in-memory runtime holding pods and images, recording the operations performed on it.
+// It is safe for concurrent use.
+type FakeRuntime struct {
+	mu sync.Mutex
+
+	RuntimeType types.RuntimeType
+	Pods        []types.Pod
+	Images      []types.Image
+	Routes      []types.Route
+	// Pulled and Built are the images pulled and built, Created the bodies of the pods created, in order.
+	Pulled  []string
+	Built   []string
+	Created []string
+	// Err is returned by every operation when set, Eg:- to test a runtime failure.
+	Err error
+}
+
+var _ runtime.Runtime = (*FakeRuntime)(nil)
+
+// NewFakeRuntime returns a fake runtime of the given type holding the given pods.
+func NewFakeRuntime(rt types.RuntimeType, pods ...types.Pod) *FakeRuntime {
+	return &FakeRuntime{RuntimeType: rt, Pods: pods}
+}
+
+// UseRuntime makes vars.RuntimeFactory, and the factories created by the commands, create the given runtime,
+// until the end of the test.
+func UseRuntime(t *testing.T, rt runtime.Runtime) {
+	t.Helper()
+
+	restore := runtime.SetCreator(func(types.RuntimeType, string) (runtime.Runtime, error) { return rt, nil })
+	factory := vars.RuntimeFactory
+	vars.RuntimeFactory = runtime.NewRuntimeFactory(rt.Type())
+	t.Cleanup(func() {
+		restore()
+		vars.RuntimeFactory = factory
+	})
+}
+
+func (f *FakeRuntime) ListImages() ([]types.Image, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.Images), f.Err
+}
+
+func (f *FakeRuntime) PullImage(image string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Pulled = append(f.Pulled, image)
+	f.Images = append(f.Images, types.Image{RepoTags: []string{image}})
+
+	return nil
+}
+
+func (f *FakeRuntime) Build(ctx context.Context, contextDir, tag string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Built = append(f.Built, tag)
+
+	return nil
+}
+
+// ListPods lists the pods matching the label filters, either "key" or "key=value", the other filters being ignored.
+func (f *FakeRuntime) ListPods(filters map[string][]string) ([]types.Pod, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return nil, f.Err
+	}
+
+	var pods []types.Pod
+	for _, pod := range f.Pods {
+		if matchLabels(pod.Labels, filters["label"]) {
+			pods = append(pods, pod)
+		}
+	}
+
+	return pods, nil
+}
+
+func (f *FakeRuntime) CreatePod(body io.Reader) ([]types.Pod, error) {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	f.Created = append(f.Created, string(data))
+
+	return nil, nil
+}
+
+func (f *FakeRuntime) DeletePod(id string, force *bool) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i, err := f.podIndex(id)
+	if err != nil {
+		return err
+	}
+	f.Pods = slices.Delete(f.Pods, i, i+1)
+
+	return nil
+}
+
+func (f *FakeRuntime) StopPod(id string) error {
+	return f.setPodStatus(id, "Exited")
+}
+
+func (f *FakeRuntime) StartPod(id string) error {
+	return f.setPodStatus(id, "Running")
+}
+
+func (f *FakeRuntime) InspectPod(nameOrID string) (*types.Pod, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i, err := f.podIndex(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	pod := f.Pods[i]
+
+	return &pod, nil
+}
+
+func (f *FakeRuntime) PodExists(nameOrID string) (bool, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return false, f.Err
+	}
+	_, err := f.podIndex(nameOrID)
+
+	return err == nil, nil
+}
+
+func (f *FakeRuntime) PodLogs(nameOrID string, opts types.LogsOptions) error {
+	_, err := f.InspectPod(nameOrID)
+
+	return err
+}
+
+func (f *FakeRuntime) InspectContainer(nameOrID string) (*types.Container, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.Err != nil {
+		return nil, f.Err
+	}
+	for _, pod := range f.Pods {
+		for _, c := range pod.Containers {
+			if c.ID == nameOrID || c.Name == nameOrID {
+				return &c, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("container %s: %w", nameOrID, types.ErrNotFound)
+}
+
+func (f *FakeRuntime) ContainerExists(nameOrID string) (bool, error) {
+	_, err := f.InspectContainer(nameOrID)
+	if err != nil && f.Err == nil {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (f *FakeRuntime) ContainerLogs(containerNameOrID string, opts types.LogsOptions) error {
+	_, err := f.InspectContainer(containerNameOrID)
+
+	return err
+}
+
+func (f *FakeRuntime) ExecContainer(podNameOrID, containerName string, opts types.ExecOptions) error {
+	_, err := f.InspectPod(podNameOrID)
+
+	return err
+}
+
+func (f *FakeRuntime) ListRoutes() ([]types.Route, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.Routes), f.Err
+}
+
+func (f *FakeRuntime) DeletePVCs(appLabel string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.Err
+}
+
+func (f *FakeRuntime) Type() types.RuntimeType {
+	return f.RuntimeType
+}
+
+func (f *FakeRuntime) setPodStatus(id, status string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	i, err := f.podIndex(id)
+	if err != nil {
+		return err
+	}
+	f.Pods[i].Status = status
+
+	return nil
+}
+
+// podIndex returns the index of the pod by name or ID, it is called with the lock held.
+func (f *FakeRuntime) podIndex(nameOrID string) (int, error) {
+	if f.Err != nil {
+		return 0, f.Err
+	}
+	i := slices.IndexFunc(f.Pods, func(p types.Pod) bool { return p.ID == nameOrID || p.Name == nameOrID })
+	if i < 0 {
+		return 0, fmt.Errorf("pod %s: %w", nameOrID, types.ErrNotFound)
+	}
+
+	return i, nil
+}
+
+func matchLabels(labels map[string]string, filters []string) bool {
+	for _, filter := range filters {
+		key, value, hasValue := strings.Cut(filter, "=")
+		got, ok := labels[key]
+		if !ok || (hasValue && got != value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,50 @@
+// Package testutil provides the harness of the end-to-end tests of the commands, run without the real infrastructure:
+// the command is run with its output captured, against a fake runtime, a temporary model directory and a fixture host.
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+// Result is the outcome of a command run.
+type Result struct {
+	// Stdout holds the results and the informational messages, Stderr the warnings and the errors.
+	Stdout string
+	Stderr string
+	Err    error
+	// ExitCode is the exit code the CLI exits with for Err, Eg:- exitcode.ValidationFailed.
+	ExitCode int
+}
+
+// Run runs the command with the given arguments, capturing the output of both the command and the logger.
+// The command is run as the root of the command line, Eg:- cmd.RootCmd with the subcommand in the arguments.
+// Note that cobra keeps the values of the flags across runs of the same command tree, the tests set all they depend on.
+func Run(t *testing.T, cmd *cobra.Command, args ...string) Result {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	logger.SetOutput(&stdout)
+	logger.SetErrorOutput(&stderr)
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	cmd.SetArgs(args)
+	t.Cleanup(func() {
+		logger.SetOutput(os.Stdout)
+		logger.SetErrorOutput(os.Stderr)
+		cmd.SetOut(nil)
+		cmd.SetErr(nil)
+		cmd.SetArgs(nil)
+	})
+
+	err := cmd.ExecuteContext(context.Background())
+
+	return Result{Stdout: stdout.String(), Stderr: stderr.String(), Err: err, ExitCode: exitcode.Of(err, exitcode.Success)}
+}
@@ -0,0 +1,107 @@
+package testutil
+
+import (
+	"errors"
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/exitcode"
+	"github.com/project-ai-services/ai-services/internal/pkg/facts"
+	"github.com/project-ai-services/ai-services/internal/pkg/lock"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestRun(t *testing.T) {
+	cmd := &cobra.Command{
+		Use:           "validate",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger.Infoln("Running validation for " + args[0])
+			logger.Warningln("LPAR affinity below the threshold")
+
+			return exitcode.MarkValidationFailed(errors.New("1 validation check(s) failed"))
+		},
+	}
+
+	res := Run(t, cmd, "lpar1")
+	if res.Stdout != "Running validation for lpar1\n" {
+		t.Errorf("Stdout = %q, want the informational message", res.Stdout)
+	}
+	if res.Stderr != "WARNING: LPAR affinity below the threshold\n" {
+		t.Errorf("Stderr = %q, want the warning", res.Stderr)
+	}
+	if res.Err == nil || res.ExitCode != exitcode.ValidationFailed {
+		t.Errorf("Run() = %v with exit code %d, want the validation failure", res.Err, res.ExitCode)
+	}
+}
+
+func TestUseRuntime(t *testing.T) {
+	fake := NewFakeRuntime(types.RuntimeTypePodman,
+		types.Pod{ID: "1", Name: "rag--vllm", Labels: map[string]string{"ai-services.io/application": "rag"}},
+		types.Pod{ID: "2", Name: "chat--ui", Labels: map[string]string{"ai-services.io/application": "chat"}},
+	)
+	UseRuntime(t, fake)
+
+	rt, err := vars.RuntimeFactory.Create("")
+	if err != nil || rt != fake {
+		t.Fatalf("RuntimeFactory.Create() = %v, %v, want the fake runtime", rt, err)
+	}
+
+	pods, err := rt.ListPods(map[string][]string{"label": {"ai-services.io/application=rag"}})
+	if err != nil || len(pods) != 1 || pods[0].Name != "rag--vllm" {
+		t.Errorf("ListPods() = %+v, %v, want the pod of the rag application", pods, err)
+	}
+
+	if err := rt.DeletePod("rag--vllm", nil); err != nil {
+		t.Fatalf("DeletePod() error = %v", err)
+	}
+	if err := rt.DeletePod("rag--vllm", nil); !errors.Is(err, types.ErrNotFound) {
+		t.Errorf("DeletePod() of a deleted pod error = %v, want it to match types.ErrNotFound", err)
+	}
+}
+
+func TestFakeHost(t *testing.T) {
+	host := maps.Clone(Power11Host)
+	delete(host, "/sys/bus/pci/devices/0183:70:00.0/vendor")
+	FakeHost(t, host)
+
+	// the architecture is the one of the test binary, so is the Power11 detection
+	got := facts.Get()
+	if got.SpyreCardCount != 1 || got.NUMANodeCount != 1 || got.LparAffinity != 100 || got.RHELVersion != "9.6" {
+		t.Errorf("facts.Get() = %+v, want the facts of the fixture host less a Spyre card", got)
+	}
+
+	// the validators created at init read the substituted host as well
+	rule, ok := validators.PodmanRegistry.Rule("numa")
+	if !ok {
+		t.Fatal("numa rule is not registered")
+	}
+	if err := rule.Verify(); err != nil {
+		t.Errorf("numa Verify() on the fixture host error = %v", err)
+	}
+}
+
+func TestTempModelDir(t *testing.T) {
+	dir := TempModelDir(t, "ibm-granite/granite-3.3-8b-instruct")
+	if vars.ModelDirectory != dir {
+		t.Errorf("vars.ModelDirectory = %s, want %s", vars.ModelDirectory, dir)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "ibm-granite/granite-3.3-8b-instruct", "config.json")); err != nil {
+		t.Errorf("model is not staged: %v", err)
+	}
+}
+
+func TestTempLockDir(t *testing.T) {
+	dir := TempLockDir(t)
+	if got := lock.DefaultPath(); filepath.Dir(got) != dir {
+		t.Errorf("lock.DefaultPath() = %s, want it under %s", got, dir)
+	}
+}
@@ -0,0 +1,104 @@
+package trace
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Span is a timed operation of the run, Eg:- the pull of an image.
+type Span struct {
+	Name     string
+	Start    time.Time
+	Duration time.Duration
+}
+
+// Recorder records the spans of the operations, it is safe for concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	started time.Time
+	spans   []Span
+}
+
+// NewRecorder returns a recorder whose timeline starts now.
+func NewRecorder() *Recorder {
+	return &Recorder{started: time.Now()}
+}
+
+// Start starts the span of the named operation, which ends once the returned function is called.
+// Eg:- defer r.Start("pull image " + image)().
+func (r *Recorder) Start(name string) func() {
+	start := time.Now()
+
+	return func() {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.spans = append(r.spans, Span{Name: name, Start: start, Duration: time.Since(start)})
+	}
+}
+
+// Spans returns the ended spans, ordered by start time.
+func (r *Recorder) Spans() []Span {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := append([]Span(nil), r.spans...)
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].Start.Before(spans[j].Start) })
+
+	return spans
+}
+
+// Render writes the timeline of the spans, with the offset of each from the start of the run and its duration.
+func (r *Recorder) Render(w io.Writer) error {
+	spans := r.Spans()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Execution timeline (total %s):\n", round(time.Since(r.started)))
+	fmt.Fprintf(&b, "  %-12s %-12s %s\n", "OFFSET", "DURATION", "OPERATION")
+	for _, s := range spans {
+		fmt.Fprintf(&b, "  %-12s %-12s %s\n", "+"+round(s.Start.Sub(r.started)).String(), round(s.Duration), s.Name)
+	}
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// round rounds the duration to the millisecond, enough to tell where the time goes.
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
+
+var (
+	// defaultMu guards the recorder of the run, nil until tracing is enabled by --trace.
+	defaultMu sync.Mutex
+	recorder  *Recorder
+)
+
+// Enable starts recording the spans of the run.
+func Enable() {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	recorder = NewRecorder()
+}
+
+// Default returns the recorder of the run, nil when tracing is disabled.
+func Default() *Recorder {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+
+	return recorder
+}
+
+// Start starts the span of the named operation on the recorder of the run, a no-op when tracing is disabled.
+// Eg:- defer trace.Start("pull image " + image)().
+func Start(name string) func() {
+	r := Default()
+	if r == nil {
+		return func() {}
+	}
+
+	return r.Start(name)
+}
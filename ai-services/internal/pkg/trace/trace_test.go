@@ -0,0 +1,70 @@
+package trace
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRecorderRender(t *testing.T) {
+	r := NewRecorder()
+	r.started = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.spans = []Span{
+		{Name: "helm install rag", Start: r.started.Add(1500 * time.Millisecond), Duration: 42 * time.Second},
+		{Name: "openshift client", Start: r.started.Add(10 * time.Millisecond), Duration: 1234567 * time.Microsecond},
+	}
+
+	var out strings.Builder
+	if err := r.Render(&out); err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Render() = %q, want a header and 2 spans", out.String())
+	}
+	if !strings.HasPrefix(lines[0], "Execution timeline (total ") {
+		t.Errorf("title = %q", lines[0])
+	}
+	wantSpans := []string{
+		"  +10ms        1.235s       openshift client",
+		"  +1.5s        42s          helm install rag",
+	}
+	for i, want := range wantSpans {
+		if lines[i+2] != want {
+			t.Errorf("span line %d = %q, want %q", i, lines[i+2], want)
+		}
+	}
+}
+
+func TestRecorderConcurrentSpans(t *testing.T) {
+	r := NewRecorder()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Go(func() {
+			r.Start("check")()
+		})
+	}
+	wg.Wait()
+
+	if got := len(r.Spans()); got != 10 {
+		t.Errorf("len(Spans()) = %d, want 10", got)
+	}
+}
+
+func TestStartDisabled(t *testing.T) {
+	defaultMu.Lock()
+	recorder = nil
+	defaultMu.Unlock()
+
+	// a no-op, not recorded anywhere
+	Start("podman client")()
+
+	Enable()
+	Start("podman client")()
+	if spans := Default().Spans(); len(spans) != 1 || spans[0].Name != "podman client" {
+		t.Errorf("Spans() = %+v, want the podman client span", spans)
+	}
+}
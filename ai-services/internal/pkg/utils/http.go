@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"crypto/tls"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// insecureSkipTLSVerify disables the verification of the server certificates by the clients of NewHTTPClient.
+var insecureSkipTLSVerify atomic.Bool
+
+// SetInsecureSkipTLSVerify toggles the verification of the server certificates by the clients created afterwards by NewHTTPClient.
+func SetInsecureSkipTLSVerify(insecure bool) {
+	insecureSkipTLSVerify.Store(insecure)
+}
+
+// NewHTTPClient returns a client for the outbound HTTP calls, Eg:- the registry reachability check, so that they behave alike.
+// It goes through the proxy set by the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables, skips the certificate
+// verification with --insecure-skip-tls-verify, and gives up on a request after timeout, 0 not bounding it.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+	if insecureSkipTLSVerify.Load() {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // requested by --insecure-skip-tls-verify
+	}
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// RetryAfter returns the delay the server asked to wait with the Retry-After header of the response,
+// given either in seconds or as an HTTP date. It returns 0 when the header is missing or invalid.
+func RetryAfter(resp *http.Response) time.Duration {
+	value := strings.TrimSpace(resp.Header.Get("Retry-After"))
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(max(seconds, 0)) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+
+	return 0
+}
+
+// IsThrottled reports whether the response asks to slow down the requests, Eg:- a registry enforcing a rate limit.
+func IsThrottled(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
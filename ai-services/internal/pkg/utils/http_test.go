@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	t.Cleanup(func() { SetInsecureSkipTLSVerify(false) })
+
+	client := NewHTTPClient(10 * time.Second)
+	if client.Timeout != 10*time.Second {
+		t.Errorf("Timeout = %s, want 10s", client.Timeout)
+	}
+	transport, ok := client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", client.Transport)
+	}
+	if transport.Proxy == nil {
+		t.Error("Proxy is nil, want the proxy of the environment")
+	}
+	if transport.TLSClientConfig != nil && transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify is set by default")
+	}
+
+	SetInsecureSkipTLSVerify(true)
+	transport = NewHTTPClient(0).Transport.(*http.Transport)
+	if transport.TLSClientConfig == nil || !transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify is not set with SetInsecureSkipTLSVerify(true)")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{name: "missing"},
+		{name: "seconds", header: "120", want: 2 * time.Minute},
+		{name: "negative", header: "-5"},
+		{name: "past date", header: "Wed, 21 Oct 2015 07:28:00 GMT"},
+		{name: "invalid", header: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				resp.Header.Set("Retry-After", tt.header)
+			}
+			if got := RetryAfter(resp); got != tt.want {
+				t.Errorf("RetryAfter(%q) = %s, want %s", tt.header, got, tt.want)
+			}
+		})
+	}
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("Retry-After", time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if got := RetryAfter(resp); got <= 0 || got > time.Minute {
+		t.Errorf("RetryAfter() of a date in a minute = %s, want within a minute", got)
+	}
+}
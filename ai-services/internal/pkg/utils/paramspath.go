@@ -0,0 +1,224 @@
+package utils
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// pathSegment is a step of a parameter path, either the key of a map or the index of a list.
+type pathSegment struct {
+	key     string
+	index   int
+	isIndex bool
+}
+
+// parseParamPath parses a parameter path made of dotted keys and list indices, Eg:- "ui.env[0].name".
+func parseParamPath(path string) ([]pathSegment, error) {
+	var segments []pathSegment
+	for part := range strings.SplitSeq(path, ".") {
+		key, rest, bracketed := strings.Cut(part, "[")
+		if key == "" {
+			return nil, fmt.Errorf("invalid parameter '%s': empty key", path)
+		}
+		segments = append(segments, pathSegment{key: key})
+
+		for bracketed {
+			raw, after, ok := strings.Cut(rest, "]")
+			index, err := strconv.Atoi(raw)
+			if !ok || err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid parameter '%s': the list index must be a non-negative number within brackets", path)
+			}
+			segments = append(segments, pathSegment{index: index, isIndex: true})
+
+			if after != "" && !strings.HasPrefix(after, "[") {
+				return nil, fmt.Errorf("invalid parameter '%s': unexpected '%s' after the list index", path, after)
+			}
+			rest, bracketed = strings.CutPrefix(after, "[")
+		}
+	}
+
+	return segments, nil
+}
+
+// SetParamValue sets a value in the nested values based on a parameter path of dotted keys and list indices,
+// mirroring helm --set. Eg:- "ui.env[0].name" sets the name of the first item of the list values["ui"]["env"].
+// The missing maps and lists are created, and the index right after the last item of a list appends one.
+// Indexing anything but a list, or setting the key of anything but a map, fails with the conflicting type.
+func SetParamValue(values map[string]any, path string, value any) error {
+	segments, err := parseParamPath(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = setPathValue(values, segments, value, "")
+
+	return err
+}
+
+// setPathValue sets the value in the node at the remaining segments, walked being the path leading to the node.
+// It returns the node to store in place of the given one, as appending to a list may reallocate it.
+func setPathValue(node any, segments []pathSegment, value any, walked string) (any, error) {
+	if len(segments) == 0 {
+		return value, nil
+	}
+
+	seg := segments[0]
+	if seg.isIndex {
+		var list []any
+		switch n := node.(type) {
+		case nil:
+		case []any:
+			list = n
+		default:
+			return nil, notAListError(walked, n)
+		}
+
+		if seg.index > len(list) {
+			return nil, outOfRangeError(seg.index, walked, list)
+		}
+		if seg.index == len(list) {
+			list = append(list, nil)
+		}
+
+		item, err := setPathValue(list[seg.index], segments[1:], value, fmt.Sprintf("%s[%d]", walked, seg.index))
+		if err != nil {
+			return nil, err
+		}
+		list[seg.index] = item
+
+		return list, nil
+	}
+
+	var m map[string]any
+	switch n := node.(type) {
+	case nil:
+		m = map[string]any{}
+	case map[string]any:
+		m = n
+	default:
+		return nil, notAMapError(seg.key, walked, n)
+	}
+
+	child, err := setPathValue(m[seg.key], segments[1:], value, joinPrefix(walked, seg.key))
+	if err != nil {
+		return nil, err
+	}
+	m[seg.key] = child
+
+	return m, nil
+}
+
+// SortParamPaths sorts the parameter paths in the order they are to be set, the items of a list by their index
+// so that they are appended one after the other, Eg:- "args[2]" before "args[10]".
+func SortParamPaths(paths []string) {
+	slices.SortFunc(paths, compareParamPaths)
+}
+
+func compareParamPaths(a, b string) int {
+	segsA, errA := parseParamPath(a)
+	segsB, errB := parseParamPath(b)
+	if errA != nil || errB != nil {
+		return strings.Compare(a, b)
+	}
+
+	for i := 0; i < len(segsA) && i < len(segsB); i++ {
+		x, y := segsA[i], segsB[i]
+		if x.isIndex && y.isIndex {
+			if c := cmp.Compare(x.index, y.index); c != 0 {
+				return c
+			}
+
+			continue
+		}
+		if c := strings.Compare(x.key, y.key); c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(segsA), len(segsB))
+}
+
+/*
+checkParamPath checks that the parameter path can be set in the values without adding parameters to the template,
+which is the case when the full path exists, Eg:- "ui.port" when values["ui"]["port"] exists.
+New keys are only accepted in the maps left empty by the template, Eg:- nodeSelector: {}, and in the items
+appended to a list.
+*/
+func checkParamPath(path string, values map[string]any) error {
+	segments, err := parseParamPath(path)
+	if err != nil {
+		return err
+	}
+
+	var node any = values
+	walked := ""
+	for _, seg := range segments {
+		// a null left by the template is a placeholder, like an empty map
+		if node == nil && walked != "" {
+			return nil
+		}
+		if seg.isIndex {
+			list, ok := node.([]any)
+			if !ok {
+				return notAListError(walked, node)
+			}
+			// the items past the end are appended, checked to be in range once the params are set in order
+			if seg.index >= len(list) {
+				return nil
+			}
+			node = list[seg.index]
+			walked = fmt.Sprintf("%s[%d]", walked, seg.index)
+
+			continue
+		}
+
+		m, ok := node.(map[string]any)
+		if !ok {
+			return notAMapError(seg.key, walked, node)
+		}
+		if len(m) == 0 && walked != "" {
+			return nil
+		}
+		if node, ok = m[seg.key]; !ok {
+			return fmt.Errorf("unsupported parameter: %s", path)
+		}
+		walked = joinPrefix(walked, seg.key)
+	}
+
+	return nil
+}
+
+func notAListError(walked string, node any) error {
+	return fmt.Errorf("cannot index '%s', it is a %s, not a list", walked, valueKind(node))
+}
+
+func notAMapError(key, walked string, node any) error {
+	return fmt.Errorf("cannot set '%s' of '%s', it is a %s, not a map", key, walked, valueKind(node))
+}
+
+func outOfRangeError(index int, walked string, list []any) error {
+	return fmt.Errorf("index %d of '%s' is out of range, the list has %d items, %d appends one", index, walked, len(list), len(list))
+}
+
+// valueKind names the kind of a value decoded from the values, for the type conflict errors.
+func valueKind(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "map"
+	case []any:
+		return "list"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case int, int64, float64:
+		return "number"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
@@ -0,0 +1,203 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func newParamValues() map[string]any {
+	return map[string]any{
+		"ui": map[string]any{
+			"port": "3000",
+			"env": []any{
+				map[string]any{"name": "LOG_LEVEL", "value": "info"},
+			},
+			"nodeSelector": map[string]any{},
+		},
+		"args": []any{"--verbose"},
+	}
+}
+
+func TestSetParamValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		value   any
+		want    any
+		wantErr string
+	}{
+		{
+			name:  "nested map",
+			path:  "ui.port",
+			value: "8080",
+			want:  map[string]any{"port": "8080"},
+		},
+		{
+			name:  "new nested maps",
+			path:  "ui.resources.limits.cpu",
+			value: "2",
+			want:  map[string]any{"resources": map[string]any{"limits": map[string]any{"cpu": "2"}}},
+		},
+		{
+			name:  "key of a list item",
+			path:  "ui.env[0].value",
+			value: "debug",
+			want:  map[string]any{"env": []any{map[string]any{"name": "LOG_LEVEL", "value": "debug"}}},
+		},
+		{
+			name:  "appended list item",
+			path:  "ui.env[1].name",
+			value: "MODE",
+			want: map[string]any{"env": []any{
+				map[string]any{"name": "LOG_LEVEL", "value": "info"},
+				map[string]any{"name": "MODE"},
+			}},
+		},
+		{
+			name:  "new list",
+			path:  "ui.hosts[0]",
+			value: "example.com",
+			want:  map[string]any{"hosts": []any{"example.com"}},
+		},
+		{
+			name:    "index out of range",
+			path:    "ui.env[2].name",
+			value:   "MODE",
+			wantErr: "index 2 of 'ui.env' is out of range, the list has 1 items, 1 appends one",
+		},
+		{
+			name:    "indexing a string",
+			path:    "ui.port[0]",
+			value:   "8080",
+			wantErr: "cannot index 'ui.port', it is a string, not a list",
+		},
+		{
+			name:    "key of a string",
+			path:    "ui.port.number",
+			value:   "8080",
+			wantErr: "cannot set 'number' of 'ui.port', it is a string, not a map",
+		},
+		{
+			name:    "key of a list",
+			path:    "ui.env.name",
+			value:   "MODE",
+			wantErr: "cannot set 'name' of 'ui.env', it is a list, not a map",
+		},
+		{
+			name:    "invalid index",
+			path:    "ui.env[first].name",
+			value:   "MODE",
+			wantErr: "invalid parameter 'ui.env[first].name': the list index must be a non-negative number within brackets",
+		},
+		{
+			name:    "unterminated bracket",
+			path:    "args[",
+			value:   "--verbose",
+			wantErr: "invalid parameter 'args[': the list index must be a non-negative number within brackets",
+		},
+		{
+			name:    "unterminated second bracket",
+			path:    "matrix[0][",
+			value:   "a",
+			wantErr: "invalid parameter 'matrix[0][': the list index must be a non-negative number within brackets",
+		},
+		{
+			name:    "empty key",
+			path:    "ui..port",
+			value:   "8080",
+			wantErr: "invalid parameter 'ui..port': empty key",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := newParamValues()
+			err := SetParamValue(values, tt.path, tt.value)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("SetParamValue() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetParamValue() unexpected error = %v", err)
+			}
+
+			// only compare the keys of ui expected to be set, the others are left as is
+			ui := values["ui"].(map[string]any)
+			for key, want := range tt.want.(map[string]any) {
+				if !reflect.DeepEqual(ui[key], want) {
+					t.Errorf("ui.%s = %#v, want %#v", key, ui[key], want)
+				}
+			}
+		})
+	}
+}
+
+func TestSetParamValueNestedLists(t *testing.T) {
+	values := map[string]any{}
+	if err := SetParamValue(values, "matrix[0][0]", "a"); err != nil {
+		t.Fatalf("SetParamValue() unexpected error = %v", err)
+	}
+	if err := SetParamValue(values, "matrix[0][1]", "b"); err != nil {
+		t.Fatalf("SetParamValue() unexpected error = %v", err)
+	}
+
+	if want := []any{[]any{"a", "b"}}; !reflect.DeepEqual(values["matrix"], want) {
+		t.Errorf("matrix = %#v, want %#v", values["matrix"], want)
+	}
+}
+
+func TestValidateParamPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		param   string
+		wantErr string
+	}{
+		{name: "existing key", param: "ui.port"},
+		{name: "existing list item", param: "ui.env[0].value"},
+		{name: "appended list item", param: "args[1]"},
+		{name: "below an appended list item", param: "ui.env[1].name"},
+		{name: "key of an empty map", param: "ui.nodeSelector.zone"},
+		{name: "unknown key", param: "ui.host", wantErr: "unsupported parameter: ui.host"},
+		{name: "unknown key of a list item", param: "ui.env[0].from", wantErr: "unsupported parameter: ui.env[0].from"},
+		{name: "items appended past the end", param: "args[3]"},
+		{
+			name:    "indexing a map",
+			param:   "ui[0]",
+			wantErr: "cannot index 'ui', it is a map, not a list",
+		},
+		{
+			name:    "key of a string",
+			param:   "ui.port.number",
+			wantErr: "cannot set 'number' of 'ui.port', it is a string, not a map",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(map[string]string{tt.param: "x"}, newParamValues())
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("ValidateParams() unexpected error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("ValidateParams() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSortParamPaths(t *testing.T) {
+	paths := []string{"args[10]", "ui.port", "args[2]", "ui.env[1].name", "args[0]", "ui.env[0].value"}
+	SortParamPaths(paths)
+
+	want := []string{"args[0]", "args[2]", "args[10]", "ui.env[0].value", "ui.env[1].name", "ui.port"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("SortParamPaths() = %v, want %v", paths, want)
+	}
+}
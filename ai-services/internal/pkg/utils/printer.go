@@ -5,6 +5,7 @@ import (
 
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
@@ -25,11 +26,10 @@ func NewTableWriter() *Printer {
 
 	styles := table.DefaultStyles()
 
-	styles.Header = lipgloss.NewStyle().
+	styles.Header = style.Heading().
 		BorderStyle(lipgloss.NormalBorder()).
 		BorderBottom(true).
-		Padding(0, 1).
-		Bold(true)
+		Padding(0, 1)
 
 	styles.Cell = lipgloss.NewStyle().
 		Padding(0, 1)
@@ -105,7 +105,7 @@ func (p *Printer) CloseTableWriter() {
 
 	for line := range strings.SplitSeq(out, "\n") {
 		if strings.TrimSpace(line) != "" {
-			logger.Infoln(line)
+			logger.Resultln(line)
 		}
 	}
 
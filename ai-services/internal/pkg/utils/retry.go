@@ -1,54 +1,145 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 )
 
+// maxRetryAfter caps the delay a server asks to wait before retrying, so that a bogus one does not stall the run.
+const maxRetryAfter = 5 * time.Minute
+
 // BackoffFunc type definition.
 type BackoffFunc func(currentDelay time.Duration) time.Duration
 
+// PermanentError wraps an error which should not be retried.
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+// Permanent marks the given error as permanent, so that Retry stops and returns it right away.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &PermanentError{Err: err}
+}
+
+// RetryAfterError wraps an error along with the delay the server asked to wait before retrying,
+// Eg:- the Retry-After header of a 429 Too Many Requests response.
+type RetryAfterError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// WithRetryAfter attaches the delay asked by the server to the error, so that Retry waits for it rather than
+// for its own delay. A delay which is not positive leaves the error as is.
+func WithRetryAfter(err error, after time.Duration) error {
+	if err == nil || after <= 0 {
+		return err
+	}
+
+	return &RetryAfterError{Err: err, After: after}
+}
+
+// RetryAfterOf returns the delay asked by the server, carried by the error or by one of the errors it wraps.
+func RetryAfterOf(err error) (time.Duration, bool) {
+	var retryAfter *RetryAfterError
+	if !errors.As(err, &retryAfter) {
+		return 0, false
+	}
+
+	return retryAfter.After, true
+}
+
 // Retry -> retries based on the retry attempts and initialDelay time set on failure.
 // Does exponentialBackOff based on the provided BackoffFunc.
 // Set backoff func to nil, if exponentialBackoff is not required.
+// Errors wrapped with Permanent are returned immediately without further attempts.
+// Errors carrying the delay asked by the server, see WithRetryAfter, are retried after it rather than after the computed one.
 func Retry(
 	attempts int,
 	initialDelay time.Duration,
 	backoff BackoffFunc,
 	fn func() error,
 ) error {
-	delay := initialDelay
-	var err error
+	_, err := RetryWithAttempts(attempts, initialDelay, backoff, fn)
 
-	// Run the function initially and if no error do not proceed with retry attempts
-	err = fn()
-	if err == nil {
-		return nil
-	}
+	return err
+}
 
-	for i := range attempts {
-		logger.Infof("\n[Retry] Attempt %d/%d...\n", i+1, attempts, 0)
+// RetryWithAttempts behaves like Retry, and additionally returns the number of times fn was called,
+// so that callers can tell a success after retries from a first-try success.
+// Each failed attempt followed by a retry is logged on a single line, suppressed in quiet mode as all the
+// informational messages, and the final error reports the number of attempts and the time spent on them.
+func RetryWithAttempts(
+	attempts int,
+	initialDelay time.Duration,
+	backoff BackoffFunc,
+	fn func() error,
+) (int, error) {
+	start := time.Now()
+	delay := initialDelay
+	// the initial call is followed by at most attempts retries
+	total := attempts + 1
 
-		if err = fn(); err == nil {
-			return nil
+	var permanent *PermanentError
+	calls := 0
+	for {
+		calls++
+		err := fn()
+		if err == nil {
+			if calls > 1 {
+				logger.Infof("[Retry] Attempt %d/%d succeeded\n", calls, total, logger.VerbosityLevelDebug)
+			}
+
+			return calls, nil
 		}
+		if errors.As(err, &permanent) {
+			// a permanent error stopping the retries reports the attempts made until then, as the exhausted retries do
+			if calls > 1 {
+				return calls, fmt.Errorf("retry stopped after %d attempts in %s with err: %w", calls, time.Since(start).Round(time.Millisecond), permanent.Err)
+			}
 
-		// At Last attempt — stop
-		if i == attempts-1 {
-			break
+			return calls, permanent.Err
+		}
+		// with no retries, there is no retry to report
+		if attempts <= 0 {
+			return calls, err
+		}
+		if calls >= total {
+			return calls, fmt.Errorf("retry failed after %d attempts in %s with err: %w", calls, time.Since(start).Round(time.Millisecond), err)
 		}
 
-		// Sleep till delay
-		logger.Infof("[Retry] Sleeping %v before retrying...\n", delay, logger.VerbosityLevelDebug)
-		time.Sleep(delay)
+		wait := delay
+		if after, ok := RetryAfterOf(err); ok {
+			wait = min(after, maxRetryAfter)
+		}
+		logger.Infof("[Retry] Attempt %d/%d failed, retrying in %s: %v\n", calls, total, wait, err)
+		time.Sleep(wait)
 
 		// Apply backoff if provided
 		if backoff != nil {
 			delay = backoff(delay)
 		}
 	}
-
-	return fmt.Errorf("retry failed after %d attempts with err: %w", attempts, err)
 }
@@ -0,0 +1,183 @@
+package utils
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+)
+
+func TestRetryWithAttempts(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	tests := []struct {
+		name      string
+		attempts  int
+		failures  int
+		permanent bool
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "first try success", attempts: 3, failures: 0, wantCalls: 1},
+		{name: "success after retries", attempts: 3, failures: 2, wantCalls: 3},
+		{name: "retries exhausted", attempts: 2, failures: 5, wantCalls: 3, wantErr: true},
+		{name: "permanent error", attempts: 3, failures: 5, permanent: true, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := RetryWithAttempts(tt.attempts, 0, nil, func() error {
+				calls++
+				if calls > tt.failures {
+					return nil
+				}
+				if tt.permanent {
+					return Permanent(errFlaky)
+				}
+
+				return errFlaky
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RetryWithAttempts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.wantCalls || calls != tt.wantCalls {
+				t.Errorf("RetryWithAttempts() = %d (fn called %d times), want %d", got, calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryWithAttemptsFinalError(t *testing.T) {
+	logger.SetQuiet(true)
+	defer logger.SetQuiet(false)
+
+	errFlaky := errors.New("flaky")
+	errDenied := errors.New("denied")
+
+	tests := []struct {
+		name     string
+		attempts int
+		// permanentAt is the call failing with a permanent error, none when zero
+		permanentAt int
+		want        string
+	}{
+		{name: "one retry exhausted", attempts: 1, want: `^retry failed after 2 attempts in \S+ with err: flaky$`},
+		{name: "retries exhausted", attempts: 4, want: `^retry failed after 5 attempts in \S+ with err: flaky$`},
+		{name: "permanent error after retries", attempts: 4, permanentAt: 3, want: `^retry stopped after 3 attempts in \S+ with err: denied$`},
+		{name: "permanent error right away", attempts: 4, permanentAt: 1, want: `^denied$`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got, err := RetryWithAttempts(tt.attempts, 0, nil, func() error {
+				calls++
+				if calls == tt.permanentAt {
+					return Permanent(errDenied)
+				}
+
+				return errFlaky
+			})
+			if got != calls {
+				t.Errorf("RetryWithAttempts() = %d, want the %d calls", got, calls)
+			}
+			if err == nil || !regexp.MustCompile(tt.want).MatchString(err.Error()) {
+				t.Errorf("RetryWithAttempts() error = %v, want it to match %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryWithAttemptsLogging(t *testing.T) {
+	var out bytes.Buffer
+	logger.SetOutput(&out)
+	defer logger.SetOutput(os.Stdout)
+
+	errFlaky := errors.New("flaky")
+	_, err := RetryWithAttempts(2, 0, nil, func() error { return errFlaky })
+
+	wantLines := "[Retry] Attempt 1/3 failed, retrying in 0s: flaky\n" +
+		"[Retry] Attempt 2/3 failed, retrying in 0s: flaky\n"
+	if out.String() != wantLines {
+		t.Errorf("retry log = %q, want %q", out.String(), wantLines)
+	}
+
+	if !errors.Is(err, errFlaky) {
+		t.Fatalf("RetryWithAttempts() error = %v, want it to wrap %v", err, errFlaky)
+	}
+	if want := regexp.MustCompile(`^retry failed after 3 attempts in \d+(\.\d+)?[µnm]?s with err: flaky$`); !want.MatchString(err.Error()) {
+		t.Errorf("RetryWithAttempts() error = %q, want it to match %s", err, want)
+	}
+
+	t.Run("quiet", func(t *testing.T) {
+		out.Reset()
+		logger.SetQuiet(true)
+		defer logger.SetQuiet(false)
+
+		_, _ = RetryWithAttempts(1, 0, nil, func() error { return errFlaky })
+		if out.Len() != 0 {
+			t.Errorf("retry log in quiet mode = %q, want none", out.String())
+		}
+	})
+
+	t.Run("no retries", func(t *testing.T) {
+		out.Reset()
+
+		if _, err := RetryWithAttempts(0, 0, nil, func() error { return errFlaky }); err != errFlaky { //nolint:errorlint // the error is expected unwrapped
+			t.Errorf("RetryWithAttempts() error = %v, want %v unwrapped", err, errFlaky)
+		}
+		if out.Len() != 0 {
+			t.Errorf("retry log = %q, want none", out.String())
+		}
+	})
+}
+
+func TestRetryWithAttemptsRetryAfter(t *testing.T) {
+	var out bytes.Buffer
+	logger.SetOutput(&out)
+	defer logger.SetOutput(os.Stdout)
+
+	throttled := WithRetryAfter(errors.New("429 Too Many Requests"), time.Millisecond)
+	calls, err := RetryWithAttempts(1, time.Hour, nil, func() error {
+		if out.Len() == 0 {
+			return fmt.Errorf("failed to pull the image: %w", throttled)
+		}
+
+		return nil
+	})
+	if err != nil || calls != 2 {
+		t.Fatalf("RetryWithAttempts() = %d, %v, want success after 2 calls", calls, err)
+	}
+	if want := "retrying in 1ms"; !strings.Contains(out.String(), want) {
+		t.Errorf("retry log = %q, want it to contain %q", out.String(), want)
+	}
+}
+
+func TestRetryAfterOf(t *testing.T) {
+	if _, ok := RetryAfterOf(errors.New("flaky")); ok {
+		t.Error("RetryAfterOf() of a plain error is ok")
+	}
+	if err := WithRetryAfter(nil, time.Second); err != nil {
+		t.Errorf("WithRetryAfter(nil) = %v, want nil", err)
+	}
+
+	errFlaky := errors.New("flaky")
+	if err := WithRetryAfter(errFlaky, 0); err != errFlaky {
+		t.Errorf("WithRetryAfter() with no delay = %v, want the error as is", err)
+	}
+
+	err := fmt.Errorf("failed to fetch: %w", WithRetryAfter(errFlaky, 30*time.Second))
+	if after, ok := RetryAfterOf(err); !ok || after != 30*time.Second {
+		t.Errorf("RetryAfterOf() = %s, %v, want 30s, true", after, ok)
+	}
+	if !errors.Is(err, errFlaky) {
+		t.Errorf("error = %v, want it to wrap %v", err, errFlaky)
+	}
+}
@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"encoding/json"
 	"fmt"
 	"maps"
 	"net"
@@ -97,6 +98,45 @@ func ParseKeyValues(pairs []string) (map[string]string, error) {
 	return out, nil
 }
 
+// ParseEnvParams parses key=ENV_VAR pairs, reading the value of each key from the named environment variable.
+func ParseEnvParams(pairs []string) (map[string]string, error) {
+	refs, err := ParseKeyValues(pairs)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]string, len(refs))
+	for key, env := range refs {
+		value, ok := os.LookupEnv(env)
+		if !ok {
+			return nil, fmt.Errorf("environment variable '%s' for parameter '%s' is not set", env, key)
+		}
+		out[key] = value
+	}
+
+	return out, nil
+}
+
+// ParseJSONParams parses key=<json> pairs, decoding the value of each key as JSON.
+// Unlike ParseKeyValues, a pair holds a single parameter, as the JSON value may contain commas.
+func ParseJSONParams(pairs []string) (map[string]any, error) {
+	out := make(map[string]any, len(pairs))
+	for _, pair := range pairs {
+		kv := strings.SplitN(pair, "=", maxKeyValueParts)
+		if len(kv) != maxKeyValueParts || kv[0] == "" {
+			return nil, fmt.Errorf("invalid format: %s (expected key=<json>)", pair)
+		}
+
+		var value any
+		if err := json.Unmarshal([]byte(kv[1]), &value); err != nil {
+			return nil, fmt.Errorf("invalid JSON value for parameter '%s': %w", kv[0], err)
+		}
+		out[kv[0]] = value
+	}
+
+	return out, nil
+}
+
 func FileExists(path string) bool {
 	_, err := os.Stat(path)
 	if err == nil {
@@ -149,10 +189,49 @@ func getDescription(n *yaml.Node) string {
 	}
 
 	desc := comment[idx+len("@description"):]
+	desc = strings.ReplaceAll(desc, "@required", "")
 
 	return strings.TrimSpace(desc)
 }
 
+// Checks if a yaml.Node is marked as required via @required in the head comment.
+func isRequired(n *yaml.Node) bool {
+	if n == nil {
+		return false
+	}
+
+	return strings.Contains(n.HeadComment, "@required")
+}
+
+// CollectRequiredParams collects the dotted keys of all the non-hidden parameters marked with @required,
+// the ones of the list items indexed, Eg:- models[0].uri.
+func CollectRequiredParams(prefix string, n *yaml.Node, required map[string]bool) {
+	if n == nil {
+		return
+	}
+
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			keyNode := n.Content[i]
+			if isHidden(keyNode) {
+				continue
+			}
+
+			newPrefix := joinPrefix(prefix, keyNode.Value)
+			if isRequired(keyNode) {
+				required[newPrefix] = true
+			}
+
+			CollectRequiredParams(newPrefix, n.Content[i+1], required)
+		}
+	case yaml.SequenceNode:
+		for i, el := range n.Content {
+			CollectRequiredParams(fmt.Sprintf("%s[%d]", prefix, i), el, required)
+		}
+	}
+}
+
 func FlattenNode(prefix string, n *yaml.Node, descMap map[string]string) {
 	if n == nil {
 		return
@@ -239,6 +318,23 @@ func SetNestedValue(out map[string]any, dottedKey string, value any) {
 	current[last] = value
 }
 
+// GetNestedValue returns the value from a nested map based on a dotted key notation.
+// For example, ui.port returns map["ui"]["port"].
+func GetNestedValue(values map[string]any, dottedKey string) (any, bool) {
+	var current any = values
+	for _, key := range strings.Split(dottedKey, ".") {
+		m, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
 func VerifyAppName(appName string) error {
 	if appName == "" || strings.Contains(appName, "..") || strings.ContainsAny(appName, "/\\") {
 		return fmt.Errorf("invalid application name: %s", appName)
@@ -247,6 +343,7 @@ func VerifyAppName(appName string) error {
 	return nil
 }
 
+// ValidateParams checks that each parameter can be set in the values of the template, see checkParamPath.
 func ValidateParams(params map[string]string, supportedParams map[string]any) error {
 	for param := range params {
 		key := param
@@ -254,49 +351,10 @@ func ValidateParams(params map[string]string, supportedParams map[string]any) er
 			key = strings.Split(param, "=")[0]
 		}
 
-		if !checkParamsInValues(key, supportedParams) {
-			return fmt.Errorf("unsupported parameter: %s", key)
+		if err := checkParamPath(key, supportedParams); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
-
-/*
-checkParamsInValues traverses the nested map structure, and return true only if the full path exists.
-Eg: for param = "ui.port", it checks if values["ui"]["port"] exists.
-*/
-func checkParamsInValues(param string, values map[string]any) bool {
-	// Considering example: "ui.port" which becomes ["ui", "port"] after below step
-	parts := strings.Split(param, ".")
-	current := values
-
-	for i, key := range parts {
-		// Check if the current key exists in the current map level
-		val, ok := current[key]
-		if !ok {
-			// Key doesn't exists at this level, so parameter path is invalid
-			// Example: if "ui" doesn't exist in values, return false
-			return false
-		}
-		// If we have reached the last part of the path, the parameter exists
-		// Example: for "ui.port", when i=1 (on "port"), we found it; hence returning true
-		if i == len(parts)-1 {
-			return true
-		}
-		// If it is not the last part, we need to go deeper into the nested structure,
-		// so we try to cast the value to a map so we can continue.
-		// Example: for "ui.port", when i=0 (on "ui"), we need values["ui"] to be a map
-		cast, ok := val.(map[string]any)
-		if !ok {
-			// Value exists but isn't a map, so we cant traverse further
-			// Example: if user supplies "ui.port.number" but port is a string, so return false
-			return false
-		}
-
-		// Move the pointer deeper for the next iteration
-		current = cast
-	}
-
-	return false
-}
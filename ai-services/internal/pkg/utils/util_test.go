@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"go.yaml.in/yaml/v3"
+)
+
+func TestParseEnvParams(t *testing.T) {
+	t.Setenv("AIS_TEST_PASSWORD", "s3cr3t")
+	t.Setenv("AIS_TEST_EMPTY", "")
+
+	tests := []struct {
+		name    string
+		pairs   []string
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name:  "values read from the environment",
+			pairs: []string{"opensearch.password=AIS_TEST_PASSWORD", "ui.banner=AIS_TEST_EMPTY"},
+			want:  map[string]string{"opensearch.password": "s3cr3t", "ui.banner": ""},
+		},
+		{
+			name:    "missing environment variable",
+			pairs:   []string{"opensearch.password=AIS_TEST_MISSING"},
+			wantErr: "environment variable 'AIS_TEST_MISSING' for parameter 'opensearch.password' is not set",
+		},
+		{
+			name:    "invalid format",
+			pairs:   []string{"opensearch.password"},
+			wantErr: "invalid format: opensearch.password (expected key=value)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEnvParams(tt.pairs)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("ParseEnvParams() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseEnvParams() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseEnvParams() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseJSONParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		pairs   []string
+		want    map[string]any
+		wantErr string
+	}{
+		{
+			name:  "structured and scalar values",
+			pairs: []string{`ui.resources={"limits":{"cpu":"2"}}`, `ui.args=["--port","8080"]`, `ui.name="demo"`, "ui.replicas=2"},
+			want: map[string]any{
+				"ui.resources": map[string]any{"limits": map[string]any{"cpu": "2"}},
+				"ui.args":      []any{"--port", "8080"},
+				"ui.name":      "demo",
+				"ui.replicas":  float64(2),
+			},
+		},
+		{
+			name:  "value containing an equal sign",
+			pairs: []string{`ui.env={"OPTS":"a=b"}`},
+			want:  map[string]any{"ui.env": map[string]any{"OPTS": "a=b"}},
+		},
+		{
+			name:    "invalid format",
+			pairs:   []string{`{"limits":{}}`},
+			wantErr: `invalid format: {"limits":{}} (expected key=<json>)`,
+		},
+		{
+			name:    "invalid JSON",
+			pairs:   []string{"ui.name=demo"},
+			wantErr: "invalid JSON value for parameter 'ui.name': invalid character 'd' looking for beginning of value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseJSONParams(tt.pairs)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Errorf("ParseJSONParams() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseJSONParams() unexpected error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseJSONParams() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectRequiredParams(t *testing.T) {
+	values := `
+ui:
+  # @hidden @required
+  image: icr.io/rag-ui
+  # @description Host port for the UI
+  port: ""
+opensearch:
+  auth:
+    # @required @description Password of the administrator
+    password: secret
+# @required
+models:
+  - name: granite
+    # @required
+    uri: hf://ibm-granite/granite
+  - name: bge
+    # @required @description Location of the model
+    uri: hf://BAAI/bge
+# @hidden
+internal:
+  # @required
+  token: abc
+`
+	var root yaml.Node
+	if err := yaml.Unmarshal([]byte(values), &root); err != nil {
+		t.Fatalf("failed to unmarshal the values: %v", err)
+	}
+
+	required := map[string]bool{}
+	CollectRequiredParams("", root.Content[0], required)
+
+	want := map[string]bool{
+		"opensearch.auth.password": true,
+		"models":                   true,
+		"models[0].uri":            true,
+		"models[1].uri":            true,
+	}
+	if !reflect.DeepEqual(required, want) {
+		t.Errorf("CollectRequiredParams() = %v, want %v", required, want)
+	}
+
+	descriptions := map[string]string{}
+	FlattenNode("", root.Content[0], descriptions)
+	if got := descriptions["opensearch.auth.password"]; got != "Password of the administrator" {
+		t.Errorf("description of a required parameter = %q, want it without the marker", got)
+	}
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	// waitBackoffFactor grows the interval between two polls of WaitFor.
+	waitBackoffFactor = 1.5
+	// waitBackoffJitter is the fraction of the interval added at random, so that the waits started together spread out.
+	waitBackoffJitter = 0.1
+	// waitMaxIntervalFactor caps the interval between two polls to a multiple of the initial one, Eg:- 30s for 5s.
+	waitMaxIntervalFactor = 6
+)
+
+// ErrWaitTimeout is returned by WaitFor when the condition is still not met once the timeout has elapsed.
+var ErrWaitTimeout = errors.New("timed out waiting for the condition")
+
+// WaitFor polls the condition until it is done, right away and then with a jittered backoff starting at interval.
+// It returns the error the condition fails with, an error wrapping ErrWaitTimeout once the timeout has elapsed,
+// or the one of the context when it is done first. A timeout which is not positive only stops with the context.
+func WaitFor(ctx context.Context, interval, timeout time.Duration, condition func() (done bool, err error)) error {
+	return WaitForWithMaxInterval(ctx, interval, interval*waitMaxIntervalFactor, timeout, condition)
+}
+
+// WaitForWithMaxInterval behaves like WaitFor, with the interval between two polls growing up to maxInterval
+// rather than up to a multiple of the initial one, Eg:- to keep polling a local resource at least every 10s.
+func WaitForWithMaxInterval(ctx context.Context, interval, maxInterval, timeout time.Duration, condition func() (done bool, err error)) error {
+	waitCtx := ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	delay := min(interval, maxInterval)
+	for {
+		done, err := condition()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+
+		select {
+		case <-waitCtx.Done():
+			// the context given by the caller is only done when it is the one which stopped the wait
+			if ctx.Err() != nil {
+				return fmt.Errorf("stopped waiting for the condition: %w", ctx.Err())
+			}
+
+			return fmt.Errorf("%w after %s", ErrWaitTimeout, timeout)
+		case <-time.After(jitter(delay)):
+		}
+
+		delay = min(time.Duration(float64(delay)*waitBackoffFactor), maxInterval)
+	}
+}
+
+func jitter(d time.Duration) time.Duration {
+	return d + time.Duration(rand.Float64()*waitBackoffJitter*float64(d)) //nolint:gosec // not used for security
+}
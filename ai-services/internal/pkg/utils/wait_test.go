@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	conditionErr := errors.New("failed to inspect the pod")
+
+	tests := []struct {
+		name      string
+		timeout   time.Duration
+		doneAfter int
+		err       error
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "done right away", timeout: time.Second, doneAfter: 1, wantCalls: 1},
+		{name: "done after polls", timeout: time.Second, doneAfter: 3, wantCalls: 3},
+		{name: "condition error", timeout: time.Second, doneAfter: 3, err: conditionErr, wantErr: conditionErr, wantCalls: 1},
+		{name: "timeout", timeout: 20 * time.Millisecond, wantErr: ErrWaitTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := WaitFor(context.Background(), time.Millisecond, tt.timeout, func() (bool, error) {
+				calls++
+				if tt.err != nil {
+					return false, tt.err
+				}
+
+				return tt.doneAfter > 0 && calls >= tt.doneAfter, nil
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Fatalf("WaitFor() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantCalls > 0 && calls != tt.wantCalls {
+				t.Errorf("WaitFor() polled %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestWaitForTimeoutMessage(t *testing.T) {
+	err := WaitFor(context.Background(), time.Millisecond, 10*time.Millisecond, func() (bool, error) { return false, nil })
+	if err == nil || err.Error() != "timed out waiting for the condition after 10ms" {
+		t.Errorf("WaitFor() error = %v, want the timeout along with its duration", err)
+	}
+}
+
+func TestWaitForCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := WaitFor(ctx, time.Millisecond, 0, func() (bool, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+
+		return false, nil
+	})
+	if !errors.Is(err, context.Canceled) || errors.Is(err, ErrWaitTimeout) {
+		t.Errorf("WaitFor() error = %v, want the cancellation of the context", err)
+	}
+}
+
+func TestWaitForWithMaxInterval(t *testing.T) {
+	// the interval is capped right away, the wait would otherwise outlast the test
+	calls := 0
+	err := WaitForWithMaxInterval(context.Background(), time.Hour, time.Millisecond, time.Second, func() (bool, error) {
+		calls++
+
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("WaitForWithMaxInterval() = %v after %d polls, want done after 3 polls", err, calls)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for range 100 {
+		if d := jitter(time.Second); d < time.Second || d > time.Second+time.Second/10 {
+			t.Fatalf("jitter(1s) = %s, want within 10%% above", d)
+		}
+	}
+}
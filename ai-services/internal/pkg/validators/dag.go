@@ -0,0 +1,110 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+// Dependent is implemented by the rules which require other rules to pass first,
+// Eg:- the operator resources can only be verified once the operators are installed.
+type Dependent interface {
+	// DependsOn returns the names of the prerequisite rules.
+	DependsOn() []string
+}
+
+// DependenciesOf returns the names of the prerequisites declared by the given rule.
+func DependenciesOf(rule Rule) []string {
+	if d, ok := rule.(Dependent); ok {
+		return d.DependsOn()
+	}
+
+	return nil
+}
+
+// Plan orders the rules into stages, the rules of a stage only depend on the rules of the previous stages,
+// so that they can be verified concurrently. Within a stage, the rules keep their registration order.
+//
+// A critical rule stops the validation when it fails, so the rules registered after it implicitly depend on it,
+// Eg:- no other podman check runs before the root one.
+// The prerequisites which are not part of the rules are ignored, as they are not verified in this run.
+func Plan(rules []Rule) ([][]Rule, error) {
+	index := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		index[rule.Name()] = i
+	}
+
+	prerequisites := make([][]int, len(rules))
+	lastCritical := -1
+	for i, rule := range rules {
+		if lastCritical >= 0 {
+			prerequisites[i] = append(prerequisites[i], lastCritical)
+		}
+		for _, dep := range DependenciesOf(rule) {
+			if j, ok := index[dep]; ok {
+				prerequisites[i] = append(prerequisites[i], j)
+			}
+		}
+		if rule.Level() == constants.ValidationLevelCritical {
+			lastCritical = i
+		}
+	}
+
+	p := planner{rules: rules, prerequisites: prerequisites, stage: make([]int, len(rules)), state: make([]visitState, len(rules))}
+
+	var stages [][]Rule
+	for i := range rules {
+		s, err := p.visit(i, nil)
+		if err != nil {
+			return nil, err
+		}
+		for len(stages) <= s {
+			stages = append(stages, nil)
+		}
+		stages[s] = append(stages[s], rules[i])
+	}
+
+	return stages, nil
+}
+
+type visitState int
+
+const (
+	unvisited visitState = iota
+	visiting
+	visited
+)
+
+type planner struct {
+	rules         []Rule
+	prerequisites [][]int
+	stage         []int
+	state         []visitState
+}
+
+// visit returns the stage of the rule, one past the latest stage of its prerequisites.
+func (p *planner) visit(i int, path []string) (int, error) {
+	path = append(path, p.rules[i].Name())
+
+	switch p.state[i] {
+	case visited:
+		return p.stage[i], nil
+	case visiting:
+		return 0, fmt.Errorf("validation checks have a dependency cycle: %s", strings.Join(path, " -> "))
+	}
+
+	p.state[i] = visiting
+	stage := 0
+	for _, j := range p.prerequisites[i] {
+		s, err := p.visit(j, path)
+		if err != nil {
+			return 0, err
+		}
+		stage = max(stage, s+1)
+	}
+	p.state[i] = visited
+	p.stage[i] = stage
+
+	return stage, nil
+}
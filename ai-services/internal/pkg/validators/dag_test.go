@@ -0,0 +1,131 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+)
+
+type testRule struct {
+	name  string
+	level constants.ValidationLevel
+	deps  []string
+}
+
+func (r *testRule) Verify() error                    { return nil }
+func (r *testRule) Message() string                  { return "" }
+func (r *testRule) Name() string                     { return r.name }
+func (r *testRule) Level() constants.ValidationLevel { return r.level }
+func (r *testRule) Hint() string                     { return "" }
+func (r *testRule) Description() string              { return "" }
+func (r *testRule) DependsOn() []string              { return r.deps }
+
+func stageNames(stages [][]Rule) [][]string {
+	names := make([][]string, 0, len(stages))
+	for _, stage := range stages {
+		s := make([]string, 0, len(stage))
+		for _, rule := range stage {
+			s = append(s, rule.Name())
+		}
+		names = append(names, s)
+	}
+
+	return names
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		rules   []Rule
+		want    [][]string
+		wantErr string
+	}{
+		{
+			name: "independent rules",
+			rules: []Rule{
+				&testRule{name: "a", level: constants.ValidationLevelError},
+				&testRule{name: "b", level: constants.ValidationLevelWarning},
+			},
+			want: [][]string{{"a", "b"}},
+		},
+		{
+			name: "dependencies",
+			rules: []Rule{
+				&testRule{name: "dsc", level: constants.ValidationLevelError, deps: []string{"dsci"}},
+				&testRule{name: "operators", level: constants.ValidationLevelError},
+				&testRule{name: "dsci", level: constants.ValidationLevelError, deps: []string{"operators"}},
+				&testRule{name: "storage", level: constants.ValidationLevelError},
+			},
+			want: [][]string{{"operators", "storage"}, {"dsci"}, {"dsc"}},
+		},
+		{
+			name: "critical rules run before the later ones",
+			rules: []Rule{
+				&testRule{name: "root", level: constants.ValidationLevelCritical},
+				&testRule{name: "rhel", level: constants.ValidationLevelError},
+				&testRule{name: "kubeconfig", level: constants.ValidationLevelCritical},
+				&testRule{name: "numa", level: constants.ValidationLevelWarning},
+				&testRule{name: "rhn", level: constants.ValidationLevelError, deps: []string{"rhel"}},
+			},
+			want: [][]string{{"root"}, {"rhel", "kubeconfig"}, {"numa", "rhn"}},
+		},
+		{
+			name: "unknown prerequisites are ignored",
+			rules: []Rule{
+				&testRule{name: "rhn", level: constants.ValidationLevelError, deps: []string{"rhel"}},
+			},
+			want: [][]string{{"rhn"}},
+		},
+		{
+			name: "cycle",
+			rules: []Rule{
+				&testRule{name: "a", level: constants.ValidationLevelError, deps: []string{"b"}},
+				&testRule{name: "b", level: constants.ValidationLevelError, deps: []string{"a"}},
+			},
+			wantErr: "validation checks have a dependency cycle: a -> b -> a",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stages, err := Plan(tt.rules)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Plan() error = %v, want %q", err, tt.wantErr)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+			if got := stageNames(stages); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Plan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRegisteredDependencies ensures the prerequisites of the built-in rules are registered along with them.
+func TestRegisteredDependencies(t *testing.T) {
+	for name, registry := range map[string]*ValidationRegistry{"podman": PodmanRegistry, "openshift": OpenshiftRegistry} {
+		rules := registry.Rules()
+		registered := map[string]bool{}
+		for _, rule := range rules {
+			registered[rule.Name()] = true
+		}
+
+		for _, rule := range rules {
+			for _, dep := range DependenciesOf(rule) {
+				if !registered[dep] {
+					t.Errorf("%s rule '%s' depends on the unregistered rule '%s'", name, rule.Name(), dep)
+				}
+			}
+		}
+
+		if _, err := Plan(rules); err != nil {
+			t.Errorf("%s rules: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package explain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Explanation is the in-depth documentation of a check, printed by bootstrap validate --explain
+// next to its short description, as the hint of a failed check only says what to do next.
+type Explanation struct {
+	// Why tells why the check matters for AI Services on IBM Power11.
+	Why string
+	// Remediation are the steps fixing a failure of the check, in order.
+	Remediation []string
+}
+
+// Render renders the explanation, with its remediation steps numbered.
+func (e Explanation) Render() string {
+	var b strings.Builder
+	if e.Why != "" {
+		fmt.Fprintf(&b, "Why it matters:\n  %s\n", e.Why)
+	}
+	if len(e.Remediation) > 0 {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("Remediation:\n")
+		for i, step := range e.Remediation {
+			fmt.Fprintf(&b, "  %d. %s\n", i+1, step)
+		}
+	}
+
+	return b.String()
+}
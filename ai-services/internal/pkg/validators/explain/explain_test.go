@@ -0,0 +1,37 @@
+package explain
+
+import "testing"
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name        string
+		explanation Explanation
+		want        string
+	}{
+		{
+			name: "why and remediation",
+			explanation: Explanation{
+				Why:         "The Spyre cards are passed through with vfio-pci.",
+				Remediation: []string{"Load the kernel modules.", "Persist them across reboot."},
+			},
+			want: "Why it matters:\n  The Spyre cards are passed through with vfio-pci.\n\n" +
+				"Remediation:\n  1. Load the kernel modules.\n  2. Persist them across reboot.\n",
+		},
+		{
+			name:        "remediation only",
+			explanation: Explanation{Remediation: []string{"Run this command as root."}},
+			want:        "Remediation:\n  1. Run this command as root.\n",
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.explanation.Render(); got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
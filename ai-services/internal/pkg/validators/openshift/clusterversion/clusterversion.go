@@ -0,0 +1,161 @@
+package clusterversion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/operators"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+	"k8s.io/apimachinery/pkg/api/meta"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// clusterVersionName is the name of the singleton ClusterVersion resource of an OpenShift cluster.
+const clusterVersionName = "version"
+
+type ClusterVersionRule struct {
+	current    string
+	olmMissing bool
+}
+
+func NewClusterVersionRule() *ClusterVersionRule {
+	return &ClusterVersionRule{}
+}
+
+func (r *ClusterVersionRule) Name() string {
+	return "clusterversion"
+}
+
+func (r *ClusterVersionRule) Description() string {
+	return "Validates that the OpenShift cluster version meets the minimum required version"
+}
+
+// Verify checks that the current version of the cluster is at least vars.MinOpenShiftVersion.
+func (r *ClusterVersionRule) Verify() error {
+	client, err := openshift.NewOpenshiftClient()
+	if err != nil {
+		return fmt.Errorf("failed to create openshift client: %w", err)
+	}
+
+	r.current = ""
+	cv, err := getClusterVersion(client.Ctx, client.Client)
+	r.olmMissing = errors.Is(err, operators.ErrOLMNotDetected)
+	if err != nil {
+		return err
+	}
+
+	r.current = currentVersion(cv)
+	if r.current == "" {
+		return fmt.Errorf("cluster version is not reported yet")
+	}
+
+	return checkVersion(r.current, vars.MinOpenShiftVersion)
+}
+
+// Versions returns the OpenShift version of the cluster, none when it is not reported.
+func (r *ClusterVersionRule) Versions() map[string]string {
+	if r.current == "" {
+		return nil
+	}
+
+	return map[string]string{"openshift": r.current}
+}
+
+func (r *ClusterVersionRule) Message() string {
+	return fmt.Sprintf("OpenShift version %s", r.current)
+}
+
+func (r *ClusterVersionRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelCritical
+}
+
+func (r *ClusterVersionRule) Hint() string {
+	if r.olmMissing {
+		return "The OpenShift runtime requires an OpenShift cluster, please check that the current kubeconfig context points to the right cluster"
+	}
+
+	return fmt.Sprintf("The required operators are only available from OpenShift %s onwards, please upgrade the cluster", vars.MinOpenShiftVersion)
+}
+
+func (r *ClusterVersionRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The operators AI Services relies on, Eg:- the Spyre operator and Red Hat OpenShift AI, are only available from a minimum OpenShift version.",
+		Remediation: []string{
+			"Check the version of the cluster with 'oc get clusterversion'.",
+			fmt.Sprintf("Upgrade the cluster to OpenShift %s or later, following the OpenShift update documentation.", vars.MinOpenShiftVersion),
+		},
+	}
+}
+
+// getClusterVersion gets the ClusterVersion of the cluster. Its kind is only served by OpenShift, so that it is missing
+// along with OLM, Eg:- on plain Kubernetes, which is reported as operators.ErrOLMNotDetected rather than as an unknown kind.
+func getClusterVersion(ctx context.Context, c k8sClient.Reader) (*configv1.ClusterVersion, error) {
+	cv := &configv1.ClusterVersion{}
+	if err := c.Get(ctx, k8sClient.ObjectKey{Name: clusterVersionName}, cv); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, operators.ErrOLMNotDetected
+		}
+
+		return nil, fmt.Errorf("failed to get the cluster version: %w", err)
+	}
+
+	return cv, nil
+}
+
+// currentVersion returns the version the cluster last completed updating to.
+// While the very first install is in progress no update is completed yet, so the desired version is reported.
+func currentVersion(cv *configv1.ClusterVersion) string {
+	// the history is ordered with the most recent update first
+	for _, h := range cv.Status.History {
+		if h.State == configv1.CompletedUpdate {
+			return h.Version
+		}
+	}
+
+	return cv.Status.Desired.Version
+}
+
+// checkVersion fails when the major.minor of current is older than the one of minimum.
+func checkVersion(current, minimum string) error {
+	want, err := parseMajorMinor(minimum)
+	if err != nil {
+		return fmt.Errorf("invalid minimum OpenShift version: %w", err)
+	}
+
+	got, err := parseMajorMinor(current)
+	if err != nil {
+		return fmt.Errorf("invalid cluster version: %w", err)
+	}
+
+	if got[0] < want[0] || (got[0] == want[0] && got[1] < want[1]) {
+		return fmt.Errorf("OpenShift %s required, found %s", minimum, current)
+	}
+
+	return nil
+}
+
+// parseMajorMinor reads the major and minor numbers of a version, Eg:- "4.16.3" -> [4 16].
+func parseMajorMinor(version string) ([2]int, error) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3)
+	if len(parts) < 2 {
+		return [2]int{}, fmt.Errorf("'%s' is not in the major.minor format", version)
+	}
+
+	var mm [2]int
+	for i := range mm {
+		n, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return [2]int{}, fmt.Errorf("'%s' is not in the major.minor format", version)
+		}
+		mm[i] = n
+	}
+
+	return mm, nil
+}
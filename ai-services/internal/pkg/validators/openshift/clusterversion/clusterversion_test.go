@@ -0,0 +1,96 @@
+package clusterversion
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/operators"
+)
+
+func TestCurrentVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		cv   configv1.ClusterVersion
+		want string
+	}{
+		{
+			name: "last completed update",
+			cv: configv1.ClusterVersion{Status: configv1.ClusterVersionStatus{
+				Desired: configv1.Release{Version: "4.17.2"},
+				History: []configv1.UpdateHistory{
+					{State: configv1.PartialUpdate, Version: "4.17.2"},
+					{State: configv1.CompletedUpdate, Version: "4.16.9"},
+				},
+			}},
+			want: "4.16.9",
+		},
+		{
+			name: "install in progress",
+			cv: configv1.ClusterVersion{Status: configv1.ClusterVersionStatus{
+				Desired: configv1.Release{Version: "4.16.0"},
+				History: []configv1.UpdateHistory{{State: configv1.PartialUpdate, Version: "4.16.0"}},
+			}},
+			want: "4.16.0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currentVersion(&tt.cv); got != tt.want {
+				t.Errorf("currentVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		minimum string
+		wantErr string
+	}{
+		{name: "same minor", current: "4.16.0", minimum: "4.16"},
+		{name: "newer minor", current: "4.18.3", minimum: "4.16"},
+		{name: "newer major", current: "5.0.1", minimum: "4.16"},
+		{name: "older minor", current: "4.14.12", minimum: "4.16", wantErr: "OpenShift 4.16 required, found 4.14.12"},
+		{name: "minor compared numerically", current: "4.9.0", minimum: "4.16", wantErr: "OpenShift 4.16 required, found 4.9.0"},
+		{name: "invalid minimum", current: "4.16.0", minimum: "four", wantErr: "invalid minimum OpenShift version: 'four' is not in the major.minor format"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkVersion(tt.current, tt.minimum)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("checkVersion() unexpected error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("checkVersion() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetClusterVersionWithoutOLM(t *testing.T) {
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(_ context.Context, _ k8sClient.WithWatch, _ k8sClient.ObjectKey, _ k8sClient.Object, _ ...k8sClient.GetOption) error {
+			return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "config.openshift.io", Kind: "ClusterVersion"}}
+		},
+	}).Build()
+
+	if _, err := getClusterVersion(context.Background(), c); !errors.Is(err, operators.ErrOLMNotDetected) {
+		t.Errorf("getClusterVersion() error = %v, want %v", err, operators.ErrOLMNotDetected)
+	}
+}
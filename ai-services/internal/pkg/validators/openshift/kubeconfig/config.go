@@ -6,6 +6,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -51,3 +52,16 @@ func (r *KubeconfigRule) Level() constants.ValidationLevel {
 func (r *KubeconfigRule) Hint() string {
 	return "Make sure your kubeconfig is correctly configured and that you have the necessary permissions to access the OpenShift cluster."
 }
+
+func (r *KubeconfigRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "All the other OpenShift checks, the configuration and the deployments go through the API server of the cluster, " +
+			"with the credentials of the current context of the kubeconfig.",
+		Remediation: []string{
+			"Check the current context and user with 'oc whoami --show-context' and 'oc whoami'.",
+			"Log in with 'oc login <api-server-url>', or set KUBECONFIG to the kubeconfig of the cluster.",
+			"Select the context of the cluster with 'oc config use-context <context>', or validate it with --contexts.",
+			"Make sure the user is allowed to install operators and create namespaces, Eg:- with the cluster-admin role.",
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -84,3 +85,14 @@ func (r *NodeLabelsRule) Level() constants.ValidationLevel {
 func (r *NodeLabelsRule) Hint() string {
 	return "Ensure at least one worker node has the spyre role"
 }
+
+func (r *NodeLabelsRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The model servers are scheduled on the worker nodes with Spyre cards, recognized by their spyre role. " +
+			"Without such a node, their pods stay pending.",
+		Remediation: []string{
+			fmt.Sprintf("List the nodes with 'oc get nodes -l %s'.", NodeRoleSpyre),
+			fmt.Sprintf("Label the worker nodes with Spyre cards with 'oc label node <node> %s='.", NodeRoleSpyre),
+		},
+	}
+}
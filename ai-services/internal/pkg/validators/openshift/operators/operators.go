@@ -1,18 +1,38 @@
 package operators
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+var (
+	// ErrOLMNotDetected is returned when the OLM resources are not served by the cluster, Eg:- on plain Kubernetes.
+	ErrOLMNotDetected = errors.New("OLM (Operator Lifecycle Manager) not detected; this environment may be plain Kubernetes")
+	// errNotInstalled is returned when the subscription of the operator is missing, which no wait can fix.
+	errNotInstalled = errors.New("subscription not found")
+	// errInstallFailed is returned when the CSV of the operator is in the Failed phase, which needs to be fixed by hand.
+	errInstallFailed = errors.New("install failed")
+	// errConflictingInstall is returned when several CSVs of the same operator are installed side by side.
+	errConflictingInstall = errors.New("potential conflicting install")
+)
+
 type OperatorRule struct {
-	passed []string
+	passed     []string
+	olmMissing bool
 }
 
 func NewOperatorRule() *OperatorRule {
@@ -29,14 +49,24 @@ func (r *OperatorRule) Description() string {
 
 func (r *OperatorRule) Verify() error {
 	var failed []string
+	unrecoverable := false
+	// the rule is verified again on retries
+	r.passed, r.olmMissing = nil, false
 
 	client, err := openshift.NewOpenshiftClient()
 	if err != nil {
-		return fmt.Errorf("failed to create openshift client: %w", err)
+		return utils.Permanent(fmt.Errorf("failed to create openshift client: %w", err))
 	}
 
-	for _, op := range constants.RequiredOperators {
+	for _, op := range vars.RequiredOperators() {
 		if err := validateOperator(client, op.Name, op.Namespace); err != nil {
+			// none of the operators can be installed without OLM, so there is no point in checking the rest
+			if errors.Is(err, ErrOLMNotDetected) {
+				r.olmMissing = true
+
+				return utils.Permanent(err)
+			}
+			unrecoverable = unrecoverable || isUnrecoverable(err)
 			failed = append(failed, fmt.Sprintf("  - %s: %s", op.Label, err.Error()))
 		} else {
 			r.passed = append(r.passed, fmt.Sprintf("  - %s installed", op.Label))
@@ -44,7 +74,13 @@ func (r *OperatorRule) Verify() error {
 	}
 
 	if len(failed) > 0 {
-		return fmt.Errorf("operator validation failed: \n%s", strings.Join(append(r.passed, failed...), "\n"))
+		err := fmt.Errorf("operator validation failed: \n%s", strings.Join(append(r.passed, failed...), "\n"))
+		// an operator which no wait can fix fails the check right away, rather than once the polls wait out their timeout
+		if unrecoverable {
+			return utils.Permanent(err)
+		}
+
+		return err
 	}
 
 	return nil
@@ -54,14 +90,39 @@ func (r *OperatorRule) Message() string {
 	return "Operators installed\n" + strings.Join(r.passed, "\n")
 }
 
+// RetryPolicy polls the readiness, which can still be progressing right after the configuration.
+// With --wait-ready, the operators being installed are waited for up to the given timeout.
+func (r *OperatorRule) RetryPolicy() retry.Policy {
+	if vars.WaitReady > 0 {
+		return retry.Until(vars.WaitReady)
+	}
+
+	return retry.Readiness
+}
+
 func (r *OperatorRule) Level() constants.ValidationLevel {
 	return constants.ValidationLevelError
 }
 
 func (r *OperatorRule) Hint() string {
+	if r.olmMissing {
+		return "The OpenShift runtime requires an OpenShift cluster with Operator Lifecycle Manager, please check that the current kubeconfig context points to the right cluster"
+	}
+
 	return "This tool requires certain operators to be up and running, please run `ai-services bootstrap configure` to install required operators"
 }
 
+func (r *OperatorRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "AI Services relies on operators to expose the Spyre cards to the pods and to serve the models, Eg:- the Spyre operator and Red Hat OpenShift AI.",
+		Remediation: []string{
+			"List the installed operators with 'oc get csv -A'.",
+			"Run 'ai-services bootstrap configure' to install the missing operators.",
+			"When an operator does not reach the Succeeded phase, check why with 'oc describe csv <name> -n <namespace>'.",
+		},
+	}
+}
+
 func validateOperator(c *openshift.OpenshiftClient, opName, opNamespace string) error {
 	// Get subscription
 	sub := &operatorsv1alpha1.Subscription{}
@@ -70,7 +131,10 @@ func validateOperator(c *openshift.OpenshiftClient, opName, opNamespace string)
 		Namespace: opNamespace,
 	}, sub); err != nil {
 		if apierrors.IsNotFound(err) {
-			return fmt.Errorf("subscription not found")
+			return errNotInstalled
+		}
+		if isOLMMissing(err) {
+			return ErrOLMNotDetected
 		}
 
 		return fmt.Errorf("failed to get subscription: %w", err)
@@ -81,8 +145,9 @@ func validateOperator(c *openshift.OpenshiftClient, opName, opNamespace string)
 		return fmt.Errorf("no CSV installed yet")
 	}
 
-	// Get CSV
-	csv := &operatorsv1alpha1.ClusterServiceVersion{}
+	// Get CSV, unstructured so that a CSV without a phase is told apart from one with an empty phase
+	csv := &unstructured.Unstructured{}
+	csv.SetGroupVersionKind(operatorsv1alpha1.SchemeGroupVersion.WithKind(operatorsv1alpha1.ClusterServiceVersionKind))
 	if err := c.Client.Get(c.Ctx, k8sClient.ObjectKey{
 		Name:      sub.Status.InstalledCSV,
 		Namespace: opNamespace,
@@ -95,9 +160,120 @@ func validateOperator(c *openshift.OpenshiftClient, opName, opNamespace string)
 	}
 
 	// Check CSV phase
-	if csv.Status.Phase != operatorsv1alpha1.CSVPhaseSucceeded {
-		return fmt.Errorf("not ready (phase: %s)", csv.Status.Phase)
+	if err := checkCSVPhase(csv); err != nil {
+		return err
+	}
+
+	// Other CSVs of the same operator (e.g. a failed older version) point to a conflicting install,
+	// which must not be masked by the installed CSV having succeeded
+	matching, err := listMatchingCSVs(c.Ctx, c.Client, opNamespace, csv.GetName())
+	if err != nil {
+		if isOLMMissing(err) {
+			return ErrOLMNotDetected
+		}
+
+		return fmt.Errorf("failed to list CSVs: %w", err)
+	}
+	if len(matching) > 1 {
+		return fmt.Errorf("%w, found %d CSVs: %s", errConflictingInstall, len(matching), formatCSVPhases(matching))
+	}
+
+	return nil
+}
+
+// checkCSVPhase verifies that the CSV succeeded, a CSV without a phase, Eg:- not yet processed by OLM, is reported as such.
+func checkCSVPhase(csv *unstructured.Unstructured) error {
+	phase, err := openshift.NestedString(csv.Object, "status", "phase")
+	if err != nil {
+		return fmt.Errorf("CSV %s: %w", csv.GetName(), err)
+	}
+	if phase == string(operatorsv1alpha1.CSVPhaseFailed) {
+		return fmt.Errorf("%w (phase: %s)", errInstallFailed, phase)
+	}
+	if phase != string(operatorsv1alpha1.CSVPhaseSucceeded) {
+		return fmt.Errorf("not ready (phase: %s)", phase)
 	}
 
 	return nil
 }
+
+// isUnrecoverable reports whether the error of an operator is one which no wait can fix: the operator is not installed,
+// its install failed or conflicts with another one.
+func isUnrecoverable(err error) bool {
+	return errors.Is(err, errNotInstalled) || errors.Is(err, errInstallFailed) || errors.Is(err, errConflictingInstall)
+}
+
+// isOLMMissing reports whether the error is caused by the OLM kinds (Subscription, ClusterServiceVersion)
+// not being registered in the cluster, e.g. "no matches for kind ClusterServiceVersion".
+func isOLMMissing(err error) bool {
+	return meta.IsNoMatchError(err)
+}
+
+// listMatchingCSVs lists the CSVs of the namespace page by page, returning the ones belonging to the same operator
+// package as the installed CSV across all the pages, but the ones being replaced by an upgrade.
+func listMatchingCSVs(ctx context.Context, c k8sClient.Reader, namespace, installedCSV string) ([]operatorsv1alpha1.ClusterServiceVersion, error) {
+	var matching []operatorsv1alpha1.ClusterServiceVersion
+	csvList := &operatorsv1alpha1.ClusterServiceVersionList{}
+	err := openshift.ListPages(ctx, c, csvList, func() error {
+		matching = append(matching, matchingCSVs(csvList.Items, installedCSV)...)
+
+		return nil
+	}, k8sClient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	return withoutReplaced(matching), nil
+}
+
+// withoutReplaced drops the CSVs being replaced by an upgrade, which are no conflicting install: the ones in the
+// Replacing or Deleting phase, and the ones another CSV replaces through spec.replaces, Eg:- nfd.v4.18.0 replaced by
+// nfd.v4.19.0 while OLM has not yet moved it to the Replacing phase.
+func withoutReplaced(csvs []operatorsv1alpha1.ClusterServiceVersion) []operatorsv1alpha1.ClusterServiceVersion {
+	replaced := map[string]bool{}
+	for _, csv := range csvs {
+		if csv.Spec.Replaces != "" {
+			replaced[csv.Spec.Replaces] = true
+		}
+	}
+
+	kept := []operatorsv1alpha1.ClusterServiceVersion{}
+	for _, csv := range csvs {
+		if replaced[csv.Name] || csv.Status.Phase == operatorsv1alpha1.CSVPhaseReplacing || csv.Status.Phase == operatorsv1alpha1.CSVPhaseDeleting {
+			continue
+		}
+		kept = append(kept, csv)
+	}
+
+	return kept
+}
+
+// matchingCSVs returns the CSVs belonging to the same operator package as the installed CSV.
+// CSV names follow the "<package>.<version>" convention, so the package is matched by name prefix.
+// CSVs copied from other namespaces by OLM are ignored.
+func matchingCSVs(csvs []operatorsv1alpha1.ClusterServiceVersion, installedCSV string) []operatorsv1alpha1.ClusterServiceVersion {
+	pkg, _, _ := strings.Cut(installedCSV, ".")
+	prefix := pkg + "."
+
+	matching := []operatorsv1alpha1.ClusterServiceVersion{}
+	for _, csv := range csvs {
+		if csv.IsCopied() {
+			continue
+		}
+		if csv.Name == installedCSV || strings.HasPrefix(csv.Name, prefix) {
+			matching = append(matching, csv)
+		}
+	}
+
+	return matching
+}
+
+// formatCSVPhases renders the given CSVs along with their phases. Eg:- "nfd.v4.18 (Failed), nfd.v4.19 (Succeeded)".
+func formatCSVPhases(csvs []operatorsv1alpha1.ClusterServiceVersion) string {
+	parts := make([]string, 0, len(csvs))
+	for _, csv := range csvs {
+		parts = append(parts, fmt.Sprintf("%s (%s)", csv.Name, csv.Status.Phase))
+	}
+
+	return strings.Join(parts, ", ")
+}
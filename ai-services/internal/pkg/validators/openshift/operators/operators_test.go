@@ -0,0 +1,317 @@
+package operators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	operatorsv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+)
+
+func newCSV(name string, phase operatorsv1alpha1.ClusterServiceVersionPhase, reason operatorsv1alpha1.ConditionReason) operatorsv1alpha1.ClusterServiceVersion {
+	return operatorsv1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     operatorsv1alpha1.ClusterServiceVersionStatus{Phase: phase, Reason: reason},
+	}
+}
+
+func TestMatchingCSVs(t *testing.T) {
+	tests := []struct {
+		name      string
+		csvs      []operatorsv1alpha1.ClusterServiceVersion
+		installed string
+		want      []string
+	}{
+		{
+			name: "single csv",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+			},
+			installed: "nfd.v4.19.0",
+			want:      []string{"nfd.v4.19.0"},
+		},
+		{
+			name: "failed old version alongside the installed one",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseFailed, ""),
+				newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+			},
+			installed: "nfd.v4.19.0",
+			want:      []string{"nfd.v4.18.0", "nfd.v4.19.0"},
+		},
+		{
+			name: "other operators in the namespace are ignored",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+				newCSV("nfd-extra.v1.0.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+				newCSV("cert-manager.v1.16.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+			},
+			installed: "nfd.v4.19.0",
+			want:      []string{"nfd.v4.19.0"},
+		},
+		{
+			name: "copied csvs are ignored",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseSucceeded, operatorsv1alpha1.CSVReasonCopied),
+				newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+			},
+			installed: "nfd.v4.19.0",
+			want:      []string{"nfd.v4.19.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matching := matchingCSVs(tt.csvs, tt.installed)
+			got := make([]string, 0, len(matching))
+			for _, csv := range matching {
+				got = append(got, csv.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("matchingCSVs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithoutReplaced(t *testing.T) {
+	replacing := func(name, replaces string, phase operatorsv1alpha1.ClusterServiceVersionPhase) operatorsv1alpha1.ClusterServiceVersion {
+		csv := newCSV(name, phase, "")
+		csv.Spec.Replaces = replaces
+
+		return csv
+	}
+
+	tests := []struct {
+		name string
+		csvs []operatorsv1alpha1.ClusterServiceVersion
+		want []string
+	}{
+		{
+			name: "upgrade in progress",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseReplacing, ""),
+				replacing("nfd.v4.19.0", "nfd.v4.18.0", operatorsv1alpha1.CSVPhaseInstalling),
+			},
+			want: []string{"nfd.v4.19.0"},
+		},
+		{
+			name: "replaced csv not yet in the replacing phase",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+				replacing("nfd.v4.19.0", "nfd.v4.18.0", operatorsv1alpha1.CSVPhaseSucceeded),
+			},
+			want: []string{"nfd.v4.19.0"},
+		},
+		{
+			name: "replaced csv being deleted",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.17.0", operatorsv1alpha1.CSVPhaseDeleting, ""),
+				newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+			},
+			want: []string{"nfd.v4.19.0"},
+		},
+		{
+			name: "unrelated failed csv still conflicts",
+			csvs: []operatorsv1alpha1.ClusterServiceVersion{
+				newCSV("nfd.v4.16.0", operatorsv1alpha1.CSVPhaseFailed, ""),
+				replacing("nfd.v4.19.0", "nfd.v4.18.0", operatorsv1alpha1.CSVPhaseSucceeded),
+			},
+			want: []string{"nfd.v4.16.0", "nfd.v4.19.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept := withoutReplaced(tt.csvs)
+			got := make([]string, 0, len(kept))
+			for _, csv := range kept {
+				got = append(got, csv.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("withoutReplaced() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatCSVPhases(t *testing.T) {
+	csvs := []operatorsv1alpha1.ClusterServiceVersion{
+		newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseFailed, ""),
+		newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, ""),
+	}
+
+	want := "nfd.v4.18.0 (Failed), nfd.v4.19.0 (Succeeded)"
+	if got := formatCSVPhases(csvs); got != want {
+		t.Errorf("formatCSVPhases() = %q, want %q", got, want)
+	}
+}
+
+func TestCheckCSVPhase(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  map[string]any
+		wantErr string
+	}{
+		{name: "succeeded", status: map[string]any{"phase": "Succeeded"}},
+		{name: "installing", status: map[string]any{"phase": "Installing"}, wantErr: "not ready (phase: Installing)"},
+		{name: "failed", status: map[string]any{"phase": "Failed"}, wantErr: "install failed (phase: Failed)"},
+		{name: "no phase", status: map[string]any{}, wantErr: "CSV nfd.v4.19.0: status.phase not found"},
+		{name: "no status", wantErr: "CSV nfd.v4.19.0: status.phase not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			csv := &unstructured.Unstructured{Object: map[string]any{}}
+			csv.SetName("nfd.v4.19.0")
+			if tt.status != nil {
+				csv.Object["status"] = tt.status
+			}
+
+			err := checkCSVPhase(csv)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkCSVPhase() error = %v, want none", err)
+				}
+
+				return
+			}
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("checkCSVPhase() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsUnrecoverable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "not installed", err: errNotInstalled, want: true},
+		{name: "install failed", err: fmt.Errorf("%w (phase: Failed)", errInstallFailed), want: true},
+		{name: "conflicting install", err: fmt.Errorf("%w, found 2 CSVs", errConflictingInstall), want: true},
+		{name: "not ready", err: errors.New("not ready (phase: Installing)"), want: false},
+		{name: "no CSV yet", err: errors.New("no CSV installed yet"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isUnrecoverable(tt.err); got != tt.want {
+				t.Errorf("isUnrecoverable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsOLMMissing(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "no matches for kind",
+			err:  &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "operators.coreos.com", Kind: "ClusterServiceVersion"}},
+			want: true,
+		},
+		{
+			name: "wrapped no matches for kind",
+			err:  fmt.Errorf("failed to get subscription: %w", &meta.NoResourceMatchError{PartialResource: schema.GroupVersionResource{Resource: "subscriptions"}}),
+			want: true,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isOLMMissing(tt.err); got != tt.want {
+				t.Errorf("isOLMMissing() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// pagedReader serves the CSVs in the given pages, following the continue token of the list requests.
+type pagedReader struct {
+	k8sClient.Reader
+	pages [][]operatorsv1alpha1.ClusterServiceVersion
+	calls int
+}
+
+func (r *pagedReader) List(_ context.Context, list k8sClient.ObjectList, opts ...k8sClient.ListOption) error {
+	lo := &k8sClient.ListOptions{}
+	lo.ApplyOptions(opts)
+	if lo.Limit != openshift.ListPageSize {
+		return fmt.Errorf("unexpected limit %d", lo.Limit)
+	}
+
+	page := 0
+	if lo.Continue != "" {
+		var err error
+		if page, err = strconv.Atoi(lo.Continue); err != nil {
+			return err
+		}
+	}
+	r.calls++
+
+	csvList := list.(*operatorsv1alpha1.ClusterServiceVersionList)
+	csvList.Items = r.pages[page]
+	csvList.Continue = ""
+	if page+1 < len(r.pages) {
+		csvList.Continue = strconv.Itoa(page + 1)
+	}
+
+	return nil
+}
+
+func TestListMatchingCSVs(t *testing.T) {
+	reader := &pagedReader{pages: [][]operatorsv1alpha1.ClusterServiceVersion{
+		{newCSV("cert-manager.v1.16.0", operatorsv1alpha1.CSVPhaseSucceeded, ""), newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseFailed, "")},
+		{newCSV("rhods-operator.v2.19.0", operatorsv1alpha1.CSVPhaseSucceeded, "")},
+		{newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, "")},
+	}}
+
+	matching, err := listMatchingCSVs(context.Background(), reader, "openshift-nfd", "nfd.v4.19.0")
+	if err != nil {
+		t.Fatalf("listMatchingCSVs() unexpected error: %v", err)
+	}
+	if reader.calls != len(reader.pages) {
+		t.Errorf("listMatchingCSVs() listed %d pages, want %d", reader.calls, len(reader.pages))
+	}
+	if got := formatCSVPhases(matching); got != "nfd.v4.18.0 (Failed), nfd.v4.19.0 (Succeeded)" {
+		t.Errorf("listMatchingCSVs() = %s, want the nfd CSVs of all the pages", got)
+	}
+}
+
+func TestListMatchingCSVsUpgrade(t *testing.T) {
+	// the CSV replacing the old version is on another page than the one it replaces
+	upgraded := newCSV("nfd.v4.19.0", operatorsv1alpha1.CSVPhaseSucceeded, "")
+	upgraded.Spec.Replaces = "nfd.v4.18.0"
+	reader := &pagedReader{pages: [][]operatorsv1alpha1.ClusterServiceVersion{
+		{newCSV("nfd.v4.18.0", operatorsv1alpha1.CSVPhaseSucceeded, "")},
+		{upgraded},
+	}}
+
+	matching, err := listMatchingCSVs(context.Background(), reader, "openshift-nfd", "nfd.v4.19.0")
+	if err != nil {
+		t.Fatalf("listMatchingCSVs() unexpected error: %v", err)
+	}
+	if got := formatCSVPhases(matching); got != "nfd.v4.19.0 (Succeeded)" {
+		t.Errorf("listMatchingCSVs() = %s, want only the CSV of the upgrade", got)
+	}
+}
@@ -5,6 +5,8 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -28,6 +30,11 @@ func (r *DataScienceCluster) Name() string {
 	return "dsc"
 }
 
+// DependsOn requires the DSCInitialization, which the DataScienceCluster is reconciled after.
+func (r *DataScienceCluster) DependsOn() []string {
+	return []string{"dsci"}
+}
+
 func (r *DataScienceCluster) Description() string {
 	return "Validates that Data Science Cluster is in ready phase"
 }
@@ -54,13 +61,9 @@ func (r *DataScienceCluster) Verify() error {
 		return fmt.Errorf("failed to find %s: %w", dscName, err)
 	}
 
-	phase, found, err := unstructured.NestedString(obj.Object, "status", "phase")
+	phase, err := openshift.NestedString(obj.Object, "status", "phase")
 	if err != nil {
-		return fmt.Errorf("failed to parse status.phase from dsc: %w", err)
-	}
-
-	if !found {
-		return fmt.Errorf("DataScienceCluster status.phase not found")
+		return fmt.Errorf("DataScienceCluster %w", err)
 	}
 
 	if phase != "Ready" {
@@ -74,6 +77,11 @@ func (r *DataScienceCluster) Message() string {
 	return "Data Science Cluster is ready"
 }
 
+// RetryPolicy polls the readiness, which can still be progressing right after the configuration.
+func (r *DataScienceCluster) RetryPolicy() retry.Policy {
+	return retry.Readiness
+}
+
 func (r *DataScienceCluster) Level() constants.ValidationLevel {
 	return constants.ValidationLevelError
 }
@@ -81,3 +89,14 @@ func (r *DataScienceCluster) Level() constants.ValidationLevel {
 func (r *DataScienceCluster) Hint() string {
 	return "Run 'oc get DataScienceCluster and ensure status.phase is 'Ready'."
 }
+
+func (r *DataScienceCluster) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The DataScienceCluster deploys the components of Red Hat OpenShift AI serving the models of the applications.",
+		Remediation: []string{
+			"Check the phase with 'oc get datasciencecluster'.",
+			"Find the failing component in the conditions reported by 'oc describe datasciencecluster'.",
+			"Run 'ai-services bootstrap configure' to create it again.",
+		},
+	}
+}
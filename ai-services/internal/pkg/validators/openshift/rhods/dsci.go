@@ -5,6 +5,8 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -28,6 +30,11 @@ func (r *DSCInitialization) Name() string {
 	return "dsci"
 }
 
+// DependsOn requires the operators, as the DSCInitialization is one of the OpenShift AI operator resources.
+func (r *DSCInitialization) DependsOn() []string {
+	return []string{"operators"}
+}
+
 func (r *DSCInitialization) Description() string {
 	return "Validates that DSC Initialization is in ready state"
 }
@@ -54,13 +61,9 @@ func (r *DSCInitialization) Verify() error {
 		return fmt.Errorf("failed to find %s: %w", dsciName, err)
 	}
 
-	phase, found, err := unstructured.NestedString(obj.Object, "status", "phase")
+	phase, err := openshift.NestedString(obj.Object, "status", "phase")
 	if err != nil {
-		return fmt.Errorf("failed to parse status.phase from dsci: %w", err)
-	}
-
-	if !found {
-		return fmt.Errorf("DSCInitialization status.phase not found")
+		return fmt.Errorf("DSCInitialization %w", err)
 	}
 
 	if phase != "Ready" {
@@ -74,6 +77,11 @@ func (r *DSCInitialization) Message() string {
 	return "DSC Initialization is ready"
 }
 
+// RetryPolicy polls the readiness, which can still be progressing right after the configuration.
+func (r *DSCInitialization) RetryPolicy() retry.Policy {
+	return retry.Readiness
+}
+
 func (r *DSCInitialization) Level() constants.ValidationLevel {
 	return constants.ValidationLevelError
 }
@@ -81,3 +89,14 @@ func (r *DSCInitialization) Level() constants.ValidationLevel {
 func (r *DSCInitialization) Hint() string {
 	return "Run 'oc get DSCInitialization and ensure status.phase is 'Ready'."
 }
+
+func (r *DSCInitialization) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The DSCInitialization sets up the common resources of Red Hat OpenShift AI, it must be ready for the DataScienceCluster to be.",
+		Remediation: []string{
+			"Check the phase with 'oc get dscinitialization'.",
+			"Find the failing component in the conditions reported by 'oc describe dscinitialization'.",
+			"Run 'ai-services bootstrap configure' to create it again.",
+		},
+	}
+}
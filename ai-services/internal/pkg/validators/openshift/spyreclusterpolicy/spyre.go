@@ -5,6 +5,8 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -28,11 +30,16 @@ func (r *SpyrePolicyRule) Name() string {
 	return "scp"
 }
 
+// DependsOn requires the operators, as the SpyreClusterPolicy is one of their resources.
+func (r *SpyrePolicyRule) DependsOn() []string {
+	return []string{"operators"}
+}
+
 func (r *SpyrePolicyRule) Description() string {
 	return "Validates that Spyre Cluster Policy is in ready state"
 }
 
-// Verify performs a direct check without polling.
+// Verify performs a direct check, the runner polls it as per RetryPolicy.
 func (r *SpyrePolicyRule) Verify() error {
 	client, err := openshift.NewOpenshiftClient()
 	if err != nil {
@@ -54,13 +61,9 @@ func (r *SpyrePolicyRule) Verify() error {
 		return fmt.Errorf("failed to find %s: %w", spyreName, err)
 	}
 
-	state, found, err := unstructured.NestedString(obj.Object, "status", "state")
+	state, err := openshift.NestedString(obj.Object, "status", "state")
 	if err != nil {
-		return fmt.Errorf("failed to parse status.state from policy: %w", err)
-	}
-
-	if !found {
-		return fmt.Errorf("SpyreClusterPolicy status.state not found")
+		return fmt.Errorf("SpyreClusterPolicy %w", err)
 	}
 
 	if state != "ready" {
@@ -74,6 +77,11 @@ func (r *SpyrePolicyRule) Message() string {
 	return "Spyre Cluster Policy is ready"
 }
 
+// RetryPolicy polls the readiness, which can still be progressing right after the configuration.
+func (r *SpyrePolicyRule) RetryPolicy() retry.Policy {
+	return retry.Readiness
+}
+
 func (r *SpyrePolicyRule) Level() constants.ValidationLevel {
 	return constants.ValidationLevelError
 }
@@ -81,3 +89,15 @@ func (r *SpyrePolicyRule) Level() constants.ValidationLevel {
 func (r *SpyrePolicyRule) Hint() string {
 	return "Run 'oc get spyreclusterpolicy and ensure status.state is 'ready'."
 }
+
+func (r *SpyrePolicyRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The SpyreClusterPolicy drives the Spyre operator, which installs the drivers and the device plugin exposing the Spyre cards to the pods. " +
+			"Until it is ready, the cards cannot be requested by the model servers.",
+		Remediation: []string{
+			"Check the state of the policy with 'oc get spyreclusterpolicy -o yaml'.",
+			"Check the pods of the Spyre operator for errors with 'oc get pods -A | grep spyre'.",
+			"Run 'ai-services bootstrap configure' to create the policy again.",
+		},
+	}
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/openshift"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 	storagev1 "k8s.io/api/storage/v1"
 )
 
@@ -67,3 +68,15 @@ func (r *StorageClassRule) Level() constants.ValidationLevel {
 func (r *StorageClassRule) Hint() string {
 	return "Ensure a StorageClass is marked as default using annotation: storageclass.kubernetes.io/is-default-class=true"
 }
+
+func (r *StorageClassRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The applications store their models and data on persistent volumes claimed without a storage class, " +
+			"which are only provisioned when a StorageClass is the default one.",
+		Remediation: []string{
+			"List the storage classes with 'oc get storageclass'.",
+			"Mark one as the default with 'oc annotate storageclass <name> storageclass.kubernetes.io/is-default-class=true'.",
+			"When there is none, install a storage provider first, Eg:- OpenShift Data Foundation or the LVM Storage operator.",
+		},
+	}
+}
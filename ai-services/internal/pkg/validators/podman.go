@@ -3,9 +3,15 @@ package validators
 import (
 	"fmt"
 	"os/exec"
+	"strings"
 
+	"github.com/containers/podman/v5/pkg/bindings/images"
 	"github.com/containers/podman/v5/pkg/bindings/system"
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
 // Podman checks if podman is installed and available in PATH.
@@ -18,7 +24,7 @@ func Podman() (string, error) {
 	return path, nil
 }
 
-// PodmanHealthCheck verifies podman is working.
+// PodmanHealthCheck verifies podman is working, on a host able to run the AI Services images.
 func PodmanHealthCheck() error {
 	client, err := podman.NewPodmanClient()
 	if err != nil {
@@ -34,5 +40,37 @@ func PodmanHealthCheck() error {
 		return fmt.Errorf("podman health check failed (invalid version info)")
 	}
 
+	return checkArch(version.Server.OsArch, toolImageArch(client))
+}
+
+// toolImageArch returns the architecture of the local tool image, or else the one the images are built for.
+func toolImageArch(client *podman.PodmanClient) string {
+	report, err := images.GetImage(client.Context, vars.ToolImage, nil)
+	if err != nil || report.ImageData == nil || report.Architecture == "" {
+		return platform.ArchPower
+	}
+
+	return report.Architecture
+}
+
+// checkArch fails when the podman host cannot run the images of the given architecture,
+// which would otherwise only fail at container start with an "exec format error".
+// hostOsArch is the os/arch reported by the podman service, which may run on a remote host,
+// the platform of the local host is assumed when the service does not report it.
+func checkArch(hostOsArch, imgArch string) error {
+	_, hostArch, found := strings.Cut(hostOsArch, "/")
+	if !found {
+		hostArch = hostOsArch
+	}
+	if hostArch == "" {
+		hostArch = platform.Get().Arch
+	}
+	logger.Infof("Podman host architecture: %s, tool image architecture: %s\n", hostArch, imgArch, logger.VerbosityLevelDebug)
+
+	if hostArch != imgArch {
+		return errhints.Mark(fmt.Errorf("podman host architecture %s does not match the architecture %s of the tool image %s",
+			hostArch, imgArch, vars.ToolImage), errhints.ErrArchMismatch)
+	}
+
 	return nil
 }
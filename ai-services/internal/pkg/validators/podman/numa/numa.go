@@ -2,56 +2,72 @@ package numa
 
 import (
 	"fmt"
-	"os/exec"
-	"strconv"
-	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/affinity"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/style"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
-type NumaRule struct{}
+// AffinityMetric is the measurement of the LPAR affinity percentage.
+const AffinityMetric = "lpar_affinity_percent"
+
+type NumaRule struct {
+	fs      hostfs.FS
+	percent int
+	// measured is set once the affinity was computed.
+	measured bool
+}
 
 func NewNumaRule() *NumaRule {
-	return &NumaRule{}
+	return &NumaRule{fs: hostfs.OS}
 }
 
+// ReadsHost marks the rule as able to verify a remote host, the NUMA topology being read from sysfs.
+func (r *NumaRule) ReadsHost() {}
+
 func (r *NumaRule) Name() string {
 	return "numa"
 }
 
 func (r *NumaRule) Description() string {
-	return "Validates that the NUMA node alignment on LPAR is set to 1 for optimal performance."
+	return "Validates that the CPUs and memory of the LPAR are aligned to a single NUMA node for optimal performance."
 }
 
 func (r *NumaRule) Verify() error {
 	logger.Infoln("Validating NUMA node alignment on LPAR", logger.VerbosityLevelDebug)
-	cmd := `lscpu | grep -i "NUMA node(s)"`
-	out, err := exec.Command("bash", "-c", cmd).Output()
-	if err != nil {
-		return fmt.Errorf("failed to execute lscpu command: %w", err)
-	}
-
-	fields := strings.Fields(string(out))
-	if len(fields) == 0 {
-		return fmt.Errorf("failed to get NUMA node fields")
-	}
-
-	numaVal := fields[len(fields)-1]
-	numaCount, err := strconv.Atoi(numaVal)
+	r.measured = false
+	percent, detail, err := affinity.ComputeLparAffinityFS(r.fs)
 	if err != nil {
-		return fmt.Errorf("error extracting numa count: %w", err)
+		return fmt.Errorf("failed to compute the LPAR affinity: %w", err)
 	}
+	r.percent = percent
+	r.measured = true
+	logger.Infof("LPAR affinity: %d%% (CPU: %d%%, memory: %d%%), %s\n",
+		percent, detail.CPUPercent, detail.MemoryPercent, detail, logger.VerbosityLevelDebug)
 
-	if numaCount != 1 {
-		return fmt.Errorf(`current NUMA node configuration (%d) is not aligned for maximum efficiency. For optimal performance, ensure that all CPUs are aligned to a single NUMA node`, numaCount)
+	if percent < vars.LparAffinityThreshold {
+		return fmt.Errorf(`current LPAR affinity (%d%%) is below %d%%, the LPAR is not aligned for maximum efficiency (%s). For optimal performance, ensure that all CPUs are aligned to a single NUMA node`,
+			percent, vars.LparAffinityThreshold, detail)
 	}
 
 	return nil
 }
 
 func (r *NumaRule) Message() string {
-	return "NUMA node alignment on LPAR: 1"
+	return fmt.Sprintf("NUMA node alignment on LPAR: %d%% affinity", r.percent)
+}
+
+// Measurements returns the LPAR affinity percentage, none when it could not be computed.
+func (r *NumaRule) Measurements() map[string]float64 {
+	if !r.measured {
+		return nil
+	}
+
+	return map[string]float64{AffinityMetric: float64(r.percent)}
 }
 
 func (r *NumaRule) Level() constants.ValidationLevel {
@@ -60,7 +76,20 @@ func (r *NumaRule) Level() constants.ValidationLevel {
 
 func (r *NumaRule) Hint() string {
 	return fmt.Sprintf(`This tools requires numa node alignment set to 1 on LPAR. For optimal performance, ensure that all CPUs are aligned to a single NUMA node.
-For detailed instructions and best practices on NUMA configuration, please refer to %shttps://www.ibm.com/docs/aiservices?topic=installation-chip-alignment-in-lpar%s`,
-		"\033[34m",
-		"\033[0m")
+For detailed instructions and best practices on NUMA configuration, please refer to %s`,
+		style.Hint().Render("https://www.ibm.com/docs/aiservices?topic=installation-chip-alignment-in-lpar"))
+}
+
+func (r *NumaRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The inference of the models is bound by the memory accesses. CPUs and memory spread over several NUMA nodes of the Power11 system " +
+			"pay the latency of the remote accesses, lowering the throughput of the model servers. The LPAR affinity is the share of the CPUs " +
+			"and memory of the LPAR placed on its home NUMA node.",
+		Remediation: []string{
+			"Check the placement of the CPUs and memory of the LPAR with 'numactl --hardware'.",
+			"From the HMC, run the Dynamic Platform Optimizer, Eg:- 'optmem -m <system> -o start -t affinity', or restart the LPAR for the hypervisor to place it again.",
+			"Size the CPUs and memory of the LPAR to fit a single chip, see https://www.ibm.com/docs/aiservices?topic=installation-chip-alignment-in-lpar.",
+			"Run 'ai-services bootstrap validate' again once the LPAR is placed again.",
+		},
+	}
 }
@@ -0,0 +1,56 @@
+package numa
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func TestNumaRuleVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   hostfs.Fake
+		wantErr bool
+	}{
+		{
+			name: "aligned to a single node",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "0-15\n",
+				"/sys/devices/system/node/node0/meminfo": "Node 0 MemTotal:       6147400 kB\n",
+			},
+		},
+		{
+			name: "affinity above the threshold",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "0-8\n",
+				"/sys/devices/system/node/node0/meminfo": "Node 0 MemTotal:       9000 kB\n",
+				"/sys/devices/system/node/node1/cpulist": "9\n",
+				"/sys/devices/system/node/node1/meminfo": "Node 1 MemTotal:       1000 kB\n",
+			},
+		},
+		{
+			name: "affinity below the threshold",
+			files: hostfs.Fake{
+				"/sys/devices/system/node/node0/cpulist": "0-3\n",
+				"/sys/devices/system/node/node0/meminfo": "Node 0 MemTotal:       4000 kB\n",
+				"/sys/devices/system/node/node1/cpulist": "4-7\n",
+				"/sys/devices/system/node/node1/meminfo": "Node 1 MemTotal:       4000 kB\n",
+			},
+			wantErr: true,
+		},
+		{
+			name:    "missing sysfs",
+			files:   hostfs.Fake{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &NumaRule{fs: tt.files}
+			if err := r.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
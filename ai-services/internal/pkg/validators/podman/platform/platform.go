@@ -2,20 +2,28 @@ package platform
 
 import (
 	"fmt"
-	"os"
 	"strconv"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 )
 
-type PlatformRule struct{}
+type PlatformRule struct {
+	fs hostfs.FS
+	// version is the RHEL release found by the last verification, empty when it could not be read.
+	version string
+}
 
 func NewPlatformRule() *PlatformRule {
-	return &PlatformRule{}
+	return &PlatformRule{fs: hostfs.OS}
 }
 
+// ReadsHost marks the rule as able to verify a remote host, the release being read from /etc/os-release.
+func (r *PlatformRule) ReadsHost() {}
+
 func (r *PlatformRule) Name() string {
 	return "rhel"
 }
@@ -26,8 +34,9 @@ func (r *PlatformRule) Description() string {
 
 func (r *PlatformRule) Verify() error {
 	logger.Infoln("Validating operating system...", logger.VerbosityLevelDebug)
+	r.version = ""
 
-	data, err := os.ReadFile("/etc/os-release")
+	data, err := r.fs.ReadFile("/etc/os-release")
 	if err != nil {
 		return err
 	}
@@ -47,6 +56,7 @@ func (r *PlatformRule) Verify() error {
 	if err != nil {
 		return err
 	}
+	r.version = version
 
 	parts := strings.Split(version, ".")
 	major, _ := strconv.Atoi(parts[0])
@@ -80,6 +90,15 @@ func fetchRhelVersion(osInfo string) (string, error) {
 	return version, nil
 }
 
+// Versions returns the RHEL release, none when it could not be read.
+func (r *PlatformRule) Versions() map[string]string {
+	if r.version == "" {
+		return nil
+	}
+
+	return map[string]string{"rhel": r.version}
+}
+
 func (r *PlatformRule) Message() string {
 	return "The LPAR is running a supported version of the operating system (RHEL 9.6 or higher)."
 }
@@ -91,3 +110,15 @@ func (r *PlatformRule) Level() constants.ValidationLevel {
 func (r *PlatformRule) Hint() string {
 	return "This tool requires RHEL version 9.6, please install or upgrade to a supported platform"
 }
+
+func (r *PlatformRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The AI Services images and the Spyre software stack, including the vfio drivers of the cards, are built and supported for RHEL 9.6 and later. " +
+			"On another distribution or an older release, the cards may not be usable by the applications.",
+		Remediation: []string{
+			"Check the operating system with 'cat /etc/os-release'.",
+			"Install RHEL 9.6 or later on the LPAR, or upgrade it with 'dnf upgrade --releasever=<version>'.",
+			"Reboot the LPAR to run the upgraded kernel, then validate again.",
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package platform
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func TestPlatformRuleVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   hostfs.Fake
+		wantErr bool
+		// wantVersion is the RHEL release recorded in the report, none when it could not be read
+		wantVersion string
+	}{
+		{
+			name:        "rhel 9.6",
+			files:       hostfs.Fake{"/etc/os-release": "NAME=\"Red Hat Enterprise Linux\"\nID=\"rhel\"\nVERSION_ID=\"9.6\"\n"},
+			wantVersion: "9.6",
+		},
+		{
+			name:        "rhel 10.0",
+			files:       hostfs.Fake{"/etc/os-release": "ID=rhel\nVERSION_ID=\"10.0\"\n"},
+			wantVersion: "10.0",
+		},
+		{
+			name:        "rhel major version only",
+			files:       hostfs.Fake{"/etc/os-release": "ID=rhel\nVERSION_ID=10\n"},
+			wantVersion: "10",
+		},
+		{
+			name:        "rhel 9.4 is too old",
+			files:       hostfs.Fake{"/etc/os-release": "ID=\"rhel\"\nVERSION_ID=\"9.4\"\n"},
+			wantErr:     true,
+			wantVersion: "9.4",
+		},
+		{
+			name:    "not rhel",
+			files:   hostfs.Fake{"/etc/os-release": "NAME=\"Ubuntu\"\nID=ubuntu\nVERSION_ID=\"24.04\"\n"},
+			wantErr: true,
+		},
+		{
+			name:    "missing version",
+			files:   hostfs.Fake{"/etc/os-release": "ID=\"rhel\"\n"},
+			wantErr: true,
+		},
+		{
+			name:    "missing os-release",
+			files:   hostfs.Fake{},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PlatformRule{fs: tt.files}
+			if err := r.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := r.Versions()["rhel"]; got != tt.wantVersion {
+				t.Errorf("Versions() rhel = %q, want %q", got, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func TestFetchRhelVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		osInfo  string
+		want    string
+		wantErr bool
+	}{
+		{name: "quoted", osInfo: "ID=rhel\nVERSION_ID=\"9.6\"\nPLATFORM_ID=\"platform:el9\"\n", want: "9.6"},
+		{name: "unquoted last line", osInfo: "ID=rhel\nVERSION_ID=9.7", want: "9.7"},
+		{name: "absent", osInfo: "ID=rhel\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fetchRhelVersion(tt.osInfo)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("fetchRhelVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("fetchRhelVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
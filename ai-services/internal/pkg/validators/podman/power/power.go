@@ -1,21 +1,27 @@
 package power
 
 import (
+	"errors"
 	"fmt"
-	"os"
-	"runtime"
-	"strings"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 )
 
-type PowerRule struct{}
+type PowerRule struct {
+	platform func() platform.Info
+}
 
 func NewPowerRule() *PowerRule {
-	return &PowerRule{}
+	return &PowerRule{platform: platform.Get}
 }
 
+// ReadsHost marks the rule as able to verify a remote host, the processor being read from /proc/cpuinfo.
+func (r *PowerRule) ReadsHost() {}
+
 func (r *PowerRule) Name() string {
 	return "power"
 }
@@ -27,16 +33,16 @@ func (r *PowerRule) Description() string {
 func (r *PowerRule) Verify() error {
 	logger.Infoln("Validating IBM Power version...", logger.VerbosityLevelDebug)
 
-	if runtime.GOARCH != "ppc64le" {
-		return fmt.Errorf("unsupported architecture: %s. IBM Power architecture (ppc64le) is required", runtime.GOARCH)
+	info := r.platform()
+	if info.Arch != platform.ArchPower {
+		return errhints.Mark(fmt.Errorf("unsupported architecture: %s. IBM Power architecture (ppc64le) is required", info.Arch), errhints.ErrUnsupportedPower)
 	}
 
-	data, err := os.ReadFile("/proc/cpuinfo")
-	if err == nil && strings.Contains(strings.ToLower(string(data)), "power11") {
+	if info.Power11 {
 		return nil
 	}
 
-	return fmt.Errorf("unsupported IBM Power version: Power11 is required")
+	return errhints.Mark(errors.New("unsupported IBM Power version: Power11 is required"), errhints.ErrUnsupportedPower)
 }
 
 func (r *PowerRule) Message() string {
@@ -50,3 +56,14 @@ func (r *PowerRule) Level() constants.ValidationLevel {
 func (r *PowerRule) Hint() string {
 	return "This tools requires IBM Power11 (ppc64le)"
 }
+
+func (r *PowerRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The IBM Spyre Accelerator is supported on IBM Power11 only, and the AI Services images are built for ppc64le. " +
+			"They do not run on another architecture, nor use the Spyre cards on an earlier Power generation.",
+		Remediation: []string{
+			"Check the processor with 'grep cpu /proc/cpuinfo', it must report Power11.",
+			"Run AI Services on an LPAR of an IBM Power11 system.",
+		},
+	}
+}
@@ -0,0 +1,50 @@
+package power
+
+import (
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/bootstrap/platform"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func TestPowerRuleVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		arch    string
+		files   hostfs.Fake
+		wantErr bool
+	}{
+		{
+			name:  "power11",
+			arch:  "ppc64le",
+			files: hostfs.Fake{"/proc/cpuinfo": "processor\t: 0\ncpu\t\t: Power11 (architected), altivec supported\n"},
+		},
+		{
+			name:    "power10",
+			arch:    "ppc64le",
+			files:   hostfs.Fake{"/proc/cpuinfo": "processor\t: 0\ncpu\t\t: POWER10 (architected), altivec supported\n"},
+			wantErr: true,
+		},
+		{
+			name:    "missing cpuinfo",
+			arch:    "ppc64le",
+			files:   hostfs.Fake{},
+			wantErr: true,
+		},
+		{
+			name:    "not ppc64le",
+			arch:    "amd64",
+			files:   hostfs.Fake{"/proc/cpuinfo": "cpu\t\t: Power11\n"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &PowerRule{platform: func() platform.Info { return platform.Detect(tt.files, tt.arch) }}
+			if err := r.Verify(); (err != nil) != tt.wantErr {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
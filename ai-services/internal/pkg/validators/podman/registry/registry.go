@@ -0,0 +1,152 @@
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/containers/image/v5/pkg/sysregistriesv2"
+	"github.com/containers/image/v5/types"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/utils"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+)
+
+const reachabilityTimeout = 10 * time.Second
+
+type RegistryRule struct {
+	// sys selects the registries configuration to read, nil reads the host defaults used by podman.
+	sys *types.SystemContext
+	// probe checks whether the given registry host can be reached.
+	probe func(host string) error
+
+	mirrors     []string
+	unreachable bool
+}
+
+func NewRegistryRule() *RegistryRule {
+	return &RegistryRule{probe: probeRegistry}
+}
+
+func (r *RegistryRule) Name() string {
+	return "registry"
+}
+
+func (r *RegistryRule) Description() string {
+	return "Validates that the ai-services image registry is reachable or has a mirror configured in registries.conf."
+}
+
+func (r *RegistryRule) Verify() error {
+	logger.Infoln("Validating access to the image registry...", logger.VerbosityLevelDebug)
+	r.mirrors, r.unreachable = nil, false
+
+	reg, err := sysregistriesv2.FindRegistry(r.sys, constants.ImageRegistry)
+	if err != nil {
+		return fmt.Errorf("failed to read the registries configuration: %w", err)
+	}
+
+	if reg != nil {
+		if reg.Blocked {
+			return fmt.Errorf("pulling from '%s' is blocked in the registries configuration", reg.Prefix)
+		}
+		r.mirrors = mirrorLocations(reg)
+	}
+
+	if len(r.mirrors) > 0 {
+		logger.Infof("Mirrors configured for %s: %s\n", constants.ImageRegistry, strings.Join(r.mirrors, ", "), logger.VerbosityLevelDebug)
+
+		return nil
+	}
+
+	host, _, _ := strings.Cut(constants.ImageRegistry, "/")
+	if err := r.probe(host); err != nil {
+		r.unreachable = true
+
+		return errhints.Mark(fmt.Errorf("no mirror is configured for '%s' and the registry is unreachable: %w", constants.ImageRegistry, err),
+			errhints.ErrRegistryUnreachable)
+	}
+
+	return nil
+}
+
+// mirrorLocations returns the locations the images get pulled from instead of the ai-services registry,
+// which are its mirrors and a rewritten location.
+func mirrorLocations(reg *sysregistriesv2.Registry) []string {
+	locations := []string{}
+	for _, mirror := range reg.Mirrors {
+		locations = append(locations, mirror.Location)
+	}
+
+	if reg.Location != "" && reg.Location != reg.Prefix {
+		locations = append(locations, reg.Location)
+	}
+
+	return locations
+}
+
+// probeRegistry checks that the registry API of host answers, any HTTP response counts as reachable.
+func probeRegistry(host string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), reachabilityTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+host+"/v2/", nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := utils.NewHTTPClient(reachabilityTimeout).Do(req)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
+			return fmt.Errorf("timed out after %s connecting to %s", reachabilityTimeout, host)
+		}
+
+		return err
+	}
+	defer resp.Body.Close()
+
+	return nil
+}
+
+func (r *RegistryRule) Message() string {
+	if len(r.mirrors) > 0 {
+		return fmt.Sprintf("Image registry %s is mirrored to %s", constants.ImageRegistry, strings.Join(r.mirrors, ", "))
+	}
+
+	return fmt.Sprintf("Image registry %s is reachable", constants.ImageRegistry)
+}
+
+func (r *RegistryRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelWarning
+}
+
+func (r *RegistryRule) Hint() string {
+	if !r.unreachable {
+		return "Review the [[registry]] entries for icr.io in /etc/containers/registries.conf and /etc/containers/registries.conf.d/."
+	}
+
+	return fmt.Sprintf(`The images cannot be pulled from %[1]s. On air-gapped hosts, mirror the images to a local registry and configure it in /etc/containers/registries.conf.d/, eg:-
+[[registry]]
+prefix = "%[1]s"
+location = "%[1]s"
+[[registry.mirror]]
+location = "<mirror-registry>/ai-services"`, constants.ImageRegistry)
+}
+
+func (r *RegistryRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: fmt.Sprintf("The images of the AI Services applications are pulled from %s while deploying. When the registry cannot be reached, "+
+			"Eg:- from an air-gapped LPAR, a mirror must be configured in registries.conf for the pulls to succeed.", constants.ImageRegistry),
+		Remediation: []string{
+			"Check the access to the registry with 'curl -I https://icr.io/v2/'.",
+			"Allow the LPAR to reach icr.io on port 443, or set HTTPS_PROXY to the proxy of the network.",
+			"On an air-gapped LPAR, mirror the images and add a [[registry]] entry for icr.io with the mirror location in /etc/containers/registries.conf.d/.",
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package registry
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/containers/image/v5/types"
+)
+
+func TestRegistryRuleVerify(t *testing.T) {
+	unreachable := func(string) error { return errors.New("dial tcp: no route to host") }
+	reachable := func(string) error { return nil }
+
+	tests := []struct {
+		name            string
+		conf            string
+		probe           func(string) error
+		wantErr         bool
+		wantMirrors     []string
+		wantMirrorsHint bool
+	}{
+		{
+			name: "mirror configured",
+			conf: `[[registry]]
+prefix = "icr.io/ai-services"
+location = "icr.io/ai-services"
+[[registry.mirror]]
+location = "mirror.local:5000/ai-services"
+`,
+			probe:       unreachable,
+			wantMirrors: []string{"mirror.local:5000/ai-services"},
+		},
+		{
+			name: "mirror configured for the whole registry",
+			conf: `[[registry]]
+location = "icr.io"
+[[registry.mirror]]
+location = "mirror.local:5000"
+`,
+			probe:       unreachable,
+			wantMirrors: []string{"mirror.local:5000"},
+		},
+		{
+			name: "location rewritten",
+			conf: `[[registry]]
+prefix = "icr.io/ai-services"
+location = "mirror.local:5000/ai-services"
+`,
+			probe:       unreachable,
+			wantMirrors: []string{"mirror.local:5000/ai-services"},
+		},
+		{
+			name:  "no mirror and registry reachable",
+			conf:  "unqualified-search-registries = [\"registry.access.redhat.com\"]\n",
+			probe: reachable,
+		},
+		{
+			name:            "no mirror and registry unreachable",
+			conf:            "",
+			probe:           unreachable,
+			wantErr:         true,
+			wantMirrorsHint: true,
+		},
+		{
+			name: "registry blocked",
+			conf: `[[registry]]
+location = "icr.io"
+blocked = true
+`,
+			probe:   reachable,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			confPath := filepath.Join(dir, "registries.conf")
+			if err := os.WriteFile(confPath, []byte(tt.conf), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			r := &RegistryRule{
+				sys: &types.SystemContext{
+					SystemRegistriesConfPath:    confPath,
+					SystemRegistriesConfDirPath: filepath.Join(dir, "registries.conf.d"),
+				},
+				probe: tt.probe,
+			}
+
+			err := r.Verify()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if strings.Join(r.mirrors, ",") != strings.Join(tt.wantMirrors, ",") {
+				t.Errorf("mirrors = %v, want %v", r.mirrors, tt.wantMirrors)
+			}
+			if hasHint := strings.Contains(r.Hint(), "[[registry.mirror]]"); hasHint != tt.wantMirrorsHint {
+				t.Errorf("Hint() suggests configuring a mirror = %v, want %v", hasHint, tt.wantMirrorsHint)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 )
 
 type RHNRule struct{}
@@ -19,6 +20,11 @@ func (r *RHNRule) Name() string {
 	return "rhn"
 }
 
+// DependsOn requires a RHEL host, as the registration is done with its subscription-manager.
+func (r *RHNRule) DependsOn() []string {
+	return []string{"rhel"}
+}
+
 func (r *RHNRule) Description() string {
 	return "Validates that the system is registered with Red Hat Network (RHN)."
 }
@@ -53,3 +59,14 @@ func (r *RHNRule) Level() constants.ValidationLevel {
 func (r *RHNRule) Hint() string {
 	return "Register your system with Red Hat Network using: subscription-manager register --username <username> --password <password> "
 }
+
+func (r *RHNRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The packages installed while configuring the LPAR come from the Red Hat repositories, which are only available to the registered systems.",
+		Remediation: []string{
+			"Check the registration with 'subscription-manager status'.",
+			"Register the system with 'subscription-manager register --username <username> --password <password>', or with an activation key.",
+			"Check that the repositories are enabled with 'subscription-manager repos --list-enabled'.",
+		},
+	}
+}
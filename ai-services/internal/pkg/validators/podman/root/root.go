@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 )
 
 type RootRule struct{}
@@ -28,7 +30,7 @@ func (r *RootRule) Verify() error {
 	logger.Infoln("Checking root privileges", logger.VerbosityLevelDebug)
 
 	if euid != 0 {
-		return fmt.Errorf("current user is not root (EUID: %d)", euid)
+		return errhints.Mark(fmt.Errorf("current user is not root (EUID: %d)", euid), errhints.ErrNotRoot)
 	}
 
 	return nil
@@ -45,3 +47,14 @@ func (r *RootRule) Level() constants.ValidationLevel {
 func (r *RootRule) Hint() string {
 	return "Run this command with root privileges using 'sudo' or as the root user"
 }
+
+func (r *RootRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "Configuring the LPAR loads kernel modules, binds the Spyre cards to vfio-pci and runs the applications with the rootful podman, " +
+			"which all require root privileges.",
+		Remediation: []string{
+			"Run the command as root, or prefix it with sudo, Eg:- sudo ai-services bootstrap validate.",
+			"If sudo is refused, ask the administrator of the LPAR to grant the sudo rights to your user, Eg:- by adding it to the wheel group.",
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package rootless
+
+import (
+	"fmt"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+)
+
+type RootlessRule struct {
+	detect func() (podman.Mode, error)
+	mode   podman.Mode
+}
+
+func NewRootlessRule() *RootlessRule {
+	return &RootlessRule{detect: podman.DetectMode}
+}
+
+func (r *RootlessRule) Name() string {
+	return "rootless"
+}
+
+func (r *RootlessRule) Description() string {
+	return "Validates that podman runs rootful, as required for the vfio passthrough of the Spyre cards."
+}
+
+func (r *RootlessRule) Verify() error {
+	logger.Infoln("Detecting the podman mode...", logger.VerbosityLevelDebug)
+	mode, err := r.detect()
+	if err != nil {
+		return fmt.Errorf("failed to detect the podman mode: %w", err)
+	}
+	r.mode = mode
+	logger.Infof("Podman mode: %s\n", mode, logger.VerbosityLevelDebug)
+
+	if mode == podman.ModeRootless {
+		return fmt.Errorf("podman is configured rootless for the current user, the Spyre vfio devices cannot be passed through to rootless containers")
+	}
+
+	return nil
+}
+
+func (r *RootlessRule) Message() string {
+	return fmt.Sprintf("Podman runs in %s mode", r.mode)
+}
+
+func (r *RootlessRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelWarning
+}
+
+func (r *RootlessRule) Hint() string {
+	return "Run ai-services as root (eg:- with sudo) using the rootful podman, " +
+		"and make sure CONTAINER_HOST does not point to a rootless podman socket under /run/user/<uid>."
+}
+
+func (r *RootlessRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The Spyre cards are passed through to the containers with vfio, which only the rootful podman can do. " +
+			"A rootless podman cannot access the /dev/vfio devices of the cards, so the model servers would start without their accelerators.",
+		Remediation: []string{
+			"Run ai-services as root, Eg:- with sudo, so that it uses the rootful podman socket /run/podman/podman.sock.",
+			"Unset CONTAINER_HOST, or point it to the rootful podman socket, when it points to a rootless socket under /run/user/<uid>.",
+			"Enable the rootful podman socket with 'systemctl enable --now podman.socket'.",
+		},
+	}
+}
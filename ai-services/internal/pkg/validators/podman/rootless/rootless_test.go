@@ -0,0 +1,36 @@
+package rootless
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/podman"
+)
+
+func TestRootlessRuleVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    podman.Mode
+		err     error
+		wantErr bool
+		wantMsg string
+	}{
+		{name: "rootful", mode: podman.ModeRootful, wantMsg: "Podman runs in rootful mode"},
+		{name: "rootless", mode: podman.ModeRootless, wantErr: true},
+		{name: "podman unavailable", err: errors.New("podman: command not found"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &RootlessRule{detect: func() (podman.Mode, error) { return tt.mode, tt.err }}
+
+			err := r.Verify()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantMsg != "" && r.Message() != tt.wantMsg {
+				t.Errorf("Message() = %q, want %q", r.Message(), tt.wantMsg)
+			}
+		})
+	}
+}
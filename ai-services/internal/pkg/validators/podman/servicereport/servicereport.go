@@ -4,9 +4,15 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/helpers"
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
 )
 
-type ServiceReportRule struct{}
+type ServiceReportRule struct {
+	// recommendations are the actions recommended by the last servicereport run.
+	recommendations []Recommendation
+	// version is the version of the servicereport tool found by the last verification, empty when it could not be read.
+	version string
+}
 
 func NewServiceReportRule() *ServiceReportRule {
 	return &ServiceReportRule{}
@@ -21,15 +27,41 @@ func (r *ServiceReportRule) Description() string {
 }
 
 func (r *ServiceReportRule) Verify() error {
+	version, err := checkTool()
+	r.version = version
+	if err != nil {
+		return err
+	}
+
 	logger.Infoln("Validating if ServiceReport tool has run on LPAR", logger.VerbosityLevelDebug)
-	if err := helpers.RunServiceReportContainer("servicereport -v -p spyre", "validate"); err != nil {
+	out, err := helpers.RunServiceReportContainer("servicereport -v -p spyre", "validate")
+	r.recommendations = ParseRecommendations(out)
+	if err != nil {
 		return err
 	}
 
 	return nil
 }
 
+// Recommendations returns the actions recommended by servicereport when the rule was last verified.
+func (r *ServiceReportRule) Recommendations() []Recommendation {
+	return r.recommendations
+}
+
+// Versions returns the version of the servicereport tool, none when it could not be read.
+func (r *ServiceReportRule) Versions() map[string]string {
+	if r.version == "" {
+		return nil
+	}
+
+	return map[string]string{"servicereport": r.version}
+}
+
 func (r *ServiceReportRule) Message() string {
+	if len(r.recommendations) > 0 {
+		return "ServiceReport tool has successfully run on the LPAR, it recommends:\n" + RenderRecommendations(r.recommendations)
+	}
+
 	return "ServiceReport tool has successfully run on the LPAR"
 }
 
@@ -38,5 +70,21 @@ func (r *ServiceReportRule) Level() constants.ValidationLevel {
 }
 
 func (r *ServiceReportRule) Hint() string {
+	if len(r.recommendations) > 0 {
+		return "ServiceReport recommends the following actions, apply them with `ai-services bootstrap configure --apply-recommendations`:\n" +
+			RenderRecommendations(r.recommendations)
+	}
+
 	return "ServiceReport tool needs to be run on LPAR, please use `ai-services bootstrap configure`"
 }
+
+func (r *ServiceReportRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "ServiceReport verifies and repairs the settings of the LPAR the Spyre cards depend on. " +
+			"The cards may fail at runtime on an LPAR it has not been run on.",
+		Remediation: []string{
+			"Run 'ai-services bootstrap configure', which runs ServiceReport and repairs the reported issues.",
+			"Run 'ai-services bootstrap validate' again to verify the LPAR.",
+		},
+	}
+}
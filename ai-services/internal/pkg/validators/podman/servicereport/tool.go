@@ -0,0 +1,111 @@
+package servicereport
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+// commandNotFoundExitCode is the exit code of podman run when the command is not found in the container.
+const commandNotFoundExitCode = 127
+
+var versionPattern = regexp.MustCompile(`\d+(?:\.\d+)+`)
+
+// runTool runs the servicereport tool of the tool image with the given args, returning its combined output.
+// It is a variable so that the tests can stub it.
+var runTool = func(args ...string) ([]byte, error) {
+	return exec.Command("podman", append([]string{"run", "--rm", vars.ToolImage, "servicereport"}, args...)...).CombinedOutput()
+}
+
+// CheckTool verifies that the servicereport tool is on the PATH of the tool image, at vars.MinServiceReportVersion or later.
+// The servicereport runs of both configure and validate depend on it.
+func CheckTool() error {
+	_, err := checkTool()
+
+	return err
+}
+
+// checkTool verifies the servicereport tool as CheckTool does, returning its version once it could be read.
+func checkTool() (string, error) {
+	out, err := runTool("--version")
+	if err != nil {
+		var exitErr *exec.ExitError
+		switch {
+		case errors.Is(err, exec.ErrNotFound):
+			return "", fmt.Errorf("podman is required to run the servicereport tool: %w", err)
+		case errors.As(err, &exitErr) && exitErr.ExitCode() == commandNotFoundExitCode:
+			return "", errhints.Mark(fmt.Errorf("servicereport tool is not found in the PATH of the tool image %s", vars.ToolImage),
+				errhints.ErrServiceReportUnavailable)
+		default:
+			return "", fmt.Errorf("failed to get the servicereport version: %w: %s", err, strings.TrimSpace(string(out)))
+		}
+	}
+
+	version := versionPattern.FindString(string(out))
+	if version == "" {
+		return "", fmt.Errorf("failed to read the servicereport version from '%s'", strings.TrimSpace(string(out)))
+	}
+	logger.Infof("servicereport version: %s\n", version, logger.VerbosityLevelDebug)
+
+	older, err := olderThan(version, vars.MinServiceReportVersion)
+	if err != nil {
+		return version, err
+	}
+	if older {
+		return version, errhints.Mark(fmt.Errorf("servicereport %s or later is required, found %s in the tool image %s",
+			vars.MinServiceReportVersion, version, vars.ToolImage), errhints.ErrServiceReportUnavailable)
+	}
+
+	return version, nil
+}
+
+// olderThan reports whether the dotted version is older than minimum, the missing numbers counting as 0.
+// Eg:- "2.2" is older than "2.2.4", while "2.10" is not older than "2.9".
+func olderThan(version, minimum string) (bool, error) {
+	got, err := parseVersion(version)
+	if err != nil {
+		return false, fmt.Errorf("invalid servicereport version: %w", err)
+	}
+	want, err := parseVersion(minimum)
+	if err != nil {
+		return false, fmt.Errorf("invalid minimum servicereport version: %w", err)
+	}
+
+	for i := range max(len(got), len(want)) {
+		g, w := at(got, i), at(want, i)
+		if g != w {
+			return g < w, nil
+		}
+	}
+
+	return false, nil
+}
+
+func parseVersion(version string) ([]int, error) {
+	parts := strings.Split(strings.TrimPrefix(version, "v"), ".")
+	nums := make([]int, 0, len(parts))
+	for _, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a dotted version, Eg:- 2.2.4", version)
+		}
+		nums = append(nums, n)
+	}
+
+	return nums, nil
+}
+
+func at(nums []int, i int) int {
+	if i < len(nums) {
+		return nums[i]
+	}
+
+	return 0
+}
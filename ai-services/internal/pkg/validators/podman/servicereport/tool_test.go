@@ -0,0 +1,78 @@
+package servicereport
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestCheckTool(t *testing.T) {
+	notFound := exec.Command("sh", "-c", "exit 127").Run()
+	failed := exec.Command("sh", "-c", "exit 1").Run()
+
+	tests := []struct {
+		name        string
+		out         string
+		err         error
+		minimum     string
+		wantErr     bool
+		wantMissing bool
+	}{
+		{name: "compatible version", out: "ServiceReport 2.2.4\n", minimum: "2.2"},
+		{name: "newer minor version", out: "servicereport version 2.10\n", minimum: "2.9"},
+		{name: "older version", out: "ServiceReport 2.1.9\n", minimum: "2.2", wantErr: true, wantMissing: true},
+		{name: "missing in the tool image", err: notFound, minimum: "2.2", wantErr: true, wantMissing: true},
+		{name: "podman missing", err: fmt.Errorf("exec: \"podman\": %w", exec.ErrNotFound), minimum: "2.2", wantErr: true},
+		{name: "tool failure", out: "error", err: failed, minimum: "2.2", wantErr: true},
+		{name: "unreadable version", out: "ServiceReport\n", minimum: "2.2", wantErr: true},
+		{name: "invalid minimum", out: "ServiceReport 2.2.4\n", minimum: "latest", wantErr: true},
+	}
+
+	origRun, origMin := runTool, vars.MinServiceReportVersion
+	t.Cleanup(func() { runTool, vars.MinServiceReportVersion = origRun, origMin })
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runTool = func(...string) ([]byte, error) { return []byte(tt.out), tt.err }
+			vars.MinServiceReportVersion = tt.minimum
+
+			err := CheckTool()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckTool() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := errors.Is(err, errhints.ErrServiceReportUnavailable); got != tt.wantMissing {
+				t.Errorf("CheckTool() error = %v, marked unavailable %v, want %v", err, got, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestOlderThan(t *testing.T) {
+	tests := []struct {
+		version, minimum string
+		want             bool
+	}{
+		{version: "2.2", minimum: "2.2"},
+		{version: "2.2", minimum: "2.2.0"},
+		{version: "2.2", minimum: "2.2.4", want: true},
+		{version: "2.10", minimum: "2.9"},
+		{version: "v3.0", minimum: "2.2"},
+		{version: "1.9.9", minimum: "2", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+"<"+tt.minimum, func(t *testing.T) {
+			got, err := olderThan(tt.version, tt.minimum)
+			if err != nil {
+				t.Fatalf("olderThan() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("olderThan(%q, %q) = %v, want %v", tt.version, tt.minimum, got, tt.want)
+			}
+		})
+	}
+}
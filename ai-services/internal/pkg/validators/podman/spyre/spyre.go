@@ -2,50 +2,111 @@ package spyre
 
 import (
 	"fmt"
-	"os/exec"
-	"strconv"
+	"path/filepath"
 	"strings"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
 	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
 )
 
-type SpyreRule struct{}
+const (
+	// PCI vendor and device IDs of the IBM Spyre Accelerator (lspci -d 1014:06a7).
+	spyreVendorID = "0x1014"
+	spyreDeviceID = "0x06a7"
+
+	pciDevicesGlob = "/sys/bus/pci/devices/*"
+
+	// CardsMetric is the measurement of the number of Spyre cards attached to the LPAR.
+	CardsMetric = "spyre_cards"
+)
+
+type SpyreRule struct {
+	fs hostfs.FS
+	// cards is the number of cards found, -1 until they are enumerated.
+	cards int
+}
 
 func NewSpyreRule() *SpyreRule {
-	return &SpyreRule{}
+	return &SpyreRule{fs: hostfs.OS, cards: -1}
 }
 
+// ReadsHost marks the rule as able to verify a remote host, the PCI devices being listed from sysfs.
+func (r *SpyreRule) ReadsHost() {}
+
 func (r *SpyreRule) Name() string {
 	return "spyre"
 }
 
+// DependsOn requires an IBM Power11 host, as the Spyre cards are only supported on it.
+func (r *SpyreRule) DependsOn() []string {
+	return []string{"power"}
+}
+
 func (r *SpyreRule) Description() string {
 	return "Validates that the IBM Spyre Accelerator is attached to the LPAR."
 }
 
 func (r *SpyreRule) Verify() error {
 	logger.Infoln("Validating Spyre attachment...", logger.VerbosityLevelDebug)
-	cmd := `lspci -k -d 1014:06a7 | wc -l`
-	out, err := exec.Command("bash", "-c", cmd).Output()
-	if err != nil {
-		return fmt.Errorf("❌ failed to execute lspci command %w", err)
-	}
-	cardsCount, err := strconv.Atoi(strings.TrimSpace(string(out)))
+	r.cards = -1
+	cards, err := ListDevices(r.fs)
 	if err != nil {
-		return fmt.Errorf("failed to parse spyre cards count: %w", err)
+		return fmt.Errorf("❌ failed to enumerate PCI devices %w", err)
 	}
-	if cardsCount == 0 {
+	r.cards = len(cards)
+	if len(cards) == 0 {
 		return fmt.Errorf("IBM Spyre Accelerator is not attached to the LPAR")
 	}
+	if len(cards) < vars.MinSpyreCards {
+		return fmt.Errorf("only %d IBM Spyre Accelerator cards are attached to the LPAR, the application template requires at least %d",
+			len(cards), vars.MinSpyreCards)
+	}
 
 	return nil
 }
 
+// ListDevices returns the PCI addresses of all the Spyre cards found under sysfs.
+func ListDevices(fsys hostfs.FS) ([]string, error) {
+	devices, err := fsys.Glob(pciDevicesGlob)
+	if err != nil {
+		return nil, err
+	}
+
+	cards := []string{}
+	for _, dev := range devices {
+		vendor, err := fsys.ReadFile(filepath.Join(dev, "vendor"))
+		if err != nil {
+			continue
+		}
+		device, err := fsys.ReadFile(filepath.Join(dev, "device"))
+		if err != nil {
+			continue
+		}
+
+		if strings.TrimSpace(string(vendor)) == spyreVendorID && strings.TrimSpace(string(device)) == spyreDeviceID {
+			cards = append(cards, filepath.Base(dev))
+		}
+	}
+
+	return cards, nil
+}
+
 func (r *SpyreRule) Message() string {
 	return "IBM Spyre Accelerator is attached to the LPAR"
 }
 
+// Measurements returns the number of Spyre cards attached to the LPAR, none when they could not be enumerated.
+func (r *SpyreRule) Measurements() map[string]float64 {
+	if r.cards < 0 {
+		return nil
+	}
+
+	return map[string]float64{CardsMetric: float64(r.cards)}
+}
+
 func (r *SpyreRule) Level() constants.ValidationLevel {
 	return constants.ValidationLevelError
 }
@@ -53,3 +114,15 @@ func (r *SpyreRule) Level() constants.ValidationLevel {
 func (r *SpyreRule) Hint() string {
 	return "IBM Spyre Accelerator hardware is required but not detected."
 }
+
+func (r *SpyreRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The model servers of the AI Services applications run their inference on the IBM Spyre Accelerator cards. " +
+			"Without a card attached to the LPAR, the applications cannot be deployed.",
+		Remediation: []string{
+			"List the Spyre cards seen by the LPAR with 'lspci -d 1014:06a7'.",
+			"From the HMC, assign the Spyre cards to the LPAR as dedicated I/O adapters, dynamically or in its profile.",
+			"Restart the LPAR when the cards were added to its profile, then validate again.",
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package spyre
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestSpyreRuleMinCards(t *testing.T) {
+	files := hostfs.Fake{
+		"/sys/bus/pci/devices/0182:70:00.0/vendor": "0x1014\n",
+		"/sys/bus/pci/devices/0182:70:00.0/device": "0x06a7\n",
+		"/sys/bus/pci/devices/0183:70:00.0/vendor": "0x1014\n",
+		"/sys/bus/pci/devices/0183:70:00.0/device": "0x06a7\n",
+	}
+	t.Cleanup(func() { vars.MinSpyreCards = 0 })
+
+	tests := []struct {
+		name     string
+		minCards int
+		wantErr  string
+	}{
+		{name: "no minimum", minCards: 0},
+		{name: "minimum met", minCards: 2},
+		{name: "minimum not met", minCards: 4, wantErr: "only 2 IBM Spyre Accelerator cards are attached to the LPAR, the application template requires at least 4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vars.MinSpyreCards = tt.minCards
+			err := (&SpyreRule{fs: files, cards: -1}).Verify()
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("Verify() error = %v, want nil", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestListDevices(t *testing.T) {
+	tests := []struct {
+		name  string
+		files hostfs.Fake
+		want  []string
+	}{
+		{
+			name: "two spyre cards among other devices",
+			files: hostfs.Fake{
+				"/sys/bus/pci/devices/0182:70:00.0/vendor": "0x1014\n",
+				"/sys/bus/pci/devices/0182:70:00.0/device": "0x06a7\n",
+				"/sys/bus/pci/devices/0183:70:00.0/vendor": "0x1014\n",
+				"/sys/bus/pci/devices/0183:70:00.0/device": "0x06a7\n",
+				"/sys/bus/pci/devices/0001:00:01.0/vendor": "0x15b3\n",
+				"/sys/bus/pci/devices/0001:00:01.0/device": "0x1019\n",
+			},
+			want: []string{"0182:70:00.0", "0183:70:00.0"},
+		},
+		{
+			name: "ibm device that is not spyre",
+			files: hostfs.Fake{
+				"/sys/bus/pci/devices/0001:00:01.0/vendor": "0x1014\n",
+				"/sys/bus/pci/devices/0001:00:01.0/device": "0x034a\n",
+			},
+			want: []string{},
+		},
+		{
+			name: "device without vendor file is skipped",
+			files: hostfs.Fake{
+				"/sys/bus/pci/devices/0182:70:00.0/device": "0x06a7\n",
+			},
+			want: []string{},
+		},
+		{
+			name:  "no pci devices",
+			files: hostfs.Fake{},
+			want:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ListDevices(tt.files)
+			if err != nil {
+				t.Fatalf("ListDevices() unexpected error: %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ListDevices() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpyreRuleVerify(t *testing.T) {
+	r := &SpyreRule{fs: hostfs.Fake{}}
+	if err := r.Verify(); err == nil {
+		t.Error("Verify() expected error when no spyre card is attached")
+	}
+
+	r = &SpyreRule{fs: hostfs.Fake{
+		"/sys/bus/pci/devices/0182:70:00.0/vendor": "0x1014\n",
+		"/sys/bus/pci/devices/0182:70:00.0/device": "0x06a7\n",
+	}}
+	if err := r.Verify(); err != nil {
+		t.Errorf("Verify() unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,220 @@
+package vfio
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/warn"
+)
+
+const (
+	vfioDriver = "vfio-pci"
+
+	pciDevicesDir = "/sys/bus/pci/devices"
+	pciDriversDir = "/sys/bus/pci/drivers"
+	// driversProbe binds a device to the driver matching it, Eg:- the one set by its driver_override.
+	driversProbe = "/sys/bus/pci/drivers_probe"
+
+	sysfsPerm = 0o200
+)
+
+// writeSysfs writes a sysfs attribute, it is a variable so that it can be substituted in tests.
+var writeSysfs = func(path, value string) error {
+	return os.WriteFile(path, []byte(value), sysfsPerm)
+}
+
+// Bindings are the vfio-pci bindings of the host which do not match the expected ones, where every Spyre card,
+// and only them, is bound to vfio-pci as configured by servicereport.
+type Bindings struct {
+	// Unbound maps the Spyre cards not bound to vfio-pci to their current driver, empty for none.
+	Unbound map[string]string
+	// Orphaned are the other devices bound to vfio-pci, Eg:- left over by a card removed from the LPAR.
+	Orphaned []string
+}
+
+type BindingsRule struct {
+	fs       hostfs.FS
+	bindings Bindings
+}
+
+func NewBindingsRule() *BindingsRule {
+	return &BindingsRule{fs: hostfs.OS}
+}
+
+// ReadsHost marks the rule as able to verify a remote host, the drivers of the cards being read from sysfs.
+// Its fix binds them on the local host only.
+func (r *BindingsRule) ReadsHost() {}
+
+func (r *BindingsRule) Name() string {
+	return "vfio-bindings"
+}
+
+// DependsOn requires the Spyre cards to be attached and the vfio modules to be loaded, for their bindings to be checked.
+func (r *BindingsRule) DependsOn() []string {
+	return []string{"spyre", "vfio"}
+}
+
+func (r *BindingsRule) Description() string {
+	return "Validates that the Spyre cards, and only them, are bound to vfio-pci."
+}
+
+func (r *BindingsRule) Verify() error {
+	logger.Infoln("Validating vfio-pci bindings...", logger.VerbosityLevelDebug)
+	bindings, err := CheckBindings(r.fs)
+	if err != nil {
+		return err
+	}
+	r.bindings = bindings
+
+	var issues []string
+	if len(bindings.Unbound) > 0 {
+		issues = append(issues, "spyre cards not bound to "+vfioDriver+": "+formatUnbound(bindings.Unbound))
+	}
+	if len(bindings.Orphaned) > 0 {
+		issues = append(issues, "devices bound to "+vfioDriver+" which are not spyre cards: "+strings.Join(bindings.Orphaned, ", "))
+	}
+	if len(issues) == 0 {
+		return nil
+	}
+	// orphaned bindings alone do not prevent the cards from being passed through to the pods
+	if len(bindings.Unbound) == 0 {
+		return warn.Errorf("stale %s bindings: %s", vfioDriver, strings.Join(issues, "; "))
+	}
+
+	return fmt.Errorf("stale %s bindings: %s", vfioDriver, strings.Join(issues, "; "))
+}
+
+// CheckBindings compares the devices bound to vfio-pci with the Spyre cards attached to the LPAR.
+func CheckBindings(fsys hostfs.FS) (Bindings, error) {
+	cards, err := spyre.ListDevices(fsys)
+	if err != nil {
+		return Bindings{}, fmt.Errorf("failed to enumerate PCI devices: %w", err)
+	}
+
+	bound, err := fsys.Glob(filepath.Join(pciDriversDir, vfioDriver, "*:*"))
+	if err != nil {
+		return Bindings{}, fmt.Errorf("failed to list the devices bound to %s: %w", vfioDriver, err)
+	}
+	boundAddrs := make([]string, 0, len(bound))
+	for _, dev := range bound {
+		boundAddrs = append(boundAddrs, filepath.Base(dev))
+	}
+
+	bindings := Bindings{Unbound: map[string]string{}}
+	for _, card := range cards {
+		if !slices.Contains(boundAddrs, card) {
+			bindings.Unbound[card] = currentDriver(fsys, card)
+		}
+	}
+	for _, addr := range boundAddrs {
+		if !slices.Contains(cards, addr) {
+			bindings.Orphaned = append(bindings.Orphaned, addr)
+		}
+	}
+	slices.Sort(bindings.Orphaned)
+
+	return bindings, nil
+}
+
+// currentDriver returns the driver the device is bound to, empty for none.
+func currentDriver(fsys hostfs.FS, addr string) string {
+	matches, err := fsys.Glob(filepath.Join(pciDriversDir, "*", addr))
+	if err != nil || len(matches) == 0 {
+		return ""
+	}
+
+	return filepath.Base(filepath.Dir(matches[0]))
+}
+
+// Fix binds the Spyre cards to vfio-pci, and releases the orphaned devices back to their default driver.
+func (r *BindingsRule) Fix() error {
+	for _, card := range sortedKeys(r.bindings.Unbound) {
+		logger.Infof("Binding spyre card %s to %s\n", card, vfioDriver, logger.VerbosityLevelDebug)
+		if err := rebind(card, r.bindings.Unbound[card], vfioDriver); err != nil {
+			return fmt.Errorf("failed to bind spyre card %s to %s: %w", card, vfioDriver, err)
+		}
+	}
+	for _, addr := range r.bindings.Orphaned {
+		logger.Infof("Releasing device %s from %s\n", addr, vfioDriver, logger.VerbosityLevelDebug)
+		if err := rebind(addr, vfioDriver, ""); err != nil {
+			return fmt.Errorf("failed to release device %s from %s: %w", addr, vfioDriver, err)
+		}
+	}
+
+	return nil
+}
+
+// rebind unbinds the device from its current driver, and probes it again for the given driver, or the default one.
+func rebind(addr, from, to string) error {
+	if from != "" {
+		if err := writeSysfs(filepath.Join(pciDriversDir, from, "unbind"), addr); err != nil {
+			return err
+		}
+	}
+	// an empty value clears the override, so that the default driver of the device is probed
+	override := to
+	if override == "" {
+		override = "\n"
+	}
+	if err := writeSysfs(filepath.Join(pciDevicesDir, addr, "driver_override"), override); err != nil {
+		return err
+	}
+
+	return writeSysfs(driversProbe, addr)
+}
+
+func (r *BindingsRule) Message() string {
+	return "Spyre cards are bound to vfio-pci"
+}
+
+func (r *BindingsRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *BindingsRule) Hint() string {
+	return "Stale bindings are left by repeated configure runs or manual edits. Run 'ai-services bootstrap validate --fix' " +
+		"to bind the Spyre cards to vfio-pci and release the other devices, or 'ai-services bootstrap configure' to configure the cards again."
+}
+
+func (r *BindingsRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "Each Spyre card must be bound to vfio-pci to be passed through to a container, and no other device must be. " +
+			"A card left to another driver is unavailable to the applications, while another device bound to vfio-pci is lost to the host.",
+		Remediation: []string{
+			"List the drivers the cards are bound to with 'lspci -k -d 1014:06a7'.",
+			"Run 'ai-services bootstrap validate --fix' to bind the cards to vfio-pci and release the other devices.",
+			"Or run 'ai-services bootstrap configure' to configure the cards again.",
+		},
+	}
+}
+
+func formatUnbound(unbound map[string]string) string {
+	parts := make([]string, 0, len(unbound))
+	for _, card := range sortedKeys(unbound) {
+		driver := unbound[card]
+		if driver == "" {
+			driver = "no driver"
+		}
+		parts = append(parts, fmt.Sprintf("%s (%s)", card, driver))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
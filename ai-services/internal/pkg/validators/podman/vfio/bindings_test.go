@@ -0,0 +1,141 @@
+package vfio
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/warn"
+)
+
+func spyreCard(files hostfs.Fake, addr, driver string) {
+	files["/sys/bus/pci/devices/"+addr+"/vendor"] = "0x1014\n"
+	files["/sys/bus/pci/devices/"+addr+"/device"] = "0x06a7\n"
+	if driver != "" {
+		files["/sys/bus/pci/drivers/"+driver+"/"+addr] = ""
+	}
+}
+
+func TestBindingsRuleVerify(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(hostfs.Fake)
+		wantUnbound  map[string]string
+		wantOrphaned []string
+		wantErr      bool
+		wantWarning  bool
+	}{
+		{
+			name: "all spyre cards bound",
+			setup: func(files hostfs.Fake) {
+				spyreCard(files, "0182:70:00.0", vfioDriver)
+				spyreCard(files, "0183:70:00.0", vfioDriver)
+			},
+			wantUnbound: map[string]string{},
+		},
+		{
+			name: "spyre card left unbound",
+			setup: func(files hostfs.Fake) {
+				spyreCard(files, "0182:70:00.0", vfioDriver)
+				spyreCard(files, "0183:70:00.0", "")
+			},
+			wantUnbound: map[string]string{"0183:70:00.0": ""},
+			wantErr:     true,
+		},
+		{
+			name: "spyre card bound to another driver",
+			setup: func(files hostfs.Fake) {
+				spyreCard(files, "0182:70:00.0", "aiu")
+			},
+			wantUnbound: map[string]string{"0182:70:00.0": "aiu"},
+			wantErr:     true,
+		},
+		{
+			name: "orphaned binding only warns",
+			setup: func(files hostfs.Fake) {
+				spyreCard(files, "0182:70:00.0", vfioDriver)
+				files["/sys/bus/pci/drivers/vfio-pci/0001:00:01.0"] = ""
+			},
+			wantUnbound:  map[string]string{},
+			wantOrphaned: []string{"0001:00:01.0"},
+			wantErr:      true,
+			wantWarning:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := hostfs.Fake{"/sys/bus/pci/drivers/vfio-pci/new_id": ""}
+			tt.setup(files)
+
+			r := &BindingsRule{fs: files}
+			err := r.Verify()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if warn.Is(err) != tt.wantWarning {
+				t.Errorf("Verify() warning = %t, want %t", warn.Is(err), tt.wantWarning)
+			}
+			if !maps.Equal(r.bindings.Unbound, tt.wantUnbound) {
+				t.Errorf("unbound = %v, want %v", r.bindings.Unbound, tt.wantUnbound)
+			}
+			if !slices.Equal(r.bindings.Orphaned, tt.wantOrphaned) {
+				t.Errorf("orphaned = %v, want %v", r.bindings.Orphaned, tt.wantOrphaned)
+			}
+		})
+	}
+}
+
+func TestBindingsRuleFix(t *testing.T) {
+	tests := []struct {
+		name       string
+		bindings   Bindings
+		writeErr   error
+		wantWrites []string
+		wantErr    bool
+	}{
+		{
+			name:     "binds the spyre cards and releases the orphaned devices",
+			bindings: Bindings{Unbound: map[string]string{"0182:70:00.0": "aiu", "0183:70:00.0": ""}, Orphaned: []string{"0001:00:01.0"}},
+			wantWrites: []string{
+				"/sys/bus/pci/drivers/aiu/unbind=0182:70:00.0",
+				"/sys/bus/pci/devices/0182:70:00.0/driver_override=vfio-pci",
+				"/sys/bus/pci/drivers_probe=0182:70:00.0",
+				"/sys/bus/pci/devices/0183:70:00.0/driver_override=vfio-pci",
+				"/sys/bus/pci/drivers_probe=0183:70:00.0",
+				"/sys/bus/pci/drivers/vfio-pci/unbind=0001:00:01.0",
+				"/sys/bus/pci/devices/0001:00:01.0/driver_override=\n",
+				"/sys/bus/pci/drivers_probe=0001:00:01.0",
+			},
+		},
+		{
+			name:       "sysfs write failure",
+			bindings:   Bindings{Unbound: map[string]string{"0182:70:00.0": "aiu"}},
+			writeErr:   errors.New("permission denied"),
+			wantWrites: []string{"/sys/bus/pci/drivers/aiu/unbind=0182:70:00.0"},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var writes []string
+			defer func(f func(string, string) error) { writeSysfs = f }(writeSysfs)
+			writeSysfs = func(path, value string) error {
+				writes = append(writes, path+"="+value)
+
+				return tt.writeErr
+			}
+
+			r := &BindingsRule{bindings: tt.bindings}
+			if err := r.Fix(); (err != nil) != tt.wantErr {
+				t.Fatalf("Fix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(writes, tt.wantWrites) {
+				t.Errorf("sysfs writes = %q, want %q", writes, tt.wantWrites)
+			}
+		})
+	}
+}
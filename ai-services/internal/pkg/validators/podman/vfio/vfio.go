@@ -0,0 +1,127 @@
+package vfio
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+	"github.com/project-ai-services/ai-services/internal/pkg/logger"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+)
+
+const (
+	// sysModuleDir lists the loaded kernel modules, along with the ones built into the kernel.
+	sysModuleDir = "/sys/module"
+
+	// modulesLoadConf is read by systemd-modules-load, to load the modules again at boot.
+	modulesLoadConf     = "/etc/modules-load.d/ai-services-vfio.conf"
+	modulesLoadConfPerm = 0o644
+	modulesLoadDirPerm  = 0o755
+)
+
+// RequiredModules are the kernel modules needed for the vfio passthrough of the Spyre cards on IBM Power.
+var RequiredModules = []string{"vfio", "vfio_pci", "vfio_iommu_spapr_tce"}
+
+// modprobe loads the given kernel modules, it is a variable so that it can be substituted in tests.
+var modprobe = func(modules ...string) ([]byte, error) {
+	return exec.Command("modprobe", append([]string{"-a"}, modules...)...).CombinedOutput()
+}
+
+type VfioRule struct {
+	fs       hostfs.FS
+	confPath string
+	missing  []string
+}
+
+func NewVfioRule() *VfioRule {
+	return &VfioRule{fs: hostfs.OS, confPath: modulesLoadConf}
+}
+
+// ReadsHost marks the rule as able to verify a remote host, the loaded modules being listed from sysfs.
+// Its fix loads them on the local host only.
+func (r *VfioRule) ReadsHost() {}
+
+func (r *VfioRule) Name() string {
+	return "vfio"
+}
+
+// DependsOn requires an IBM Power11 host, as the vfio IOMMU driver of the required modules is specific to it.
+func (r *VfioRule) DependsOn() []string {
+	return []string{"power"}
+}
+
+func (r *VfioRule) Description() string {
+	return "Validates that the vfio kernel modules required for the Spyre cards passthrough are loaded."
+}
+
+func (r *VfioRule) Verify() error {
+	logger.Infoln("Validating vfio kernel modules...", logger.VerbosityLevelDebug)
+	r.missing = MissingModules(r.fs)
+	if len(r.missing) > 0 {
+		return fmt.Errorf("vfio kernel modules are not loaded: %s", strings.Join(r.missing, ", "))
+	}
+
+	return nil
+}
+
+// MissingModules returns the required kernel modules which are neither loaded nor built into the kernel.
+func MissingModules(fsys hostfs.FS) []string {
+	var missing []string
+	for _, module := range RequiredModules {
+		if _, err := fsys.Stat(filepath.Join(sysModuleDir, module)); err != nil {
+			missing = append(missing, module)
+		}
+	}
+
+	return missing
+}
+
+// Fix loads the missing kernel modules, and configures all the required ones to be loaded at boot.
+func (r *VfioRule) Fix() error {
+	if len(r.missing) > 0 {
+		logger.Infof("Loading kernel modules: %s\n", strings.Join(r.missing, ", "), logger.VerbosityLevelDebug)
+		if out, err := modprobe(r.missing...); err != nil {
+			return fmt.Errorf("failed to load kernel modules %s: %w, output: %s", strings.Join(r.missing, ", "), err, strings.TrimSpace(string(out)))
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.confPath), modulesLoadDirPerm); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(r.confPath), err)
+	}
+	if err := os.WriteFile(r.confPath, []byte(strings.Join(RequiredModules, "\n")+"\n"), modulesLoadConfPerm); err != nil {
+		return fmt.Errorf("failed to persist the kernel modules in %s: %w", r.confPath, err)
+	}
+	logger.Infof("Kernel modules configured to load at boot in %s\n", r.confPath, logger.VerbosityLevelDebug)
+
+	return nil
+}
+
+func (r *VfioRule) Message() string {
+	return "vfio kernel modules are loaded"
+}
+
+func (r *VfioRule) Level() constants.ValidationLevel {
+	return constants.ValidationLevelError
+}
+
+func (r *VfioRule) Hint() string {
+	return fmt.Sprintf("The Spyre cards can only be bound to vfio-pci with the kernel modules %s loaded. "+
+		"Load them with 'modprobe -a %s' and list them in %s to load them at boot, "+
+		"or run 'ai-services bootstrap validate --fix'.", strings.Join(RequiredModules, ", "), strings.Join(RequiredModules, " "), modulesLoadConf)
+}
+
+func (r *VfioRule) Explain() explain.Explanation {
+	return explain.Explanation{
+		Why: "The Spyre cards are passed through to the containers with vfio-pci. On IBM Power, the passthrough needs the vfio_iommu_spapr_tce " +
+			"IOMMU driver along with vfio and vfio_pci, without them the cards cannot be bound to vfio-pci.",
+		Remediation: []string{
+			fmt.Sprintf("Load the kernel modules with 'modprobe -a %s'.", strings.Join(RequiredModules, " ")),
+			fmt.Sprintf("List them in %s for systemd-modules-load to load them at boot.", modulesLoadConf),
+			"Or run 'ai-services bootstrap validate --fix', which does both.",
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package vfio
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/hostfs"
+)
+
+func TestMissingModules(t *testing.T) {
+	tests := []struct {
+		name  string
+		files hostfs.Fake
+		want  []string
+	}{
+		{
+			name: "all modules loaded",
+			files: hostfs.Fake{
+				"/sys/module/vfio/refcnt":                 "3\n",
+				"/sys/module/vfio_pci/refcnt":             "0\n",
+				"/sys/module/vfio_iommu_spapr_tce/refcnt": "1\n",
+			},
+		},
+		{
+			name: "vfio_pci not loaded",
+			files: hostfs.Fake{
+				"/sys/module/vfio/refcnt":                 "3\n",
+				"/sys/module/vfio_iommu_spapr_tce/refcnt": "1\n",
+			},
+			want: []string{"vfio_pci"},
+		},
+		{
+			name:  "no modules loaded",
+			files: hostfs.Fake{},
+			want:  RequiredModules,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MissingModules(tt.files); !slices.Equal(got, tt.want) {
+				t.Errorf("MissingModules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFix(t *testing.T) {
+	tests := []struct {
+		name     string
+		missing  []string
+		loadErr  error
+		wantLoad []string
+		wantErr  bool
+	}{
+		{name: "loads the missing modules", missing: []string{"vfio_pci"}, wantLoad: []string{"vfio_pci"}},
+		{name: "only persists the loaded modules"},
+		{name: "modprobe failure", missing: []string{"vfio_pci"}, loadErr: errors.New("exit status 1"), wantLoad: []string{"vfio_pci"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var loaded []string
+			defer func(f func(...string) ([]byte, error)) { modprobe = f }(modprobe)
+			modprobe = func(modules ...string) ([]byte, error) {
+				loaded = append(loaded, modules...)
+
+				return nil, tt.loadErr
+			}
+
+			r := &VfioRule{confPath: filepath.Join(t.TempDir(), "modules-load.d", "vfio.conf"), missing: tt.missing}
+			err := r.Fix()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Fix() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(loaded, tt.wantLoad) {
+				t.Errorf("loaded modules = %v, want %v", loaded, tt.wantLoad)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			data, err := os.ReadFile(r.confPath)
+			if err != nil {
+				t.Fatalf("read %s: %v", r.confPath, err)
+			}
+			if want := "vfio\nvfio_pci\nvfio_iommu_spapr_tce\n"; string(data) != want {
+				t.Errorf("persisted modules = %q, want %q", data, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package validators
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/cli/errhints"
+)
+
+func TestCheckArch(t *testing.T) {
+	tests := []struct {
+		name       string
+		hostOsArch string
+		imgArch    string
+		wantErr    string
+	}{
+		{name: "matching architecture", hostOsArch: "linux/ppc64le", imgArch: "ppc64le"},
+		{name: "architecture without os", hostOsArch: "ppc64le", imgArch: "ppc64le"},
+		{name: "mismatching architecture", hostOsArch: "linux/amd64", imgArch: "ppc64le", wantErr: "podman host architecture amd64 does not match the architecture ppc64le"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkArch(tt.hostOsArch, tt.imgArch)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkArch() error = %v", err)
+				}
+
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkArch() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if !errors.Is(err, errhints.ErrArchMismatch) {
+				t.Errorf("checkArch() error = %v, want it marked as an architecture mismatch", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package validators
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+const (
+	// ProfileProd enforces all the validation checks, it is the default profile.
+	ProfileProd = "prod"
+	// ProfileDev relaxes the validation checks for development sandboxes.
+	ProfileDev = "dev"
+
+	devLparAffinityThreshold = 50
+)
+
+// Profile is a preset of the validation checks to run and of their thresholds.
+type Profile struct {
+	Name        string
+	Description string
+	// Skip lists the checks left out by the profile, per runtime.
+	Skip map[types.RuntimeType][]string
+	// LparAffinityThreshold is the minimum LPAR affinity percentage accepted by the numa check.
+	LparAffinityThreshold int
+}
+
+// Profiles lists the available validation profiles.
+var Profiles = []Profile{
+	{
+		Name:                  ProfileProd,
+		Description:           "Production deployments, all the checks are enforced",
+		Skip:                  map[types.RuntimeType][]string{},
+		LparAffinityThreshold: vars.LparAffinityThreshold,
+	},
+	{
+		Name:        ProfileDev,
+		Description: "Development sandboxes, the subscription checks are skipped and the LPAR affinity threshold is relaxed",
+		Skip: map[types.RuntimeType][]string{
+			types.RuntimeTypePodman: {"rhn"},
+		},
+		LparAffinityThreshold: devLparAffinityThreshold,
+	},
+}
+
+// LookupProfile returns the validation profile with the given name.
+func LookupProfile(name string) (Profile, error) {
+	names := make([]string, 0, len(Profiles))
+	for _, p := range Profiles {
+		if p.Name == strings.ToLower(name) {
+			return p, nil
+		}
+		names = append(names, p.Name)
+	}
+
+	return Profile{}, fmt.Errorf("unknown validation profile '%s', must be one of: %s", name, strings.Join(names, ", "))
+}
+
+// Apply configures the thresholds of the profile, and adds the checks it leaves out for the runtime to skip.
+func (p Profile) Apply(rt types.RuntimeType, skip map[string]bool) map[string]bool {
+	vars.LparAffinityThreshold = p.LparAffinityThreshold
+
+	if skip == nil {
+		skip = map[string]bool{}
+	}
+	for _, check := range p.Skip[rt] {
+		skip[check] = true
+	}
+
+	return skip
+}
@@ -0,0 +1,89 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/project-ai-services/ai-services/internal/pkg/runtime/types"
+	"github.com/project-ai-services/ai-services/internal/pkg/vars"
+)
+
+func TestProfileApply(t *testing.T) {
+	defaultThreshold := vars.LparAffinityThreshold
+	defer func() { vars.LparAffinityThreshold = defaultThreshold }()
+
+	tests := []struct {
+		name          string
+		profile       string
+		rt            types.RuntimeType
+		skip          map[string]bool
+		wantSkip      map[string]bool
+		wantThreshold int
+	}{
+		{
+			name:          "prod enforces all the checks",
+			profile:       "prod",
+			rt:            types.RuntimeTypePodman,
+			wantSkip:      map[string]bool{},
+			wantThreshold: defaultThreshold,
+		},
+		{
+			name:          "dev skips the subscription check on podman",
+			profile:       "DEV",
+			rt:            types.RuntimeTypePodman,
+			skip:          map[string]bool{"power": true},
+			wantSkip:      map[string]bool{"power": true, "rhn": true},
+			wantThreshold: devLparAffinityThreshold,
+		},
+		{
+			name:          "dev on openshift",
+			profile:       "dev",
+			rt:            types.RuntimeTypeOpenShift,
+			wantSkip:      map[string]bool{},
+			wantThreshold: devLparAffinityThreshold,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := LookupProfile(tt.profile)
+			if err != nil {
+				t.Fatalf("LookupProfile() error = %v", err)
+			}
+
+			if got := p.Apply(tt.rt, tt.skip); !reflect.DeepEqual(got, tt.wantSkip) {
+				t.Errorf("Apply() = %v, want %v", got, tt.wantSkip)
+			}
+			if vars.LparAffinityThreshold != tt.wantThreshold {
+				t.Errorf("LparAffinityThreshold = %d, want %d", vars.LparAffinityThreshold, tt.wantThreshold)
+			}
+		})
+	}
+}
+
+func TestLookupProfileUnknown(t *testing.T) {
+	if _, err := LookupProfile("staging"); err == nil {
+		t.Error("LookupProfile() expected an error for an unknown profile")
+	}
+}
+
+func TestProfilesSkipRegisteredChecks(t *testing.T) {
+	registries := map[types.RuntimeType]*ValidationRegistry{
+		types.RuntimeTypePodman:    PodmanRegistry,
+		types.RuntimeTypeOpenShift: OpenshiftRegistry,
+	}
+
+	for _, p := range Profiles {
+		for rt, checks := range p.Skip {
+			names := map[string]bool{}
+			for _, rule := range registries[rt].Rules() {
+				names[rule.Name()] = true
+			}
+			for _, check := range checks {
+				if !names[check] {
+					t.Errorf("profile '%s' skips unknown %s check '%s'", p.Name, rt, check)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package retry
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	readinessAttempts = 5
+	readinessInterval = 10 * time.Second
+
+	// waitInterval and waitMaxInterval bound the backoff of the polls waiting for a readiness, Eg:- with --wait-ready.
+	waitInterval    = 5 * time.Second
+	waitMaxInterval = 30 * time.Second
+)
+
+// Policy is how a failed check gets attempted again by the validation runner.
+type Policy struct {
+	// Attempts is the number of retries after the first failure, none fails the check right away.
+	Attempts int
+	// Interval is the time to wait between two attempts.
+	Interval time.Duration
+	// MaxInterval caps the interval, doubled after each attempt. The interval stays the same when zero.
+	MaxInterval time.Duration
+	// Timeout polls the check until it passes for up to this time, rather than for a number of attempts, when set.
+	Timeout time.Duration
+}
+
+var (
+	// None fails the check on its first failure, as done for the checks of the host, Eg:- is-root.
+	None = Policy{}
+	// Readiness polls the checks waiting for a cluster resource to become ready, Eg:- an operator CSV.
+	Readiness = Policy{Attempts: readinessAttempts, Interval: readinessInterval}
+)
+
+// Until polls a check with backoff until it passes or the timeout elapses, Eg:- to wait for the operators being installed.
+func Until(timeout time.Duration) Policy {
+	return Policy{
+		Interval:    waitInterval,
+		MaxInterval: waitMaxInterval,
+		Timeout:     timeout,
+	}
+}
+
+// Backoff returns the interval following the given one, as per MaxInterval.
+func (p Policy) Backoff(interval time.Duration) time.Duration {
+	if p.MaxInterval <= 0 {
+		return interval
+	}
+
+	return min(2*interval, p.MaxInterval)
+}
+
+func (p Policy) String() string {
+	switch {
+	case p.Timeout > 0:
+		return fmt.Sprintf("retries with a backoff from %s to %s for up to %s", p.Interval, p.MaxInterval, p.Timeout)
+	case p.Attempts <= 0:
+		return "no retries"
+	default:
+		return fmt.Sprintf("%d retries every %s", p.Attempts, p.Interval)
+	}
+}
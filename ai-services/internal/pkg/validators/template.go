@@ -7,14 +7,18 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/cli/templates"
 )
 
+// ValidateAppTemplateExist checks that the given application template exists.
+// Hidden templates are included, so that they can be resolved by their exact name.
 func ValidateAppTemplateExist(tp templates.Template, templateName string) error {
-	// Fetch all the application Template names
+	// Fetch all the application Template names, including the hidden ones
 	appTemplateNames, err := tp.ListApplications(true)
 	if err != nil {
 		return fmt.Errorf("failed to list templates: %w", err)
 	}
 
-	if !slices.Contains(appTemplateNames, templateName) {
+	// a pinned version is validated against the application template it belongs to
+	app, _ := templates.SplitRef(templateName)
+	if !slices.Contains(appTemplateNames, app) {
 		return fmt.Errorf("application template '%s' does not exist", templateName)
 	}
 
@@ -4,6 +4,8 @@ import (
 	"sync"
 
 	"github.com/project-ai-services/ai-services/internal/pkg/constants"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/explain"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/clusterversion"
 	kubeconfig "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/kubeconfig"
 	nodelabels "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/nodelabels"
 	operators "github.com/project-ai-services/ai-services/internal/pkg/validators/openshift/operators"
@@ -13,10 +15,14 @@ import (
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/numa"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/platform"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/power"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/registry"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/rhn"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/root"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/rootless"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/servicereport"
 	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/spyre"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/podman/vfio"
+	"github.com/project-ai-services/ai-services/internal/pkg/validators/retry"
 )
 
 // Initialize the default registry with built-in rules.
@@ -24,15 +30,20 @@ func init() {
 	// Podman checks
 	// adding root rule on top to verify this check first
 	PodmanRegistry.Register(root.NewRootRule())
+	PodmanRegistry.Register(rootless.NewRootlessRule())
 	PodmanRegistry.Register(numa.NewNumaRule())
 	PodmanRegistry.Register(platform.NewPlatformRule())
 	PodmanRegistry.Register(power.NewPowerRule())
 	PodmanRegistry.Register(rhn.NewRHNRule())
 	PodmanRegistry.Register(spyre.NewSpyreRule())
+	PodmanRegistry.Register(vfio.NewVfioRule())
+	PodmanRegistry.Register(vfio.NewBindingsRule())
+	PodmanRegistry.Register(registry.NewRegistryRule())
 	PodmanRegistry.Register(servicereport.NewServiceReportRule())
 
 	// OpenshiftChecks
 	OpenshiftRegistry.Register(kubeconfig.NewKubeconfigRule())
+	OpenshiftRegistry.Register(clusterversion.NewClusterVersionRule())
 	OpenshiftRegistry.Register(nodelabels.NewNodeLabelsRule())
 	OpenshiftRegistry.Register(operators.NewOperatorRule())
 	OpenshiftRegistry.Register(spyrepolicy.NewSpyrePolicyRule())
@@ -51,6 +62,85 @@ type Rule interface {
 	Description() string
 }
 
+// Retrier is implemented by the rules which declare their own retry policy, Eg:- the ones polling for readiness.
+// The rules which do not implement it fail on their first failure.
+type Retrier interface {
+	RetryPolicy() retry.Policy
+}
+
+// RetryPolicyOf returns the retry policy of the given rule.
+func RetryPolicyOf(rule Rule) retry.Policy {
+	if r, ok := rule.(Retrier); ok {
+		return r.RetryPolicy()
+	}
+
+	return retry.None
+}
+
+// Fixer is implemented by the rules which can remediate their failure, Eg:- by loading the missing kernel modules.
+// The fix is only attempted with --fix, the rule is then verified again.
+type Fixer interface {
+	Fix() error
+}
+
+// Measurer is implemented by the rules which measure the host while verifying it, Eg:- the LPAR affinity.
+// The measurements of the last verification are recorded in the validation report, keyed by the name of the metric.
+type Measurer interface {
+	Measurements() map[string]float64
+}
+
+// MeasurementsOf returns the measurements of the given rule, none when it does not measure anything.
+func MeasurementsOf(rule Rule) map[string]float64 {
+	if m, ok := rule.(Measurer); ok {
+		return m.Measurements()
+	}
+
+	return nil
+}
+
+// Versioner is implemented by the rules which find the version of a software while verifying it, Eg:- the RHEL release.
+// The versions of the last verification are recorded in the validation report, keyed by the name of the software.
+type Versioner interface {
+	Versions() map[string]string
+}
+
+// VersionsOf returns the versions found by the given rule, none when it does not find any.
+func VersionsOf(rule Rule) map[string]string {
+	if v, ok := rule.(Versioner); ok {
+		return v.Versions()
+	}
+
+	return nil
+}
+
+// Explainer is implemented by the rules which document why they matter and how to remediate their failure step by step,
+// printed by bootstrap validate --explain.
+type Explainer interface {
+	Explain() explain.Explanation
+}
+
+// ExplanationOf returns the explanation of the given rule, its hint as the only remediation step when it does not explain itself.
+func ExplanationOf(rule Rule) explain.Explanation {
+	if e, ok := rule.(Explainer); ok {
+		return e.Explain()
+	}
+
+	return explain.Explanation{Remediation: []string{rule.Hint()}}
+}
+
+// HostReader is implemented by the rules which only read the files of the host through hostfs, Eg:- /proc/cpuinfo,
+// so that they verify a remote LPAR as well once its filesystem is substituted, see hostfs.Remote.
+type HostReader interface {
+	ReadsHost()
+}
+
+// ReadsHost tells whether the given rule can verify a remote host.
+func ReadsHost(rule Rule) bool {
+	_, ok := rule.(HostReader)
+
+	return ok
+}
+
 // PodmanRegistry is the podman registry instance that holds all registered checks.
 var PodmanRegistry = NewValidationRegistry()
 var OpenshiftRegistry = NewValidationRegistry()
@@ -82,3 +172,17 @@ func (r *ValidationRegistry) Rules() []Rule {
 
 	return r.rules
 }
+
+// Rule returns the registered check of the given name.
+func (r *ValidationRegistry) Rule(name string) (Rule, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, rule := range r.rules {
+		if rule.Name() == name {
+			return rule, true
+		}
+	}
+
+	return nil, false
+}
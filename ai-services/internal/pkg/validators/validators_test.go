@@ -0,0 +1,31 @@
+package validators
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRulesExplainThemselves(t *testing.T) {
+	rules := slices.Concat(PodmanRegistry.Rules(), OpenshiftRegistry.Rules())
+	for _, rule := range rules {
+		e, ok := rule.(Explainer)
+		if !ok {
+			t.Errorf("check %s does not implement Explainer", rule.Name())
+
+			continue
+		}
+		if explanation := e.Explain(); explanation.Why == "" || len(explanation.Remediation) == 0 {
+			t.Errorf("check %s explanation = %+v, want why it matters and the remediation steps", rule.Name(), explanation)
+		}
+	}
+}
+
+func TestRegistryRule(t *testing.T) {
+	rule, ok := PodmanRegistry.Rule("numa")
+	if !ok || rule.Name() != "numa" {
+		t.Errorf("Rule(numa) = %v, %v, want the numa check", rule, ok)
+	}
+	if _, ok := PodmanRegistry.Rule("dsc"); ok {
+		t.Error("Rule(dsc) found an openshift check in the podman registry")
+	}
+}
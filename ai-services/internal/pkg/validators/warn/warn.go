@@ -0,0 +1,32 @@
+package warn
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error is returned by a check whose condition is concerning, but does not fail the validation.
+// The check is then reported as a warning, whatever its validation level.
+type Error struct {
+	err error
+}
+
+func (e *Error) Error() string {
+	return e.err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.err
+}
+
+// Errorf returns a warning formatted as per fmt.Errorf.
+func Errorf(format string, args ...any) error {
+	return &Error{err: fmt.Errorf(format, args...)}
+}
+
+// Is reports whether the error returned by a check is a warning.
+func Is(err error) bool {
+	var w *Error
+
+	return errors.As(err, &w)
+}
@@ -1,9 +1,12 @@
 package vars
 
 import (
+	"os"
 	"regexp"
+	"slices"
 	"time"
 
+	"github.com/project-ai-services/ai-services/internal/pkg/constants"
 	"github.com/project-ai-services/ai-services/internal/pkg/runtime"
 )
 
@@ -15,22 +18,83 @@ var (
 var (
 	// SpyreCardAnnotationRegex -> ai-services.io/<containerName>--spyre-cards.
 	SpyreCardAnnotationRegex = regexp.MustCompile(`^ai-services\.io\/([A-Za-z0-9][-A-Za-z0-9_.]*)--spyre-cards$`)
-	ToolImage                = "icr.io/ai-services/tools:0.6"
+	ToolImage                = envOrDefault(constants.ToolImageEnv, "icr.io/ai-services/tools:0.6")
 	ModelDirectory           = "/var/lib/ai-services/models"
+	// ModelDownloadConcurrency bounds the parallel download streams shared by all the models being downloaded.
+	ModelDownloadConcurrency = constants.DefaultModelDownloadConcurrency
 )
 
+// Label is the key of a label set on the resources of the applications, Eg:- to select them with the runtime queries.
 type Label string
 
 var (
-	TemplateLabel Label = "ai-services.io/template"
-	VersionLabel  Label = "ai-services.io/version"
+	ApplicationLabel Label = constants.ApplicationAnnotationKey
+	TemplateLabel    Label = "ai-services.io/template"
+	VersionLabel     Label = "ai-services.io/version"
 )
 
 var (
 	LparAffinityThreshold = 70
+	// MinSpyreCards is the minimum number of spyre cards required by the application template being deployed,
+	// none when zero.
+	MinSpyreCards = 0
+)
+
+var (
+	// LogFile is the path of the log file the output is also appended to, set by --log-file. Disabled when empty.
+	LogFile string
 )
 
 var (
 	RetryCount    = 3
 	RetryInterval = 5 * time.Second
 )
+
+var (
+	// OperatorTimeout is the time to wait for each operator to become ready during bootstrap.
+	OperatorTimeout = constants.OperatorPollTimeout
+
+	// MinOpenShiftVersion is the minimum major.minor OpenShift version accepted by the clusterversion check.
+	MinOpenShiftVersion = constants.MinOpenShiftVersion
+	// MinServiceReportVersion is the minimum version of the servicereport tool run by configure and validate.
+	MinServiceReportVersion = constants.MinServiceReportVersion
+	// WarningsAsErrors fails the validation when a check reports a warning.
+	WarningsAsErrors = false
+	// ContinueOnFailure keeps verifying the remaining checks past the failure of a critical check, Eg:- the root one,
+	// so that all the failures are reported in one run.
+	ContinueOnFailure = false
+	// FixChecks remediates the failed checks which support it, before verifying them again.
+	FixChecks = false
+	// ApplyRecommendations applies the actions recommended by servicereport during the configuration,
+	// rather than only reporting them.
+	ApplyRecommendations = false
+	// AutoYes applies the actions recommended by servicereport without asking for a confirmation of each of them.
+	AutoYes = false
+	// WaitReady is the time the validation waits for the operators being installed to become ready,
+	// rather than failing on them. Disabled when zero.
+	WaitReady time.Duration
+	// OperatorNamespaces overrides the namespace of the required operators by their name,
+	// Eg:- an operator installed in a custom namespace of a customized cluster.
+	OperatorNamespaces = map[string]string{}
+)
+
+// RequiredOperators returns the operators that need to be installed and ready, in the namespaces overridden by OperatorNamespaces.
+func RequiredOperators() []constants.OperatorConfig {
+	operators := slices.Clone(constants.RequiredOperators)
+	for i, op := range operators {
+		if ns, ok := OperatorNamespaces[op.Name]; ok {
+			operators[i].Namespace = ns
+		}
+	}
+
+	return operators
+}
+
+// envOrDefault returns the value of the environment variable, or else the default value.
+func envOrDefault(env, def string) string {
+	if v, ok := os.LookupEnv(env); ok && v != "" {
+		return v
+	}
+
+	return def
+}